@@ -8,7 +8,9 @@ import (
 	component "notify-service/internal/components"
 	job "notify-service/internal/jobs"
 	service "notify-service/internal/services"
+	smser "notify-service/internal/smser"
 
+	"github.com/go-resty/resty/v2"
 	log "github.com/sirupsen/logrus"
 	"github.com/uptrace/opentelemetry-go-extra/otellogrus"
 	"github.com/urfave/cli/v2"
@@ -116,6 +118,13 @@ func main() {
 				EnvVars:     []string{"AES_KEY"},
 				Destination: &config.AESKey,
 			},
+			&cli.StringFlag{
+				Name:        "phone-default-region",
+				Usage:       "Default region (ISO 3166-1 alpha-2) for phone numbers without a country code",
+				EnvVars:     []string{"PHONE_DEFAULT_REGION"},
+				Value:       "TW",
+				Destination: &config.PhoneDefaultRegion,
+			},
 			&cli.StringFlag{
 				Name:        "kafka-broker",
 				Usage:       "Kafka broker",
@@ -134,6 +143,97 @@ func main() {
 				EnvVars:     []string{"JOB_NAME"},
 				Destination: &config.JobName,
 			},
+			&cli.StringFlag{
+				Name:        "sms-provider",
+				Usage:       "SMS provider",
+				EnvVars:     []string{"SMS_PROVIDER"},
+				Destination: &config.SmsProvider,
+			},
+			&cli.StringFlag{
+				Name:        "sms-international-provider",
+				Usage:       "SMS provider for phone numbers outside the default region (empty to use sms-provider)",
+				EnvVars:     []string{"SMS_INTERNATIONAL_PROVIDER"},
+				Destination: &config.SmsInternationalProvider,
+			},
+			&cli.StringFlag{
+				Name:        "mail-provider",
+				Usage:       "Mail provider (sendgrid/ses/mailgun)",
+				EnvVars:     []string{"MAIL_PROVIDER"},
+				Destination: &config.MailProvider,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-user-name",
+				Usage:       "Mitake user name",
+				EnvVars:     []string{"MITAKE_USER_NAME"},
+				Destination: &config.MitakeUserName,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-password",
+				Usage:       "Mitake password",
+				EnvVars:     []string{"MITAKE_PASSWORD"},
+				Destination: &config.MitakePassword,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-api-base-url",
+				Usage:       "Mitake API base URL (empty to use https://smsapi.mitake.com.tw)",
+				EnvVars:     []string{"MITAKE_API_BASE_URL"},
+				Destination: &config.MitakeApiBaseUrl,
+			},
+			&cli.IntFlag{
+				Name:        "sms-status-poll-batch-size",
+				Usage:       "number of provider trace ids per SMS status query",
+				EnvVars:     []string{"SMS_STATUS_POLL_BATCH_SIZE"},
+				Value:       100,
+				Destination: &config.SmsStatusPollBatchSize,
+			},
+			&cli.IntFlag{
+				Name:        "sms-status-poll-hours",
+				Usage:       "only poll delivery status of SMS sent within this many hours",
+				EnvVars:     []string{"SMS_STATUS_POLL_HOURS"},
+				Value:       72,
+				Destination: &config.SmsStatusPollHours,
+			},
+			&cli.IntFlag{
+				Name:        "sms-provider-batch-limit",
+				Usage:       "SMS provider batch limit",
+				EnvVars:     []string{"SMS_PROVIDER_API_BATCH_LIMIT"},
+				Value:       1000,
+				Destination: &config.SmsProviderBatchLimit,
+			},
+			&cli.IntFlag{
+				Name:        "mail-provider-api-batch-limit",
+				Usage:       "Mail provider API batch limit",
+				EnvVars:     []string{"MAIL_PROVIDER_API_BATCH_LIMIT"},
+				Value:       1000,
+				Destination: &config.MailProviderBatchLimit,
+			},
+			&cli.IntFlag{
+				Name:        "push-provider-api-batch-limit",
+				Usage:       "Push notification batch limit",
+				EnvVars:     []string{"PUSH_PROVIDER_API_BATCH_LIMIT"},
+				Value:       500,
+				Destination: &config.PushProviderBatchLimit,
+			},
+			&cli.IntFlag{
+				Name:        "line-provider-api-batch-limit",
+				Usage:       "LINE message batch limit",
+				EnvVars:     []string{"LINE_PROVIDER_API_BATCH_LIMIT"},
+				Value:       500,
+				Destination: &config.LineProviderBatchLimit,
+			},
+			&cli.IntFlag{
+				Name:        "sms-provider-schedule-min-hours",
+				Usage:       "hand SMS scheduled at least this many hours ahead to the provider (0 to disable)",
+				EnvVars:     []string{"SMS_PROVIDER_SCHEDULE_MIN_HOURS"},
+				Destination: &config.SmsProviderScheduleMinHours,
+			},
+			&cli.StringFlag{
+				Name:        "quiet-hours-default-timezone",
+				Usage:       "Default receiver timezone for quiet hours when it cannot be inferred",
+				EnvVars:     []string{"QUIET_HOURS_DEFAULT_TIMEZONE"},
+				Value:       "Asia/Taipei",
+				Destination: &config.QuietHoursDefaultTimezone,
+			},
 		},
 		Action: execute,
 	}
@@ -177,14 +277,26 @@ func execute(cCtx *cli.Context) error {
 				component.NewAesGcm,
 				component.NewDb,
 				component.NewProducer,
+				component.NewRestyClient,
+				component.NewPhoneNumber,
+				provideSmsStatusQuerier,
+				provideSmsCanceler,
 				fx.Annotate(
 					service.NewNotifyService,
 				),
+				service.NewSuppressionService,
+				service.NewQuietHoursService,
+				service.NewFrequencyCapService,
+				service.NewSmsDeliveryService,
+				service.NewFallbackService,
 				fx.Annotate(
 					job.NewRunner,
 					fx.ParamTags(`group:"jobs"`),
 				),
 				AsJob(job.NewDispatchScheduledMessagesJob),
+				AsJob(job.NewPollSmsStatusJob),
+				AsJob(job.NewEvaluateFallbacksJob),
+				AsJob(job.NewBackfillReceiverHashesJob),
 			),
 			fx.Invoke(
 				func(*tracesdk.TracerProvider) {},
@@ -215,6 +327,32 @@ func execute(cCtx *cli.Context) error {
 	return nil
 }
 
+// 發送商不支援查詢送達狀態時回傳 nil，僅在執行 PollSmsStatusJob 時回報錯誤
+func provideSmsStatusQuerier(
+	config *shared.Config,
+	resty *resty.Client,
+) smser.SmsStatusQuerier {
+	switch config.SmsProvider {
+	case "mitake":
+		return smser.NewMitakeSmser(config, resty)
+	default:
+		return nil
+	}
+}
+
+// 發送商不支援取消預約時回傳 nil
+func provideSmsCanceler(
+	config *shared.Config,
+	resty *resty.Client,
+) smser.SmsCanceler {
+	switch config.SmsProvider {
+	case "mitake":
+		return smser.NewMitakeSmser(config, resty)
+	default:
+		return nil
+	}
+}
+
 func AsJob(f any) any {
 	return fx.Annotate(
 		f,
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"net/http"
 	"os"
 
 	shared "notify-service/internal"
 	component "notify-service/internal/components"
 	server "notify-service/internal/servers"
 	service "notify-service/internal/services"
+	smser "notify-service/internal/smser"
+	webhook "notify-service/internal/webhooks"
 
+	"github.com/go-resty/resty/v2"
 	log "github.com/sirupsen/logrus"
 	"github.com/uptrace/opentelemetry-go-extra/otellogrus"
 	"github.com/urfave/cli/v2"
@@ -34,6 +38,19 @@ func main() {
 				EnvVars:     []string{"GRPC_PORT"},
 				Destination: &config.GrpcPort,
 			},
+			&cli.StringFlag{
+				Name:        "client-api-keys",
+				Usage:       "JSON API key per client id, callers authenticate with x-api-key metadata (empty to trust x-client-id from the gateway)",
+				EnvVars:     []string{"CLIENT_API_KEYS"},
+				Destination: &config.ClientApiKeys,
+			},
+			&cli.IntFlag{
+				Name:        "http-port",
+				Usage:       "HTTP server port for provider webhooks",
+				EnvVars:     []string{"HTTP_PORT"},
+				Value:       8080,
+				Destination: &config.HttpPort,
+			},
 			&cli.StringFlag{
 				Name:        "postgres-host",
 				Usage:       "PostgresSQL DB host address",
@@ -116,6 +133,75 @@ func main() {
 				EnvVars:     []string{"AES_KEY"},
 				Destination: &config.AESKey,
 			},
+			&cli.StringFlag{
+				Name:        "phone-default-region",
+				Usage:       "Default region (ISO 3166-1 alpha-2) for phone numbers without a country code",
+				EnvVars:     []string{"PHONE_DEFAULT_REGION"},
+				Value:       "TW",
+				Destination: &config.PhoneDefaultRegion,
+			},
+			&cli.StringFlag{
+				Name:        "sms-provider",
+				Usage:       "SMS provider, used to cancel SMS scheduled with the provider",
+				EnvVars:     []string{"SMS_PROVIDER"},
+				Destination: &config.SmsProvider,
+			},
+			&cli.StringFlag{
+				Name:        "sms-international-provider",
+				Usage:       "SMS provider for phone numbers outside the default region (empty to use sms-provider)",
+				EnvVars:     []string{"SMS_INTERNATIONAL_PROVIDER"},
+				Destination: &config.SmsInternationalProvider,
+			},
+			&cli.StringFlag{
+				Name:        "mail-provider",
+				Usage:       "Mail provider (sendgrid/ses/mailgun)",
+				EnvVars:     []string{"MAIL_PROVIDER"},
+				Destination: &config.MailProvider,
+			},
+			&cli.IntFlag{
+				Name:        "sms-provider-schedule-min-hours",
+				Usage:       "hand SMS scheduled at least this many hours ahead to the provider (0 to disable)",
+				EnvVars:     []string{"SMS_PROVIDER_SCHEDULE_MIN_HOURS"},
+				Destination: &config.SmsProviderScheduleMinHours,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-user-name",
+				Usage:       "Mitake user name",
+				EnvVars:     []string{"MITAKE_USER_NAME"},
+				Destination: &config.MitakeUserName,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-password",
+				Usage:       "Mitake password",
+				EnvVars:     []string{"MITAKE_PASSWORD"},
+				Destination: &config.MitakePassword,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-api-base-url",
+				Usage:       "Mitake API base URL (empty to use https://smsapi.mitake.com.tw)",
+				EnvVars:     []string{"MITAKE_API_BASE_URL"},
+				Destination: &config.MitakeApiBaseUrl,
+			},
+			&cli.IntFlag{
+				Name:        "sms-max-segments",
+				Usage:       "Reject SMS bodies longer than this many segments (0 for unlimited)",
+				EnvVars:     []string{"SMS_MAX_SEGMENTS"},
+				Value:       0,
+				Destination: &config.SmsMaxSegments,
+			},
+			&cli.StringFlag{
+				Name:        "sms-price-table",
+				Usage:       "JSON per-segment SMS price keyed by region code, * for other regions",
+				EnvVars:     []string{"SMS_PRICE_TABLE"},
+				Destination: &config.SmsPriceTable,
+			},
+			&cli.StringFlag{
+				Name:        "sms-price-currency",
+				Usage:       "Currency of the SMS price table",
+				EnvVars:     []string{"SMS_PRICE_CURRENCY"},
+				Value:       "TWD",
+				Destination: &config.SmsPriceCurrency,
+			},
 			&cli.IntFlag{
 				Name:        "sms-provider-batch-limit",
 				Usage:       "SMS provider batch limit",
@@ -130,6 +216,20 @@ func main() {
 				Value:       1000,
 				Destination: &config.MailProviderBatchLimit,
 			},
+			&cli.IntFlag{
+				Name:        "push-provider-api-batch-limit",
+				Usage:       "Push notification batch limit",
+				EnvVars:     []string{"PUSH_PROVIDER_API_BATCH_LIMIT"},
+				Value:       500,
+				Destination: &config.PushProviderBatchLimit,
+			},
+			&cli.IntFlag{
+				Name:        "line-provider-api-batch-limit",
+				Usage:       "LINE message batch limit",
+				EnvVars:     []string{"LINE_PROVIDER_API_BATCH_LIMIT"},
+				Value:       500,
+				Destination: &config.LineProviderBatchLimit,
+			},
 			&cli.StringFlag{
 				Name:        "kafka-broker",
 				Usage:       "Kafka broker",
@@ -142,6 +242,253 @@ func main() {
 				EnvVars:     []string{"KAFKA_VERSION"},
 				Destination: &config.KafkaVersion,
 			},
+			&cli.Int64Flag{
+				Name:        "sms-daily-quota",
+				Usage:       "SMS daily quota per client (0 for unlimited)",
+				EnvVars:     []string{"SMS_DAILY_QUOTA"},
+				Destination: &config.SmsDailyQuota,
+			},
+			&cli.Int64Flag{
+				Name:        "sms-monthly-quota",
+				Usage:       "SMS monthly quota per client (0 for unlimited)",
+				EnvVars:     []string{"SMS_MONTHLY_QUOTA"},
+				Destination: &config.SmsMonthlyQuota,
+			},
+			&cli.Float64Flag{
+				Name:        "sms-rate-per-second",
+				Usage:       "SMS send rate per second per client (0 for unlimited)",
+				EnvVars:     []string{"SMS_RATE_PER_SECOND"},
+				Destination: &config.SmsRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "sms-rate-burst",
+				Usage:       "SMS send rate burst per client",
+				EnvVars:     []string{"SMS_RATE_BURST"},
+				Destination: &config.SmsRateBurst,
+			},
+			&cli.Int64Flag{
+				Name:        "mail-daily-quota",
+				Usage:       "Mail daily quota per client (0 for unlimited)",
+				EnvVars:     []string{"MAIL_DAILY_QUOTA"},
+				Destination: &config.MailDailyQuota,
+			},
+			&cli.Int64Flag{
+				Name:        "mail-monthly-quota",
+				Usage:       "Mail monthly quota per client (0 for unlimited)",
+				EnvVars:     []string{"MAIL_MONTHLY_QUOTA"},
+				Destination: &config.MailMonthlyQuota,
+			},
+			&cli.Float64Flag{
+				Name:        "mail-rate-per-second",
+				Usage:       "Mail send rate per second per client (0 for unlimited)",
+				EnvVars:     []string{"MAIL_RATE_PER_SECOND"},
+				Destination: &config.MailRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "mail-rate-burst",
+				Usage:       "Mail send rate burst per client",
+				EnvVars:     []string{"MAIL_RATE_BURST"},
+				Destination: &config.MailRateBurst,
+			},
+			&cli.Int64Flag{
+				Name:        "push-daily-quota",
+				Usage:       "Push daily quota per client (0 for unlimited)",
+				EnvVars:     []string{"PUSH_DAILY_QUOTA"},
+				Destination: &config.PushDailyQuota,
+			},
+			&cli.Int64Flag{
+				Name:        "push-monthly-quota",
+				Usage:       "Push monthly quota per client (0 for unlimited)",
+				EnvVars:     []string{"PUSH_MONTHLY_QUOTA"},
+				Destination: &config.PushMonthlyQuota,
+			},
+			&cli.Float64Flag{
+				Name:        "push-rate-per-second",
+				Usage:       "Push send rate per second per client (0 for unlimited)",
+				EnvVars:     []string{"PUSH_RATE_PER_SECOND"},
+				Destination: &config.PushRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "push-rate-burst",
+				Usage:       "Push send rate burst per client",
+				EnvVars:     []string{"PUSH_RATE_BURST"},
+				Destination: &config.PushRateBurst,
+			},
+			&cli.Int64Flag{
+				Name:        "line-daily-quota",
+				Usage:       "LINE daily quota per client (0 for unlimited)",
+				EnvVars:     []string{"LINE_DAILY_QUOTA"},
+				Destination: &config.LineDailyQuota,
+			},
+			&cli.Int64Flag{
+				Name:        "line-monthly-quota",
+				Usage:       "LINE monthly quota per client (0 for unlimited)",
+				EnvVars:     []string{"LINE_MONTHLY_QUOTA"},
+				Destination: &config.LineMonthlyQuota,
+			},
+			&cli.Float64Flag{
+				Name:        "line-rate-per-second",
+				Usage:       "LINE send rate per second per client (0 for unlimited)",
+				EnvVars:     []string{"LINE_RATE_PER_SECOND"},
+				Destination: &config.LineRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "line-rate-burst",
+				Usage:       "LINE send rate burst per client",
+				EnvVars:     []string{"LINE_RATE_BURST"},
+				Destination: &config.LineRateBurst,
+			},
+			&cli.Int64Flag{
+				Name:        "webhook-daily-quota",
+				Usage:       "Webhook daily quota per client (0 for unlimited)",
+				EnvVars:     []string{"WEBHOOK_DAILY_QUOTA"},
+				Destination: &config.WebhookDailyQuota,
+			},
+			&cli.Int64Flag{
+				Name:        "webhook-monthly-quota",
+				Usage:       "Webhook monthly quota per client (0 for unlimited)",
+				EnvVars:     []string{"WEBHOOK_MONTHLY_QUOTA"},
+				Destination: &config.WebhookMonthlyQuota,
+			},
+			&cli.Float64Flag{
+				Name:        "webhook-rate-per-second",
+				Usage:       "Webhook send rate per second per client (0 for unlimited)",
+				EnvVars:     []string{"WEBHOOK_RATE_PER_SECOND"},
+				Destination: &config.WebhookRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "webhook-rate-burst",
+				Usage:       "Webhook send rate burst per client",
+				EnvVars:     []string{"WEBHOOK_RATE_BURST"},
+				Destination: &config.WebhookRateBurst,
+			},
+			&cli.IntFlag{
+				Name:        "otp-code-length",
+				Usage:       "OTP code length",
+				EnvVars:     []string{"OTP_CODE_LENGTH"},
+				Value:       6,
+				Destination: &config.OtpCodeLength,
+			},
+			&cli.IntFlag{
+				Name:        "otp-default-ttl-seconds",
+				Usage:       "OTP default time to live in seconds",
+				EnvVars:     []string{"OTP_DEFAULT_TTL_SECONDS"},
+				Value:       300,
+				Destination: &config.OtpDefaultTtlSeconds,
+			},
+			&cli.IntFlag{
+				Name:        "otp-max-ttl-seconds",
+				Usage:       "OTP max time to live in seconds",
+				EnvVars:     []string{"OTP_MAX_TTL_SECONDS"},
+				Value:       1800,
+				Destination: &config.OtpMaxTtlSeconds,
+			},
+			&cli.IntFlag{
+				Name:        "otp-max-attempts",
+				Usage:       "OTP max verify attempts",
+				EnvVars:     []string{"OTP_MAX_ATTEMPTS"},
+				Value:       5,
+				Destination: &config.OtpMaxAttempts,
+			},
+			&cli.IntFlag{
+				Name:        "otp-resend-cooldown-seconds",
+				Usage:       "OTP resend cooldown in seconds",
+				EnvVars:     []string{"OTP_RESEND_COOLDOWN_SECONDS"},
+				Value:       60,
+				Destination: &config.OtpResendCooldownSeconds,
+			},
+			&cli.StringFlag{
+				Name:        "otp-sms-template",
+				Usage:       "OTP SMS template, supports {code} and {minutes}",
+				EnvVars:     []string{"OTP_SMS_TEMPLATE"},
+				Value:       "您的驗證碼為 {code}，{minutes} 分鐘內有效。",
+				Destination: &config.OtpSmsTemplate,
+			},
+			&cli.StringFlag{
+				Name:        "otp-mail-subject",
+				Usage:       "OTP mail subject",
+				EnvVars:     []string{"OTP_MAIL_SUBJECT"},
+				Value:       "驗證碼通知",
+				Destination: &config.OtpMailSubject,
+			},
+			&cli.StringFlag{
+				Name:        "otp-mail-template",
+				Usage:       "OTP mail template, supports {code} and {minutes}",
+				EnvVars:     []string{"OTP_MAIL_TEMPLATE"},
+				Value:       "<p>您的驗證碼為 <b>{code}</b>，{minutes} 分鐘內有效。</p>",
+				Destination: &config.OtpMailTemplate,
+			},
+			&cli.StringFlag{
+				Name:        "sendgrid-webhook-verification-key",
+				Usage:       "SendGrid signed event webhook public key (empty to disable the webhook)",
+				EnvVars:     []string{"SENDGRID_WEBHOOK_VERIFICATION_KEY"},
+				Destination: &config.SendgridWebhookVerificationKey,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-auth-token",
+				Usage:       "Twilio auth token, used to verify status callback signatures",
+				EnvVars:     []string{"TWILIO_AUTH_TOKEN"},
+				Destination: &config.TwilioAuthToken,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-status-callback-url",
+				Usage:       "public URL of the Twilio status callback webhook, used to verify signatures (empty to disable the webhook)",
+				EnvVars:     []string{"TWILIO_STATUS_CALLBACK_URL"},
+				Destination: &config.TwilioStatusCallbackUrl,
+			},
+			&cli.StringFlag{
+				Name:        "line-channel-secret",
+				Usage:       "LINE channel secret for webhook signature verification (empty to disable the webhook)",
+				EnvVars:     []string{"LINE_CHANNEL_SECRET"},
+				Destination: &config.LineChannelSecret,
+			},
+			&cli.StringFlag{
+				Name:        "mitake-mo-token",
+				Usage:       "shared secret in the Mitake MO callback URL /webhooks/mitake/mo/{token} (empty to disable the webhook)",
+				EnvVars:     []string{"MITAKE_MO_TOKEN"},
+				Destination: &config.MitakeMoToken,
+			},
+			&cli.StringFlag{
+				Name:        "sms-opt-out-keywords",
+				Usage:       "SMS opt-out keywords per language in JSON",
+				EnvVars:     []string{"SMS_OPT_OUT_KEYWORDS"},
+				Value:       `{"en":["STOP","UNSUBSCRIBE"],"zh-TW":["退訂","取消"]}`,
+				Destination: &config.SmsOptOutKeywords,
+			},
+			&cli.BoolFlag{
+				Name:        "sms-opt-out-confirmation-enabled",
+				Usage:       "Send confirmation SMS after opt-out",
+				EnvVars:     []string{"SMS_OPT_OUT_CONFIRMATION_ENABLED"},
+				Destination: &config.SmsOptOutConfirmationEnabled,
+			},
+			&cli.StringFlag{
+				Name:        "sms-opt-out-confirmation-templates",
+				Usage:       "SMS opt-out confirmation content per language in JSON",
+				EnvVars:     []string{"SMS_OPT_OUT_CONFIRMATION_TEMPLATES"},
+				Value:       `{"en":"You have been unsubscribed and will no longer receive SMS from us.","zh-TW":"您已成功退訂，將不再收到本服務簡訊。"}`,
+				Destination: &config.SmsOptOutConfirmationTemplates,
+			},
+			&cli.StringFlag{
+				Name:        "quiet-hours-default-timezone",
+				Usage:       "Default receiver timezone for quiet hours when it cannot be inferred",
+				EnvVars:     []string{"QUIET_HOURS_DEFAULT_TIMEZONE"},
+				Value:       "Asia/Taipei",
+				Destination: &config.QuietHoursDefaultTimezone,
+			},
+			&cli.IntFlag{
+				Name:        "bulk-send-batch-size",
+				Usage:       "Number of CSV rows written per batch when uploading a bulk send",
+				EnvVars:     []string{"BULK_SEND_BATCH_SIZE"},
+				Value:       1000,
+				Destination: &config.BulkSendBatchSize,
+			},
+			&cli.StringFlag{
+				Name:        "error-locale",
+				Usage:       "Locale of validation error descriptions (en or zh-TW)",
+				EnvVars:     []string{"ERROR_LOCALE"},
+				Value:       "en",
+				Destination: &config.ErrorLocale,
+			},
 		},
 		Action: execute,
 	}
@@ -179,26 +526,65 @@ func execute(cCtx *cli.Context) error {
 			component.NewAesGcm,
 			component.NewDb,
 			component.NewValidator,
+			component.NewPhoneNumber,
 			component.NewProducer,
+			component.NewRateLimiter,
+			component.NewRestyClient,
+			component.NewClientAuth,
+			provideSmsCanceler,
 			fx.Annotate(
 				component.NewGrpcServer,
-				fx.ParamTags("", "", `group:"grpcServices"`),
+				fx.ParamTags("", "", "", `group:"grpcServices"`),
 			),
 			AsGrpcService(server.NewNotifyServer),
+			fx.Annotate(
+				component.NewHttpServer,
+				fx.ParamTags("", "", `group:"httpHandlers"`),
+			),
+			AsHttpHandler(webhook.NewSendgridWebhook),
+			AsHttpHandler(webhook.NewTwilioStatusWebhook),
+			AsHttpHandler(webhook.NewLineWebhook),
+			AsHttpHandler(webhook.NewMitakeMoWebhook),
 			fx.Annotate(
 				service.NewNotifyService,
 			),
+			service.NewQuotaService,
+			service.NewOtpService,
+			service.NewSuppressionService,
+			service.NewLineEventService,
+			service.NewQuietHoursService,
+			service.NewFrequencyCapService,
+			service.NewContactService,
+			service.NewBulkSendService,
+			service.NewSmsEstimateService,
+			service.NewInboundService,
+			service.NewSmsDeliveryService,
+			service.NewNotificationService,
 		),
 		fx.Invoke(
 			func(*tracesdk.TracerProvider) {},
 			func(*metricssdk.MeterProvider) {},
 			func(*grpc.Server) {},
+			func(*http.Server) {},
 			func(*gorm.DB) {},
 		),
 	).Run()
 	return nil
 }
 
+// 發送商不支援取消預約時回傳 nil，簡訊改由排程任務於預約時間發送
+func provideSmsCanceler(
+	config *shared.Config,
+	resty *resty.Client,
+) smser.SmsCanceler {
+	switch config.SmsProvider {
+	case "mitake":
+		return smser.NewMitakeSmser(config, resty)
+	default:
+		return nil
+	}
+}
+
 func AsGrpcService(f any) any {
 	return fx.Annotate(
 		f,
@@ -206,3 +592,11 @@ func AsGrpcService(f any) any {
 		fx.ResultTags(`group:"grpcServices"`),
 	)
 }
+
+func AsHttpHandler(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(component.HttpHandler)),
+		fx.ResultTags(`group:"httpHandlers"`),
+	)
+}
@@ -8,7 +8,9 @@ import (
 	component "notify-service/internal/components"
 	"notify-service/internal/consumer"
 	handler "notify-service/internal/handlers"
+	liner "notify-service/internal/liner"
 	mailer "notify-service/internal/mailer"
+	pusher "notify-service/internal/pusher"
 	service "notify-service/internal/services"
 	smser "notify-service/internal/smser"
 
@@ -115,15 +117,28 @@ func main() {
 				EnvVars:     []string{"AES_KEY"},
 				Destination: &config.AESKey,
 			},
+			&cli.StringFlag{
+				Name:        "phone-default-region",
+				Usage:       "Default region (ISO 3166-1 alpha-2) for phone numbers without a country code",
+				EnvVars:     []string{"PHONE_DEFAULT_REGION"},
+				Value:       "TW",
+				Destination: &config.PhoneDefaultRegion,
+			},
 			&cli.StringFlag{
 				Name:        "sms-provider",
-				Usage:       "SMS provider",
+				Usage:       "SMS provider (mitake/every8d/twilio)",
 				EnvVars:     []string{"SMS_PROVIDER"},
 				Destination: &config.SmsProvider,
 			},
+			&cli.StringFlag{
+				Name:        "sms-international-provider",
+				Usage:       "SMS provider for phone numbers outside the default region (empty to use sms-provider)",
+				EnvVars:     []string{"SMS_INTERNATIONAL_PROVIDER"},
+				Destination: &config.SmsInternationalProvider,
+			},
 			&cli.StringFlag{
 				Name:        "mail-provider",
-				Usage:       "Mail provider",
+				Usage:       "Mail provider (sendgrid/ses/mailgun)",
 				EnvVars:     []string{"MAIL_PROVIDER"},
 				Destination: &config.MailProvider,
 			},
@@ -146,12 +161,68 @@ func main() {
 				Value:       1,
 				Destination: &config.KafkaConsumerGroupInstanceNum,
 			},
+			&cli.IntFlag{
+				Name:        "kafka-high-consumer-group-instance-num",
+				Usage:       "Kafka high priority consumer group instance number",
+				EnvVars:     []string{"KAFKA_HIGH_CONSUMER_GROUP_INSTANCE_NUM"},
+				Value:       1,
+				Destination: &config.KafkaHighConsumerGroupInstanceNum,
+			},
+			&cli.IntFlag{
+				Name:        "kafka-bulk-consumer-group-instance-num",
+				Usage:       "Kafka bulk consumer group instance number",
+				EnvVars:     []string{"KAFKA_BULK_CONSUMER_GROUP_INSTANCE_NUM"},
+				Value:       1,
+				Destination: &config.KafkaBulkConsumerGroupInstanceNum,
+			},
 			&cli.StringFlag{
 				Name:        "sendgrid-api-token",
 				Usage:       "Sendgrid API Token",
 				EnvVars:     []string{"SENDGRID_TOKEN"},
 				Destination: &config.SendgridToken,
 			},
+			&cli.StringFlag{
+				Name:        "ses-region",
+				Usage:       "Amazon SES region, e.g. ap-northeast-1",
+				EnvVars:     []string{"SES_REGION"},
+				Destination: &config.SesRegion,
+			},
+			&cli.StringFlag{
+				Name:        "ses-access-key-id",
+				Usage:       "Amazon SES access key id",
+				EnvVars:     []string{"SES_ACCESS_KEY_ID"},
+				Destination: &config.SesAccessKeyId,
+			},
+			&cli.StringFlag{
+				Name:        "ses-secret-access-key",
+				Usage:       "Amazon SES secret access key",
+				EnvVars:     []string{"SES_SECRET_ACCESS_KEY"},
+				Destination: &config.SesSecretAccessKey,
+			},
+			&cli.StringFlag{
+				Name:        "ses-api-base-url",
+				Usage:       "Amazon SES API base URL (empty to use the regional endpoint)",
+				EnvVars:     []string{"SES_API_BASE_URL"},
+				Destination: &config.SesApiBaseUrl,
+			},
+			&cli.StringFlag{
+				Name:        "mailgun-domain",
+				Usage:       "Mailgun sending domain",
+				EnvVars:     []string{"MAILGUN_DOMAIN"},
+				Destination: &config.MailgunDomain,
+			},
+			&cli.StringFlag{
+				Name:        "mailgun-api-key",
+				Usage:       "Mailgun API key",
+				EnvVars:     []string{"MAILGUN_API_KEY"},
+				Destination: &config.MailgunApiKey,
+			},
+			&cli.StringFlag{
+				Name:        "mailgun-api-base-url",
+				Usage:       "Mailgun API base URL (empty to use https://api.mailgun.net)",
+				EnvVars:     []string{"MAILGUN_API_BASE_URL"},
+				Destination: &config.MailgunApiBaseUrl,
+			},
 			&cli.StringFlag{
 				Name:        "mitake-user-name",
 				Usage:       "Mitake user name",
@@ -164,6 +235,195 @@ func main() {
 				EnvVars:     []string{"MITAKE_PASSWORD"},
 				Destination: &config.MitakePassword,
 			},
+			&cli.StringFlag{
+				Name:        "mitake-api-base-url",
+				Usage:       "Mitake API base URL (empty to use https://smsapi.mitake.com.tw)",
+				EnvVars:     []string{"MITAKE_API_BASE_URL"},
+				Destination: &config.MitakeApiBaseUrl,
+			},
+			&cli.StringFlag{
+				Name:        "every8d-user-id",
+				Usage:       "EVERY8D user id",
+				EnvVars:     []string{"EVERY8D_USER_ID"},
+				Destination: &config.Every8dUserId,
+			},
+			&cli.StringFlag{
+				Name:        "every8d-password",
+				Usage:       "EVERY8D password",
+				EnvVars:     []string{"EVERY8D_PASSWORD"},
+				Destination: &config.Every8dPassword,
+			},
+			&cli.StringFlag{
+				Name:        "every8d-api-base-url",
+				Usage:       "EVERY8D API base URL (empty to use https://api.e8d.tw)",
+				EnvVars:     []string{"EVERY8D_API_BASE_URL"},
+				Destination: &config.Every8dApiBaseUrl,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-account-sid",
+				Usage:       "Twilio account SID",
+				EnvVars:     []string{"TWILIO_ACCOUNT_SID"},
+				Destination: &config.TwilioAccountSid,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-auth-token",
+				Usage:       "Twilio auth token",
+				EnvVars:     []string{"TWILIO_AUTH_TOKEN"},
+				Destination: &config.TwilioAuthToken,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-from-number",
+				Usage:       "Twilio sender phone number in E.164 format",
+				EnvVars:     []string{"TWILIO_FROM_NUMBER"},
+				Destination: &config.TwilioFromNumber,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-messaging-service-sid",
+				Usage:       "Twilio messaging service SID, used instead of twilio-from-number when set",
+				EnvVars:     []string{"TWILIO_MESSAGING_SERVICE_SID"},
+				Destination: &config.TwilioMessagingServiceSid,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-status-callback-url",
+				Usage:       "public URL of the Twilio status callback webhook (empty to disable)",
+				EnvVars:     []string{"TWILIO_STATUS_CALLBACK_URL"},
+				Destination: &config.TwilioStatusCallbackUrl,
+			},
+			&cli.StringFlag{
+				Name:        "twilio-api-base-url",
+				Usage:       "Twilio API base URL (empty to use https://api.twilio.com)",
+				EnvVars:     []string{"TWILIO_API_BASE_URL"},
+				Destination: &config.TwilioApiBaseUrl,
+			},
+			&cli.IntFlag{
+				Name:        "twilio-concurrency",
+				Usage:       "maximum concurrent Twilio requests per batch",
+				EnvVars:     []string{"TWILIO_CONCURRENCY"},
+				Value:       10,
+				Destination: &config.TwilioConcurrency,
+			},
+			&cli.Float64Flag{
+				Name:        "sms-provider-rate-per-second",
+				Usage:       "SMS recipients per second sent to the provider, shared by all workers (0 for unlimited)",
+				EnvVars:     []string{"SMS_PROVIDER_RATE_PER_SECOND"},
+				Destination: &config.SmsProviderRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "sms-provider-rate-burst",
+				Usage:       "SMS provider recipient burst",
+				EnvVars:     []string{"SMS_PROVIDER_RATE_BURST"},
+				Destination: &config.SmsProviderRateBurst,
+			},
+			&cli.Float64Flag{
+				Name:        "mail-provider-rate-per-second",
+				Usage:       "Mail recipients per second sent to the provider, shared by all workers (0 for unlimited)",
+				EnvVars:     []string{"MAIL_PROVIDER_RATE_PER_SECOND"},
+				Destination: &config.MailProviderRatePerSecond,
+			},
+			&cli.IntFlag{
+				Name:        "mail-provider-rate-burst",
+				Usage:       "Mail provider recipient burst",
+				EnvVars:     []string{"MAIL_PROVIDER_RATE_BURST"},
+				Destination: &config.MailProviderRateBurst,
+			},
+			&cli.StringFlag{
+				Name:        "webhook-signing-secret",
+				Usage:       "Secret for signing outbound webhook requests with HMAC-SHA256 (empty to disable signing)",
+				EnvVars:     []string{"WEBHOOK_SIGNING_SECRET"},
+				Destination: &config.WebhookSigningSecret,
+			},
+			&cli.IntFlag{
+				Name:        "webhook-timeout-seconds",
+				Usage:       "Outbound webhook request timeout in seconds",
+				EnvVars:     []string{"WEBHOOK_TIMEOUT_SECONDS"},
+				Value:       10,
+				Destination: &config.WebhookTimeoutSeconds,
+			},
+			&cli.StringFlag{
+				Name:        "webhook-allowed-cidrs",
+				Usage:       "Comma-separated CIDR ranges that outbound webhooks may reach even if private or loopback (e.g. 10.0.1.0/24)",
+				EnvVars:     []string{"WEBHOOK_ALLOWED_CIDRS"},
+				Destination: &config.WebhookAllowedCidrs,
+			},
+			&cli.StringFlag{
+				Name:        "push-provider",
+				Usage:       "Push notification provider (fcm or apns, empty to disable)",
+				EnvVars:     []string{"PUSH_PROVIDER"},
+				Destination: &config.PushProvider,
+			},
+			&cli.StringFlag{
+				Name:        "push-ios-provider",
+				Usage:       "Push provider for APNs device tokens of iOS devices (apns, empty to use push-provider)",
+				EnvVars:     []string{"PUSH_IOS_PROVIDER"},
+				Destination: &config.PushIosProvider,
+			},
+			&cli.IntFlag{
+				Name:        "push-concurrency",
+				Usage:       "Concurrent push requests per batch",
+				EnvVars:     []string{"PUSH_CONCURRENCY"},
+				Value:       10,
+				Destination: &config.PushConcurrency,
+			},
+			&cli.StringFlag{
+				Name:        "push-tls-ca-file",
+				Usage:       "Extra CA certificate trusted by push providers, for local TLS stand-ins",
+				EnvVars:     []string{"PUSH_TLS_CA_FILE"},
+				Destination: &config.PushTlsCaFile,
+			},
+			&cli.StringFlag{
+				Name:        "fcm-credentials-file",
+				Usage:       "FCM service account JSON key file",
+				EnvVars:     []string{"FCM_CREDENTIALS_FILE"},
+				Destination: &config.FcmCredentialsFile,
+			},
+			&cli.StringFlag{
+				Name:        "fcm-api-base-url",
+				Usage:       "FCM API base URL",
+				EnvVars:     []string{"FCM_API_BASE_URL"},
+				Destination: &config.FcmApiBaseUrl,
+			},
+			&cli.StringFlag{
+				Name:        "apns-key-file",
+				Usage:       "APNs auth key (.p8) file",
+				EnvVars:     []string{"APNS_KEY_FILE"},
+				Destination: &config.ApnsKeyFile,
+			},
+			&cli.StringFlag{
+				Name:        "apns-key-id",
+				Usage:       "APNs auth key ID",
+				EnvVars:     []string{"APNS_KEY_ID"},
+				Destination: &config.ApnsKeyId,
+			},
+			&cli.StringFlag{
+				Name:        "apns-team-id",
+				Usage:       "Apple developer team ID",
+				EnvVars:     []string{"APNS_TEAM_ID"},
+				Destination: &config.ApnsTeamId,
+			},
+			&cli.StringFlag{
+				Name:        "apns-topic",
+				Usage:       "APNs topic (app bundle ID)",
+				EnvVars:     []string{"APNS_TOPIC"},
+				Destination: &config.ApnsTopic,
+			},
+			&cli.StringFlag{
+				Name:        "apns-api-base-url",
+				Usage:       "APNs API base URL (https://api.sandbox.push.apple.com for development)",
+				EnvVars:     []string{"APNS_API_BASE_URL"},
+				Destination: &config.ApnsApiBaseUrl,
+			},
+			&cli.StringFlag{
+				Name:        "line-channel-access-token",
+				Usage:       "LINE channel access token, empty to disable",
+				EnvVars:     []string{"LINE_CHANNEL_ACCESS_TOKEN"},
+				Destination: &config.LineChannelAccessToken,
+			},
+			&cli.StringFlag{
+				Name:        "line-api-base-url",
+				Usage:       "LINE Messaging API base URL",
+				EnvVars:     []string{"LINE_API_BASE_URL"},
+				Destination: &config.LineApiBaseUrl,
+			},
 		},
 		Action: execute,
 	}
@@ -202,11 +462,19 @@ func execute(cCtx *cli.Context) error {
 			component.NewDb,
 			component.NewConsumerGroup,
 			component.NewRestyClient,
+			component.NewDbRateLimiter,
+			component.NewPhoneNumber,
 			consumer.NewConsumer,
 			provideSmsProvider,
 			provideMailProvider,
+			providePushProvider,
+			provideLineProvider,
 			service.NewSmsService,
 			service.NewMailService,
+			service.NewPushService,
+			service.NewLineService,
+			service.NewWebhookService,
+			service.NewSuppressionService,
 		),
 		fx.Invoke(
 			func(*tracesdk.TracerProvider) {},
@@ -215,6 +483,9 @@ func execute(cCtx *cli.Context) error {
 			func([]sarama.ConsumerGroup) {},
 			registerSmsHandler,
 			registerMailHandler,
+			registerPushHandler,
+			registerLineHandler,
+			registerWebhookHandler,
 		),
 	).Run()
 	return nil
@@ -223,12 +494,15 @@ func execute(cCtx *cli.Context) error {
 func registerSmsHandler(
 	consumer *consumer.Consumer,
 	smsService *service.SmsService,
+	suppressionService *service.SuppressionService,
 	db *gorm.DB,
 	config *shared.Config,
 	snowflake *snowflake.Node,
 ) {
-	handler := handler.NewSmsHandler(db, config, snowflake, smsService)
+	handler := handler.NewSmsHandler(db, config, snowflake, smsService, suppressionService)
+	consumer.RegisterHandler(shared.KafkaTopicSmsHigh, handler)
 	consumer.RegisterHandler(shared.KafkaTopicSms, handler)
+	consumer.RegisterHandler(shared.KafkaTopicSmsBulk, handler)
 }
 
 func registerMailHandler(
@@ -239,23 +513,167 @@ func registerMailHandler(
 	snowflake *snowflake.Node,
 ) {
 	handler := handler.NewMailHandler(db, config, snowflake, mailService)
+	consumer.RegisterHandler(shared.KafkaTopicMailHigh, handler)
 	consumer.RegisterHandler(shared.KafkaTopicMail, handler)
+	consumer.RegisterHandler(shared.KafkaTopicMailBulk, handler)
+}
+
+func registerPushHandler(
+	consumer *consumer.Consumer,
+	pushService *service.PushService,
+	suppressionService *service.SuppressionService,
+	db *gorm.DB,
+	config *shared.Config,
+	snowflake *snowflake.Node,
+) {
+	handler := handler.NewPushHandler(db, config, snowflake, pushService, suppressionService)
+	consumer.RegisterHandler(shared.KafkaTopicPushHigh, handler)
+	consumer.RegisterHandler(shared.KafkaTopicPush, handler)
+	consumer.RegisterHandler(shared.KafkaTopicPushBulk, handler)
+}
+
+func registerLineHandler(
+	consumer *consumer.Consumer,
+	lineService *service.LineService,
+	db *gorm.DB,
+	config *shared.Config,
+	snowflake *snowflake.Node,
+) {
+	handler := handler.NewLineHandler(db, config, snowflake, lineService)
+	consumer.RegisterHandler(shared.KafkaTopicLineHigh, handler)
+	consumer.RegisterHandler(shared.KafkaTopicLine, handler)
+	consumer.RegisterHandler(shared.KafkaTopicLineBulk, handler)
+}
+
+func registerWebhookHandler(
+	consumer *consumer.Consumer,
+	webhookService *service.WebhookService,
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+) {
+	handler := handler.NewWebhookHandler(db, snowflake, webhookService)
+	consumer.RegisterHandler(shared.KafkaTopicWebhook, handler)
+}
+
+func provideSmsProvider(
+	config *shared.Config,
+	resty *resty.Client,
+	limiter *component.DbRateLimiter,
+	phoneNumber *component.PhoneNumber,
+) (smser.SmsProvider, error) {
+	provider, err := newSmsProvider(config, resty, limiter, config.SmsProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// 設定國際發送商時，預設地區以外的門號改由國際發送商發送
+	if config.SmsInternationalProvider == "" || config.SmsInternationalProvider == config.SmsProvider {
+		return provider, nil
+	}
+	internationalProvider, err := newSmsProvider(config, resty, limiter, config.SmsInternationalProvider)
+	if err != nil {
+		return nil, err
+	}
+	return smser.NewRoutingSmser(
+		provider,
+		config.SmsProvider,
+		internationalProvider,
+		config.SmsInternationalProvider,
+		phoneNumber,
+	), nil
 }
 
-func provideSmsProvider(config *shared.Config, resty *resty.Client) (smser.SmsProvider, error) {
-	switch config.SmsProvider {
+func newSmsProvider(
+	config *shared.Config,
+	resty *resty.Client,
+	limiter *component.DbRateLimiter,
+	name string,
+) (smser.SmsProvider, error) {
+	var provider smser.SmsProvider
+	switch name {
 	case "mitake":
-		return smser.NewMitakeSmser(config, resty), nil
+		provider = smser.NewMitakeSmser(config, resty)
+	case "twilio":
+		provider = smser.NewTwilioSmser(config, resty)
+	case "every8d":
+		provider = smser.NewEvery8dSmser(config, resty)
 	default:
-		return nil, fmt.Errorf("unsupported SMS provider type: %s", config.SmsProvider)
+		return nil, fmt.Errorf("unsupported SMS provider type: %s", name)
 	}
+	return smser.NewRateLimitedSmser(
+		provider,
+		limiter,
+		name,
+		config.SmsProviderRatePerSecond,
+		config.SmsProviderRateBurst,
+	), nil
 }
 
-func provideMailProvider(config *shared.Config) (mailer.MailProvider, error) {
+func provideMailProvider(
+	config *shared.Config,
+	resty *resty.Client,
+	limiter *component.DbRateLimiter,
+) (mailer.MailProvider, error) {
+	var provider mailer.MailProvider
 	switch config.MailProvider {
 	case "sendgrid":
-		return mailer.NewSendGridMailer(config), nil
+		provider = mailer.NewSendGridMailer(config)
+	case "ses":
+		provider = mailer.NewSesMailer(config, resty)
+	case "mailgun":
+		provider = mailer.NewMailgunMailer(config, resty)
 	default:
 		return nil, fmt.Errorf("unsupported mail provider type: %s", config.MailProvider)
 	}
+	return mailer.NewRateLimitedMailer(
+		provider,
+		limiter,
+		config.MailProvider,
+		config.MailProviderRatePerSecond,
+		config.MailProviderRateBurst,
+	), nil
+}
+
+// 未設定推播發送商時不建立發送商，推播訊息一律視為發送失敗
+func providePushProvider(config *shared.Config) (pusher.PushProvider, error) {
+	provider, err := newPushProvider(config, config.PushProvider)
+	if err != nil || provider == nil {
+		return provider, err
+	}
+
+	// 設定 iOS 發送商時，APNs device token 改由 iOS 發送商發送，其餘裝置使用預設發送商
+	if config.PushIosProvider == "" || config.PushIosProvider == config.PushProvider {
+		return provider, nil
+	}
+	iosProvider, err := newPushProvider(config, config.PushIosProvider)
+	if err != nil {
+		return nil, err
+	}
+	return pusher.NewRoutingPusher(
+		provider,
+		config.PushProvider,
+		iosProvider,
+		config.PushIosProvider,
+	), nil
+}
+
+func newPushProvider(config *shared.Config, name string) (pusher.PushProvider, error) {
+	switch name {
+	case "":
+		return nil, nil
+	case "fcm":
+		return pusher.NewFcmPusher(config)
+	case "apns":
+		return pusher.NewApnsPusher(config)
+	default:
+		return nil, fmt.Errorf("unsupported push provider type: %s", name)
+	}
+}
+
+// 未設定 Channel Access Token 時不建立發送商，LINE 訊息一律視為發送失敗
+func provideLineProvider(config *shared.Config, resty *resty.Client) liner.LineProvider {
+	if config.LineChannelAccessToken == "" {
+		return nil
+	}
+	return liner.NewMessagingApiLiner(config, resty)
 }
@@ -0,0 +1,122 @@
+package component
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	shared "notify-service/internal"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	API_KEY_METADATA_KEY = "x-api-key"
+
+	// 不需驗證的 gRPC 服務（反射）
+	grpcReflectionServicePrefix = "/grpc.reflection."
+)
+
+type clientIdContextKey struct{}
+
+// ClientAuth 以 API key 驗證呼叫端身分，驗證後的呼叫端識別碼存於 context；
+// 未設定 CLIENT_API_KEYS 時不驗證，由前方的 gateway 驗證後帶入 x-client-id
+type ClientAuth struct {
+	apiKeys map[string]string // 呼叫端識別碼對應的 API key
+}
+
+func NewClientAuth(config *shared.Config) (*ClientAuth, error) {
+	apiKeys := make(map[string]string)
+	if config.ClientApiKeys != "" {
+		if err := json.Unmarshal([]byte(config.ClientApiKeys), &apiKeys); err != nil {
+			return nil, fmt.Errorf("invalid client api keys: %w", err)
+		}
+	}
+	for clientId, apiKey := range apiKeys {
+		if clientId == "" || apiKey == "" {
+			return nil, fmt.Errorf("invalid client api keys: client id and api key must not be empty")
+		}
+	}
+
+	if len(apiKeys) == 0 {
+		log.Warn("CLIENT_API_KEYS is not set, x-client-id metadata is trusted and must be set by an authenticating gateway")
+	}
+	return &ClientAuth{apiKeys: apiKeys}, nil
+}
+
+// GetAuthenticatedClientId 取得以 API key 驗證的呼叫端識別碼，未驗證時回傳 false
+func GetAuthenticatedClientId(ctx context.Context) (string, bool) {
+	clientId, ok := ctx.Value(clientIdContextKey{}).(string)
+	return clientId, ok
+}
+
+func (a *ClientAuth) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *ClientAuth) StreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+func (a *ClientAuth) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if len(a.apiKeys) == 0 || strings.HasPrefix(fullMethod, grpcReflectionServicePrefix) {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(API_KEY_METADATA_KEY)
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing api key")
+	}
+
+	clientId, ok := a.getClientId(values[0])
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return context.WithValue(ctx, clientIdContextKey{}, clientId), nil
+}
+
+// 逐一以固定時間比對，避免以回應時間推測 API key
+func (a *ClientAuth) getClientId(apiKey string) (string, bool) {
+	matched := ""
+	for clientId, key := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(key)) == 1 {
+			matched = clientId
+		}
+	}
+	return matched, matched != ""
+}
+
+// 串流以驗證後的 context 交給服務處理
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,66 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	shared "notify-service/internal"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientAuthUnaryInterceptor(t *testing.T) {
+	auth, err := NewClientAuth(&shared.Config{ClientApiKeys: `{"shop":"shop-key","crm":"crm-key"}`})
+	if err != nil {
+		t.Fatalf("new client auth: %v", err)
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		clientId, _ := GetAuthenticatedClientId(ctx)
+		return clientId, nil
+	}
+	call := func(fullMethod string, pairs ...string) (interface{}, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+		return auth.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+	}
+
+	clientId, err := call("/notify.v1.NotifyService/SendSms", API_KEY_METADATA_KEY, "crm-key", "x-client-id", "shop")
+	if err != nil || clientId != "crm" {
+		t.Fatalf("valid key = %v, %v, want crm", clientId, err)
+	}
+
+	for name, pairs := range map[string][]string{
+		"missing key": {"x-client-id", "shop"},
+		"wrong key":   {API_KEY_METADATA_KEY, "shop-key-2"},
+	} {
+		if _, err := call("/notify.v1.NotifyService/SendSms", pairs...); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: code = %s, want %s", name, status.Code(err), codes.Unauthenticated)
+		}
+	}
+
+	if _, err := call("/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"); err != nil {
+		t.Errorf("reflection: %v", err)
+	}
+}
+
+// 未設定 API key 時不驗證，呼叫端識別碼由 gateway 帶入的 x-client-id 決定
+func TestClientAuthDisabled(t *testing.T) {
+	auth, err := NewClientAuth(&shared.Config{})
+	if err != nil {
+		t.Fatalf("new client auth: %v", err)
+	}
+
+	_, err = auth.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/notify.v1.NotifyService/SendSms"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			if _, ok := GetAuthenticatedClientId(ctx); ok {
+				t.Error("client id is authenticated without api keys")
+			}
+			return nil, nil
+		})
+	if err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+}
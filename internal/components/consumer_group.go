@@ -15,14 +15,34 @@ import (
 	"go.uber.org/fx"
 )
 
+// 各優先級使用獨立的 consumer group 與 topic，避免大量發送佔用高優先級的消費能力
+type consumerLane struct {
+	groupId     string
+	topics      []string
+	instanceNum int
+}
+
 func NewConsumerGroup(
 	lc fx.Lifecycle,
 	config *shared.Config,
 	consumer *consumer.Consumer,
 ) []sarama.ConsumerGroup {
-	topics := []string{
-		shared.KafkaTopicSms,
-		shared.KafkaTopicMail,
+	lanes := []consumerLane{
+		{
+			groupId:     shared.KafkaGroupIdNotifyHigh,
+			topics:      []string{shared.KafkaTopicSmsHigh, shared.KafkaTopicMailHigh, shared.KafkaTopicPushHigh, shared.KafkaTopicLineHigh},
+			instanceNum: config.KafkaHighConsumerGroupInstanceNum,
+		},
+		{
+			groupId:     shared.KafkaGroupIdNotify,
+			topics:      []string{shared.KafkaTopicSms, shared.KafkaTopicMail, shared.KafkaTopicPush, shared.KafkaTopicLine, shared.KafkaTopicWebhook},
+			instanceNum: config.KafkaConsumerGroupInstanceNum,
+		},
+		{
+			groupId:     shared.KafkaGroupIdNotifyBulk,
+			topics:      []string{shared.KafkaTopicSmsBulk, shared.KafkaTopicMailBulk, shared.KafkaTopicPushBulk, shared.KafkaTopicLineBulk},
+			instanceNum: config.KafkaBulkConsumerGroupInstanceNum,
+		},
 	}
 	version, err := sarama.ParseKafkaVersion(config.KafkaVersion)
 	if err != nil {
@@ -38,38 +58,41 @@ func NewConsumerGroup(
 	log.Infof("Consumer connecting to Kafka broker at %s", config.KafkaBrokers)
 	var consumerGroups []sarama.ConsumerGroup
 
-	for i := 0; i < config.KafkaConsumerGroupInstanceNum; i++ {
-		consumerGroup, err := sarama.NewConsumerGroup(
-			strings.Split(config.KafkaBrokers, ","),
-			shared.KafkaGroupIdNotify,
-			consumerConfig,
-		)
-		if err != nil {
-			log.WithError(err).Fatalf("Error creating consumer group client: %v", err)
-		}
-		consumerGroups = append(consumerGroups, consumerGroup)
+	for _, lane := range lanes {
+		for i := 0; i < lane.instanceNum; i++ {
+			consumerGroup, err := sarama.NewConsumerGroup(
+				strings.Split(config.KafkaBrokers, ","),
+				lane.groupId,
+				consumerConfig,
+			)
+			if err != nil {
+				log.WithError(err).Fatalf("Error creating consumer group client: %v", err)
+			}
+			consumerGroups = append(consumerGroups, consumerGroup)
 
-		lc.Append(fx.Hook{
-			OnStart: func(ctx context.Context) error {
-				go func() {
-					propagators := propagation.TraceContext{}
-					consumer := otelsarama.WrapConsumerGroupHandler(consumer, otelsarama.WithPropagators(propagators))
-					for {
-						err := consumerGroup.Consume(context.Background(), topics, consumer)
-						if err != nil {
-							if errors.Is(err, sarama.ErrClosedConsumerGroup) {
-								return
+			topics := lane.topics
+			lc.Append(fx.Hook{
+				OnStart: func(ctx context.Context) error {
+					go func() {
+						propagators := propagation.TraceContext{}
+						consumer := otelsarama.WrapConsumerGroupHandler(consumer, otelsarama.WithPropagators(propagators))
+						for {
+							err := consumerGroup.Consume(context.Background(), topics, consumer)
+							if err != nil {
+								if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+									return
+								}
+								log.Panicf("Error from consumer: %v", err)
 							}
-							log.Panicf("Error from consumer: %v", err)
 						}
-					}
-				}()
-				return nil
-			},
-			OnStop: func(ctx context.Context) error {
-				return consumerGroup.Close()
-			},
-		})
+					}()
+					return nil
+				},
+				OnStop: func(ctx context.Context) error {
+					return consumerGroup.Close()
+				},
+			})
+		}
 	}
 
 	return consumerGroups
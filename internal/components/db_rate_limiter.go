@@ -0,0 +1,93 @@
+package component
+
+import (
+	"context"
+	"math"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	dbRateLimiterMinWait = 10 * time.Millisecond
+	dbRateLimiterMaxWait = 5 * time.Second
+)
+
+// DbRateLimiter 以 Postgres 儲存令牌桶，讓多個 worker 實例共用同一份發送額度
+type DbRateLimiter struct {
+	db *gorm.DB
+}
+
+func NewDbRateLimiter(db *gorm.DB) *DbRateLimiter {
+	return &DbRateLimiter{db: db}
+}
+
+// Wait 阻塞直到取得 n 個令牌或 ctx 結束
+func (l *DbRateLimiter) Wait(ctx context.Context, key string, n int, ratePerSecond float64, burst int) error {
+	if ratePerSecond <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(ratePerSecond))
+	}
+
+	for {
+		ok, wait, err := l.reserve(ctx, key, n, ratePerSecond, burst)
+		if err != nil {
+			// 額度查詢失敗時同樣退避重試，避免直接讓佇列失敗
+			log.WithContext(ctx).WithError(err).Warnf("reserve rate limit %s failed", key)
+			wait = dbRateLimiterMaxWait
+		} else if ok {
+			return nil
+		} else {
+			log.WithContext(ctx).Debugf("rate limit %s reached, back off %s", key, wait)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (l *DbRateLimiter) reserve(ctx context.Context, key string, n int, ratePerSecond float64, burst int) (bool, time.Duration, error) {
+	// 單次請求超過桶容量時，以桶容量判斷是否可取得
+	need := math.Min(float64(n), float64(burst))
+
+	// 依經過時間補充令牌後原子性扣除，令牌不足時不更新
+	var tokens []float64
+	err := l.db.WithContext(ctx).Raw(`
+		INSERT INTO notify.rate_limit_buckets AS b (key, tokens, updated_at)
+		VALUES (?, ?, LOCALTIMESTAMP)
+		ON CONFLICT (key) DO UPDATE
+		SET tokens = LEAST(?, b.tokens + EXTRACT(EPOCH FROM (LOCALTIMESTAMP - b.updated_at)) * ?) - ?,
+			updated_at = LOCALTIMESTAMP
+		WHERE LEAST(?, b.tokens + EXTRACT(EPOCH FROM (LOCALTIMESTAMP - b.updated_at)) * ?) >= ?
+		RETURNING b.tokens`,
+		key, float64(burst)-float64(n),
+		burst, ratePerSecond, n,
+		burst, ratePerSecond, need,
+	).Scan(&tokens).Error
+	if err != nil {
+		return false, 0, err
+	}
+	if len(tokens) > 0 {
+		return true, 0, nil
+	}
+
+	var available float64
+	err = l.db.WithContext(ctx).Raw(`
+		SELECT LEAST(?, tokens + EXTRACT(EPOCH FROM (LOCALTIMESTAMP - updated_at)) * ?)
+		FROM notify.rate_limit_buckets
+		WHERE key = ?`,
+		burst, ratePerSecond, key,
+	).Scan(&available).Error
+	if err != nil {
+		return false, 0, err
+	}
+
+	wait := time.Duration((need - available) / ratePerSecond * float64(time.Second))
+	return false, max(dbRateLimiterMinWait, min(wait, dbRateLimiterMaxWait)), nil
+}
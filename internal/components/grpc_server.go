@@ -18,11 +18,13 @@ import (
 func NewGrpcServer(
 	lc fx.Lifecycle,
 	config *shared.Config,
+	clientAuth *ClientAuth,
 	grpcServices []GrpcService,
 ) *grpc.Server {
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.UnaryInterceptor(traceIDInterceptor),
+		grpc.ChainUnaryInterceptor(traceIDInterceptor, clientAuth.UnaryInterceptor),
+		grpc.StreamInterceptor(clientAuth.StreamInterceptor),
 	)
 	for _, svc := range grpcServices {
 		svc.Register(grpcServer)
@@ -0,0 +1,52 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	shared "notify-service/internal"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.uber.org/fx"
+)
+
+// NewHttpServer 提供發送商回呼 (webhook) 使用的 HTTP 服務
+func NewHttpServer(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	httpHandlers []HttpHandler,
+) *http.Server {
+	mux := http.NewServeMux()
+	for _, h := range httpHandlers {
+		h.Register(mux)
+	}
+
+	httpServer := &http.Server{
+		Addr:    fmt.Sprintf(":%d", config.HttpPort),
+		Handler: otelhttp.NewHandler(mux, config.OtlpServiceName),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				log.Infof("http server listening at %v", httpServer.Addr)
+
+				err := httpServer.ListenAndServe()
+				if err != nil && !errors.Is(err, http.ErrServerClosed) {
+					log.WithError(err).Fatal("Error starting http server")
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return httpServer.Shutdown(ctx)
+		},
+	})
+	return httpServer
+}
+
+type HttpHandler interface {
+	Register(mux *http.ServeMux)
+}
@@ -0,0 +1,74 @@
+package component
+
+import (
+	"fmt"
+	shared "notify-service/internal"
+	"strings"
+
+	"github.com/ttacon/libphonenumber"
+)
+
+const defaultPhoneRegion = "TW"
+
+// PhoneNumber 解析手機號碼並正規化為 E.164 格式，未帶國碼的號碼以預設地區解析
+type PhoneNumber struct {
+	defaultRegion string
+}
+
+func NewPhoneNumber(
+	config *shared.Config,
+) *PhoneNumber {
+	region := strings.ToUpper(strings.TrimSpace(config.PhoneDefaultRegion))
+	if region == "" {
+		region = defaultPhoneRegion
+	}
+	return &PhoneNumber{
+		defaultRegion: region,
+	}
+}
+
+// Normalize 依國家號碼規則驗證為手機門號後回傳 E.164 格式，例如 0912345678 轉為 +886912345678
+func (p *PhoneNumber) Normalize(phone string) (string, error) {
+	number, err := p.parse(phone)
+	if err != nil {
+		return "", err
+	}
+	return libphonenumber.Format(number, libphonenumber.E164), nil
+}
+
+func (p *PhoneNumber) IsValid(phone string) bool {
+	_, err := p.parse(phone)
+	return err == nil
+}
+
+// GetRegion 回傳號碼所屬的地區代碼，例如 TW、JP，無法解析時回傳空字串
+func (p *PhoneNumber) GetRegion(phone string) string {
+	number, err := libphonenumber.Parse(strings.TrimSpace(phone), p.defaultRegion)
+	if err != nil {
+		return ""
+	}
+	return libphonenumber.GetRegionCodeForNumber(number)
+}
+
+// IsDomestic 號碼是否屬於預設地區
+func (p *PhoneNumber) IsDomestic(phone string) bool {
+	return p.GetRegion(phone) == p.defaultRegion
+}
+
+func (p *PhoneNumber) parse(phone string) (*libphonenumber.PhoneNumber, error) {
+	number, err := libphonenumber.Parse(strings.TrimSpace(phone), p.defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	if !libphonenumber.IsValidNumber(number) {
+		return nil, fmt.Errorf("invalid phone number")
+	}
+
+	switch libphonenumber.GetNumberType(number) {
+	case libphonenumber.MOBILE, libphonenumber.FIXED_LINE_OR_MOBILE:
+		return number, nil
+	default:
+		return nil, fmt.Errorf("phone number is not a mobile number")
+	}
+}
@@ -0,0 +1,82 @@
+package component
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// 清除閒置令牌桶的間隔
+const rateLimiterSweepInterval = time.Minute
+
+// 令牌桶
+type tokenBucket struct {
+	tokens   float64
+	updateAt time.Time
+	fullAt   time.Time // 令牌補滿的時間，之後與新建的令牌桶相同，可直接清除
+}
+
+// RateLimiter 以 key 區分的記憶體令牌桶限流器，已補滿的閒置令牌桶定期清除，避免 key 數量無限增長
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	sweepAt time.Time
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Reserve 嘗試取得 n 個令牌，失敗時回傳需等待的時間
+func (l *RateLimiter) Reserve(key string, n int, ratePerSecond float64, burst int) (bool, time.Duration) {
+	if ratePerSecond <= 0 {
+		return true, 0
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(ratePerSecond))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(burst), updateAt: now}
+		l.buckets[key] = bucket
+	}
+
+	// 依經過時間補充令牌，不超過桶容量
+	elapsed := now.Sub(bucket.updateAt).Seconds()
+	bucket.tokens = math.Min(float64(burst), bucket.tokens+elapsed*ratePerSecond)
+	bucket.updateAt = now
+
+	// 單次請求超過桶容量時，以桶容量計算等待時間
+	need := math.Min(float64(n), float64(burst))
+	if bucket.tokens >= need {
+		bucket.tokens -= float64(n)
+		bucket.fullAt = now.Add(time.Duration((float64(burst) - bucket.tokens) / ratePerSecond * float64(time.Second)))
+		return true, 0
+	}
+
+	wait := (need - bucket.tokens) / ratePerSecond
+	return false, time.Duration(wait * float64(time.Second))
+}
+
+// 清除已補滿的令牌桶，須在持有鎖時呼叫
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Before(l.sweepAt) {
+		return
+	}
+	l.sweepAt = now.Add(rateLimiterSweepInterval)
+
+	for key, bucket := range l.buckets {
+		if !now.Before(bucket.fullAt) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -1,27 +1,43 @@
 package component
 
 import (
-	"encoding/json"
 	"fmt"
 	shared "notify-service/internal"
+	cus_error "notify-service/internal/errors"
 	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	errorpb "proto/pkg/notify/v1/error"
+	"reflect"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 )
 
+// LINE 使用者 ID 為 U 開頭加上 32 位十六進位字元
+var lineUserIdPattern = regexp.MustCompile(`^U[0-9a-f]{32}$`)
+
 type Validator struct {
 	config   *shared.Config
 	validate *validator.Validate
 }
 
-func NewValidator(config *shared.Config) *Validator {
+func NewValidator(config *shared.Config, phoneNumber *PhoneNumber) *Validator {
 	validate := validator.New()
+	validate.RegisterTagNameFunc(getJsonFieldName)
 	validate.RegisterValidation("regexp", func(fl validator.FieldLevel) bool {
 		re := regexp.MustCompile(fl.Param())
 		return re.MatchString(fl.Field().String())
 	})
+	// 依國家號碼規則驗證手機門號，未帶國碼時以預設地區解析
+	validate.RegisterValidation("phone", func(fl validator.FieldLevel) bool {
+		return phoneNumber.IsValid(fl.Field().String())
+	})
+	validate.RegisterValidation("line_user_id", func(fl validator.FieldLevel) bool {
+		return lineUserIdPattern.MatchString(fl.Field().String())
+	})
 	return &Validator{
 		config:   config,
 		validate: validate,
@@ -29,10 +45,63 @@ func NewValidator(config *shared.Config) *Validator {
 }
 
 func (v *Validator) CheckSendSmsRequest(req model.SendSmsRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+	if err := v.checkSmsSegments(req.Body); err != nil {
+		return err
+	}
+	return v.checkFallback(req.Fallback, req.Receivers, req.GroupIds)
+}
+
+func (v *Validator) CheckEstimateSmsRequest(req model.EstimateSmsRequest) error {
 	return v.validateRequest(req)
 }
 
+// 設定分段數上限時，拒絕超過上限的簡訊內容
+func (v *Validator) checkSmsSegments(body string) error {
+	if v.config.SmsMaxSegments <= 0 {
+		return nil
+	}
+
+	if util.AnalyzeSms(body).Segments > v.config.SmsMaxSegments {
+		return v.newValidationError(v.newFieldViolation("sms.body", "max_segments", strconv.Itoa(v.config.SmsMaxSegments)))
+	}
+	return nil
+}
+
 func (v *Validator) CheckSendMailRequest(req model.SendMailRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+	return v.checkFallback(req.Fallback, req.Receivers, req.GroupIds)
+}
+
+func (v *Validator) CheckSendPushRequest(req model.SendPushRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+	return v.checkFallback(req.Fallback, req.Receivers, nil)
+}
+
+func (v *Validator) CheckSendLineRequest(req model.SendLineRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+	return v.checkFallback(req.Fallback, req.Receivers, nil)
+}
+
+func (v *Validator) CheckSendNotificationRequest(req model.SendNotificationRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+	if req.Sms == nil {
+		return nil
+	}
+	return v.checkSmsSegments(req.Sms.Body)
+}
+
+func (v *Validator) CheckSendWebhookRequest(req model.SendWebhookRequest) error {
 	return v.validateRequest(req)
 }
 
@@ -40,10 +109,160 @@ func (v *Validator) CheckCancelScheduledByMessageIdRequest(req model.CancelSched
 	return v.validateRequest(req)
 }
 
+func (v *Validator) CheckGetMessageRequest(req model.GetMessageRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckCancelNotificationRequest(req model.CancelNotificationRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckGetNotificationRequest(req model.GetNotificationRequest) error {
+	return v.validateRequest(req)
+}
+
 func (v *Validator) CheckListStatusWithPagingRequest(req model.ListStatusWithPagingRequest) error {
 	return v.validateRequest(req)
 }
 
+func (v *Validator) CheckRequestOtpRequest(req model.RequestOtpRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+
+	return v.checkReceiver(req.Channel, req.Receiver)
+}
+
+func (v *Validator) CheckVerifyOtpRequest(req model.VerifyOtpRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckListSuppressionsRequest(req model.ListSuppressionsRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckAddSuppressionRequest(req model.AddSuppressionRequest) error {
+	if err := v.validateRequest(req); err != nil {
+		return err
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return v.newValidationError(v.newFieldViolation("expires_at", "future", ""))
+	}
+	return v.checkReceiver(req.Channel, req.Receiver)
+}
+
+func (v *Validator) CheckRemoveSuppressionRequest(req model.RemoveSuppressionRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckListInboundMessagesWithPagingRequest(req model.ListInboundMessagesWithPagingRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckContactRequest(req model.ContactRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckUpdateContactRequest(req model.UpdateContactRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckDeleteContactRequest(req model.DeleteContactRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckListContactsWithPagingRequest(req model.ListContactsWithPagingRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckImportContactsRequest(req model.ImportContactsRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckCreateGroupRequest(req model.CreateGroupRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckUpdateGroupRequest(req model.UpdateGroupRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckDeleteGroupRequest(req model.DeleteGroupRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckListGroupsWithPagingRequest(req model.ListGroupsWithPagingRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckGroupMembersRequest(req model.GroupMembersRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckBulkSendHeaderRequest(req model.BulkSendHeaderRequest) error {
+	return v.validateRequest(req)
+}
+
+// CheckBulkSendReceiver 以與單筆發送相同的規則驗證批量發送的收件者
+func (v *Validator) CheckBulkSendReceiver(channel model.MessageType, receiver string) error {
+	return v.checkReceiver(channel, receiver)
+}
+
+func (v *Validator) CheckGetBulkSendJobRequest(req model.GetBulkSendJobRequest) error {
+	return v.validateRequest(req)
+}
+
+func (v *Validator) CheckDownloadBulkSendReportRequest(req model.DownloadBulkSendReportRequest) error {
+	return v.validateRequest(req)
+}
+
+// 備援通道的收件者須與原請求的收件者一一對應，因此不可與聯絡人群組併用
+func (v *Validator) checkFallback(fallback *model.FallbackPolicy, receivers []string, groupIds []string) error {
+	if fallback == nil {
+		return nil
+	}
+
+	if len(groupIds) > 0 {
+		return v.newValidationError(v.newFieldViolation("fallback", "excluded_with", "group_ids"))
+	}
+
+	for i, step := range fallback.Steps {
+		if len(step.Receivers) != len(receivers) {
+			return v.newValidationError(v.newFieldViolation(fmt.Sprintf("fallback.steps[%d].receivers", i), "len", strconv.Itoa(len(receivers))))
+		}
+		// 空字串表示該收件者不改由此通道發送
+		for _, receiver := range step.Receivers {
+			if receiver == "" {
+				continue
+			}
+			if err := v.checkReceiver(step.Channel, receiver); err != nil {
+				return err
+			}
+		}
+		if step.Channel == model.MessageType_SMS {
+			if err := v.checkSmsSegments(step.Sms.Body); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 依發送通道驗證收件者格式
+func (v *Validator) checkReceiver(channel model.MessageType, receiver string) error {
+	switch channel {
+	case model.MessageType_SMS:
+		return v.validateRequest(model.SmsReceiver{Receiver: receiver})
+	case model.MessageType_PUSH:
+		return v.validateRequest(model.PushReceiver{Receiver: receiver})
+	case model.MessageType_LINE:
+		return v.validateRequest(model.LineReceiver{Receiver: receiver})
+	default:
+		return v.validateRequest(model.MailReceiver{Receiver: receiver})
+	}
+}
+
 // 驗證 scheduled_at 是否有效
 func (v *Validator) CheckScheduledAt(scheduledAt *time.Time) error {
 	if scheduledAt == nil {
@@ -52,12 +271,28 @@ func (v *Validator) CheckScheduledAt(scheduledAt *time.Time) error {
 
 	now := time.Now()
 	if !scheduledAt.After(now) {
-		return fmt.Errorf("scheduled_at must be in the future")
+		return v.newValidationError(v.newFieldViolation("scheduled_at", "future", ""))
 	}
 
 	maxAllowedTime := now.Add(time.Duration(v.config.ScheduleLimitDays) * 24 * time.Hour)
 	if scheduledAt.After(maxAllowedTime) {
-		return fmt.Errorf("scheduled_at must be within the next %d days", v.config.ScheduleLimitDays)
+		return v.newValidationError(v.newFieldViolation("scheduled_at", "within_days", strconv.Itoa(v.config.ScheduleLimitDays)))
+	}
+	return nil
+}
+
+// 驗證 valid_until 是否有效，須晚於現在與預約時間
+func (v *Validator) CheckValidUntil(scheduledAt *time.Time, validUntil *time.Time) error {
+	if validUntil == nil {
+		return nil
+	}
+
+	if !validUntil.After(time.Now()) {
+		return v.newValidationError(v.newFieldViolation("valid_until", "future", ""))
+	}
+
+	if scheduledAt != nil && !validUntil.After(*scheduledAt) {
+		return v.newValidationError(v.newFieldViolation("valid_until", "after_scheduled_at", ""))
 	}
 	return nil
 }
@@ -67,45 +302,207 @@ func (v *Validator) validateRequest(data interface{}) error {
 	return v.handleValidationError(v.validate.Struct(data))
 }
 
-// 處理驗證錯誤
+// 處理驗證錯誤，依欄位路徑回傳各欄位的錯誤說明與錯誤原因代碼
 func (v *Validator) handleValidationError(err error) error {
 	if err == nil {
 		return nil
 	}
 
-	// 若錯誤是 ValidationErrors 類型，收集所有錯誤訊息
 	if validationErrors, ok := err.(validator.ValidationErrors); ok {
-		metaData := make(map[string]string)
+		fieldViolations := make([]cus_error.FieldViolation, 0, len(validationErrors))
 		for _, fieldErr := range validationErrors {
-			errMsg := v.getValidationErrorMessage(fieldErr.Tag())
-			metaData[fieldErr.Field()] = fmt.Sprintf("The field '%s' %s.", fieldErr.Field(), errMsg)
+			fieldViolations = append(fieldViolations, v.newFieldViolation(getFieldPath(fieldErr.Namespace()), fieldErr.Tag(), fieldErr.Param()))
 		}
+		return v.newValidationError(fieldViolations...)
+	}
 
-		jsonMetaData, _ := json.Marshal(metaData)
+	return err
+}
 
-		// 返回統一的錯誤訊息
-		return fmt.Errorf("%s", string(jsonMetaData))
+// 建立欄位驗證錯誤，錯誤原因代碼取第一個欄位
+func (v *Validator) newValidationError(fieldViolations ...cus_error.FieldViolation) error {
+	descriptions := make([]string, len(fieldViolations))
+	for i, violation := range fieldViolations {
+		descriptions[i] = violation.Description
 	}
 
-	return err
+	return &cus_error.ValidationError{
+		BaseError: cus_error.BaseError{
+			Msg:     strings.Join(descriptions, " "),
+			MsgCode: fieldViolations[0].Reason,
+		},
+		Locale:          v.getLocale(),
+		FieldViolations: fieldViolations,
+	}
+}
+
+func (v *Validator) newFieldViolation(field string, tag string, param string) cus_error.FieldViolation {
+	return cus_error.FieldViolation{
+		Field:       field,
+		Description: v.getValidationErrorMessage(field, tag, param),
+		Reason:      getFieldReasonCode(field, tag),
+	}
+}
+
+func (v *Validator) getLocale() string {
+	if _, ok := validationErrorMessages[v.config.ErrorLocale]; ok {
+		return v.config.ErrorLocale
+	}
+	return defaultErrorLocale
 }
 
+const defaultErrorLocale = "en"
+
+// 各語系的欄位錯誤說明格式與驗證規則說明，{param} 替換為規則參數
+var (
+	validationErrorFormats = map[string]string{
+		"en":    "The field '%s' %s.",
+		"zh-TW": "欄位 '%s' %s。",
+	}
+	validationErrorMessages = map[string]map[string]string{
+		"en": {
+			"required":             "is required",
+			"required_without":     "is required when the related field is empty",
+			"oneof":                "must be one of: {param}",
+			"max":                  "exceeds maximum allowed value {param}",
+			"min":                  "is below minimum allowed value {param}",
+			"email":                "must be a valid email address",
+			"http_url":             "must be a valid http or https URL",
+			"json":                 "must be valid JSON",
+			"line_user_id":         "must be a valid LINE user ID",
+			"required_with":        "is required when the related field is set",
+			"required_without_all": "is required when all related fields are empty",
+			"required_if":          "is required when {param}",
+			"excluded_with":        "cannot be used together with {param}",
+			"len":                  "must contain exactly {param} items",
+			"boolean":              "must be a boolean",
+			"gte":                  "must be greater than or equal to {param}",
+			"regexp":               "has an invalid format",
+			"phone":                "must be a valid mobile phone number",
+			"timezone":             "must be a valid IANA timezone",
+			"future":               "must be in the future",
+			"within_days":          "must be within the next {param} days",
+			"after_scheduled_at":   "must be after scheduled_at",
+			"max_segments":         "exceeds the maximum of {param} SMS segments",
+			"default":              "has an invalid value",
+		},
+		"zh-TW": {
+			"required":             "為必填",
+			"required_without":     "在相關欄位為空時為必填",
+			"oneof":                "必須為下列其中之一：{param}",
+			"max":                  "超過上限 {param}",
+			"min":                  "低於下限 {param}",
+			"email":                "必須為有效的電子郵件地址",
+			"http_url":             "必須為有效的 http 或 https 網址",
+			"json":                 "必須為有效的 JSON",
+			"line_user_id":         "必須為有效的 LINE 使用者 ID",
+			"required_with":        "在相關欄位有值時為必填",
+			"required_without_all": "在相關欄位皆為空時為必填",
+			"required_if":          "在 {param} 時為必填",
+			"excluded_with":        "不可與 {param} 併用",
+			"len":                  "必須剛好為 {param} 筆",
+			"boolean":              "必須為布林值",
+			"gte":                  "必須大於或等於 {param}",
+			"regexp":               "格式不正確",
+			"phone":                "必須為有效的手機號碼",
+			"timezone":             "必須為有效的 IANA 時區",
+			"future":               "必須晚於現在時間",
+			"within_days":          "必須在 {param} 天內",
+			"after_scheduled_at":   "必須晚於 scheduled_at",
+			"max_segments":         "超過簡訊分段數上限 {param}",
+			"default":              "的值無效",
+		},
+	}
+)
+
 // 獲取對應的錯誤訊息
-func (v *Validator) getValidationErrorMessage(tag string) string {
-	errorMessages := map[string]string{
-		"required": "is required",
-		"oneof":    "must be one of the allowed values",
-		"max":      "exceeds maximum allowed value",
-		"min":      "is below minimum allowed value",
-		"email":    "must be a valid email address",
-		"boolean":  "must be a boolean",
-		"gte":      "must be greater than or equal to required value",
+func (v *Validator) getValidationErrorMessage(field string, tag string, param string) string {
+	locale := v.getLocale()
+	errorMessages := validationErrorMessages[locale]
+
+	errMsg, exists := errorMessages[tag]
+	if !exists {
+		// 預設錯誤訊息
+		errMsg = errorMessages["default"]
+	}
+	errMsg = strings.ReplaceAll(errMsg, "{param}", param)
+	return fmt.Sprintf(validationErrorFormats[locale], field, errMsg)
+}
+
+// 欄位對應的錯誤原因代碼，以去除索引的欄位路徑或最後一段欄位名稱比對，未列出的欄位使用 ERR_COMMON_INVALID_ARGUMENT
+var fieldReasonCodes = map[string]errorpb.ErrorReasonCode{
+	"receivers":           errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"receiver":            errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"phone":               errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"email":               errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"sms.body":            errorpb.ErrorReasonCode_ERR_NOTIFY_BODY_EMPTY,
+	"mail.body":           errorpb.ErrorReasonCode_ERR_NOTIFY_BODY_EMPTY,
+	"mail.subject":        errorpb.ErrorReasonCode_ERR_NOTIFY_SUBJECT_EMPTY,
+	"mail.sender_address": errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_SENDER_ADDRESS,
+	"webhook.url":         errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"device_tokens":       errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"push.body":           errorpb.ErrorReasonCode_ERR_NOTIFY_BODY_EMPTY,
+	"push.title":          errorpb.ErrorReasonCode_ERR_NOTIFY_SUBJECT_EMPTY,
+	"user_ids":            errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"sms_receivers":       errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"mail_receivers":      errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"line_user_ids":       errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_RECEIVER,
+	"line.text":           errorpb.ErrorReasonCode_ERR_NOTIFY_BODY_EMPTY,
+	"group_ids":           errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_GROUP_ID,
+	"scheduled_at":        errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_SCHEDULED_AT,
+	"valid_until":         errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_VALID_UNTIL,
+	"priority":            errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_PRIORITY,
+	"timezone":            errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_TIMEZONE,
+	"category":            errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_CATEGORY,
+	"expires_at":          errorpb.ErrorReasonCode_ERR_NOTIFY_INVALID_EXPIRES_AT,
+}
+
+var fieldIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+func getFieldReasonCode(field string, tag string) errorpb.ErrorReasonCode {
+	key := fieldIndexPattern.ReplaceAllString(field, "")
+
+	if tag == "max_segments" {
+		return errorpb.ErrorReasonCode_ERR_NOTIFY_SMS_TOO_MANY_SEGMENTS
 	}
 
-	if errMsg, exists := errorMessages[tag]; exists {
-		return errMsg
+	// 收件者列表本身為空或超過上限時，與單一收件者格式錯誤區分
+	if (key == "receivers" || strings.HasSuffix(key, "_receivers")) && !strings.HasSuffix(field, "]") {
+		switch tag {
+		case "required", "required_without", "required_with":
+			return errorpb.ErrorReasonCode_ERR_NOTIFY_RECEIVER_EMPTY
+		case "max":
+			return errorpb.ErrorReasonCode_ERR_NOTIFY_TOO_MANY_RECEIVERS
+		}
 	}
 
-	// 預設錯誤訊息
-	return "has an invalid value"
+	if code, ok := fieldReasonCodes[key]; ok {
+		return code
+	}
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		if code, ok := fieldReasonCodes[key[i+1:]]; ok {
+			return code
+		}
+	}
+	return errorpb.ErrorReasonCode_ERR_COMMON_INVALID_ARGUMENT
+}
+
+// 去除最外層的結構名稱，例如 SendSmsRequest.receivers[3] 轉為 receivers[3]
+func getFieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// 欄位名稱使用 json 標籤，與 proto 欄位名稱一致；內嵌結構使用小寫的型別名稱，例如 sms、mail
+func getJsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" && field.Anonymous {
+		return strings.ToLower(field.Name)
+	}
+	return name
 }
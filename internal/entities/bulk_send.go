@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"time"
+)
+
+type BulkSendJobStatus string
+
+const (
+	BulkSendJobStatus_PROCESSING BulkSendJobStatus = "processing" // 上傳處理中
+	BulkSendJobStatus_COMPLETED  BulkSendJobStatus = "completed"  // 已完成
+	BulkSendJobStatus_FAILED     BulkSendJobStatus = "failed"     // 上傳中斷，訊息已取消
+)
+
+type BulkSendJob struct {
+	Id           string            `gorm:"primaryKey" json:"id"`
+	ClientId     string            `json:"client_id"`                          // 呼叫端識別碼
+	TemplateId   string            `json:"template_id"`                        // 關聯的 templates.id
+	MessageId    string            `json:"message_id"`                         // 關聯的 messages.id
+	Channel      MessageType       `json:"channel"`                            // 發送通道，使用 MessageType 枚舉
+	Status       BulkSendJobStatus `json:"status"`                             // 工作狀態，使用 BulkSendJobStatus 枚舉
+	TotalRows    int               `json:"total_rows"`                         // 資料列總數
+	AcceptedRows int               `json:"accepted_rows"`                      // 成功建立發送紀錄的列數
+	FailedRows   int               `json:"failed_rows"`                        // 驗證失敗的列數
+	ErrorMessage string            `json:"error_message"`                      // 工作失敗原因
+	CreatedAt    time.Time         `json:"created_at" gorm:"type:timestamp"`   // 創建時間
+	UpdatedAt    time.Time         `json:"updated_at" gorm:"type:timestamp"`   // 更新時間
+	CompletedAt  *time.Time        `json:"completed_at" gorm:"type:timestamp"` // 完成時間
+}
+
+func (BulkSendJob) TableName() string {
+	return "notify.bulk_send_jobs"
+}
+
+// 批量發送驗證失敗的資料列
+type BulkSendError struct {
+	Id        string    `gorm:"primaryKey" json:"id"`
+	JobId     string    `json:"job_id"`                           // 關聯的 bulk_send_jobs.id
+	RowNumber int       `json:"row_number"`                       // 資料列序號，不含欄位名稱列，從 1 開始
+	Field     string    `json:"field"`                            // 錯誤欄位
+	Value     string    `json:"value"`                            // 欄位值 (AES 加密)
+	Reason    string    `json:"reason"`                           // 錯誤原因
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp"` // 創建時間
+}
+
+func (BulkSendError) TableName() string {
+	return "notify.bulk_send_errors"
+}
+
+// 批量發送已接受的收件者，以 (message_id, receiver_hash) 唯一索引排除重複的收件者，工作結束後刪除
+type BulkSendReceiver struct {
+	MessageId    string    `gorm:"primaryKey" json:"message_id"`     // 關聯的 messages.id
+	ReceiverHash string    `gorm:"primaryKey" json:"receiver_hash"`  // 接收者的 MD5 哈希值
+	CreatedAt    time.Time `json:"created_at" gorm:"type:timestamp"` // 創建時間
+}
+
+func (BulkSendReceiver) TableName() string {
+	return "notify.bulk_send_receivers"
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Contact struct {
+	Id        string         `gorm:"primaryKey" json:"id"`
+	Name      string         `json:"name"`                             // 名稱
+	Phone     string         `json:"phone"`                            // 手機號碼 (AES 加密)
+	PhoneHash string         `json:"phone_hash"`                       // 手機號碼的 MD5 哈希值
+	Email     string         `json:"email"`                            // 電子郵件地址 (AES 加密)
+	EmailHash string         `json:"email_hash"`                       // 電子郵件地址的 MD5 哈希值
+	CreatedAt time.Time      `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt time.Time      `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (Contact) TableName() string {
+	return "notify.contacts"
+}
+
+type Group struct {
+	Id          string         `gorm:"primaryKey" json:"id"`
+	Name        string         `json:"name"`                               // 名稱
+	Description string         `json:"description"`                        // 描述
+	MemberCount int64          `json:"member_count" gorm:"->;-:migration"` // 成員數量，僅查詢時計算
+	CreatedAt   time.Time      `json:"created_at" gorm:"type:timestamp"`   // 創建時間
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"type:timestamp"`   // 更新時間
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"`   // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (Group) TableName() string {
+	return "notify.groups"
+}
+
+type GroupMember struct {
+	GroupId   string    `gorm:"primaryKey" json:"group_id"`       // 關聯的 groups.id
+	ContactId string    `gorm:"primaryKey" json:"contact_id"`     // 關聯的 contacts.id
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp"` // 創建時間
+}
+
+func (GroupMember) TableName() string {
+	return "notify.group_members"
+}
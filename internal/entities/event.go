@@ -10,20 +10,33 @@ import (
 type EventStatus string
 
 const (
-	EventStatus_SENT      EventStatus = "sent"      // 已發送
-	EventStatus_DELIVERED EventStatus = "delivered" // 已送達
-	EventStatus_OPENED    EventStatus = "opened"    // 已開啟
-	EventStatus_CLICKED   EventStatus = "clicked"   // 已點擊
-	EventStatus_FAILED    EventStatus = "failed"    // 發送失敗
+	EventStatus_SENT           EventStatus = "sent"           // 已發送
+	EventStatus_DELIVERED      EventStatus = "delivered"      // 已送達
+	EventStatus_OPENED         EventStatus = "opened"         // 已開啟
+	EventStatus_CLICKED        EventStatus = "clicked"        // 已點擊
+	EventStatus_FAILED         EventStatus = "failed"         // 發送失敗
+	EventStatus_EXPIRED        EventStatus = "expired"        // 逾期未發送
+	EventStatus_BOUNCED        EventStatus = "bounced"        // 硬退信
+	EventStatus_SPAM_REPORT    EventStatus = "spam_report"    // 垃圾郵件檢舉
+	EventStatus_INVALID_NUMBER EventStatus = "invalid_number" // 無效的手機號碼
+	EventStatus_INVALID_TOKEN  EventStatus = "invalid_token"  // 無效的推播裝置 token
+
+	EventStatus_OTP_VERIFIED EventStatus = "otp_verified" // 一次性密碼驗證成功
+	EventStatus_OTP_REJECTED EventStatus = "otp_rejected" // 一次性密碼驗證失敗
 )
 
 var (
 	ConvertEventStatusWithString = map[string]EventStatus{
-		string(EventStatus_SENT):      EventStatus_SENT,
-		string(EventStatus_DELIVERED): EventStatus_DELIVERED,
-		string(EventStatus_OPENED):    EventStatus_OPENED,
-		string(EventStatus_CLICKED):   EventStatus_CLICKED,
-		string(EventStatus_FAILED):    EventStatus_FAILED,
+		string(EventStatus_SENT):           EventStatus_SENT,
+		string(EventStatus_DELIVERED):      EventStatus_DELIVERED,
+		string(EventStatus_OPENED):         EventStatus_OPENED,
+		string(EventStatus_CLICKED):        EventStatus_CLICKED,
+		string(EventStatus_FAILED):         EventStatus_FAILED,
+		string(EventStatus_EXPIRED):        EventStatus_EXPIRED,
+		string(EventStatus_BOUNCED):        EventStatus_BOUNCED,
+		string(EventStatus_SPAM_REPORT):    EventStatus_SPAM_REPORT,
+		string(EventStatus_INVALID_NUMBER): EventStatus_INVALID_NUMBER,
+		string(EventStatus_INVALID_TOKEN):  EventStatus_INVALID_TOKEN,
 	}
 )
 
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+)
+
+type FallbackStatus string
+
+const (
+	FallbackStatus_PENDING   FallbackStatus = "pending"   // 等待原訊息送達結果
+	FallbackStatus_TRIGGERED FallbackStatus = "triggered" // 已建立備援訊息
+	FallbackStatus_SKIPPED   FallbackStatus = "skipped"   // 所有接收者皆已送達或無備援收件者，不需備援
+	FallbackStatus_CANCELED  FallbackStatus = "canceled"  // 原訊息已取消
+	FallbackStatus_FAILED    FallbackStatus = "failed"    // 建立備援訊息失敗
+)
+
+// 訊息的備援設定，原訊息未在 deadline_at 前送達的接收者改由 channel 發送，
+// 建立的備援訊息可再帶有下一層備援設定，形成備援鏈
+type MessageFallback struct {
+	Id                string         `gorm:"primaryKey" json:"id"`
+	MessageId         string         `json:"message_id"`                        // 受監看的 message.id
+	Step              int            `json:"step"`                              // 備援順序，1 為第一個備援通道
+	Channel           MessageType    `json:"channel"`                           // 備援通道，使用 MessageType 枚舉
+	Data              string         `json:"data"`                              // 備援內容 (AES 加密的 JSON)，包含收件者對應與後續備援通道
+	DeadlineAt        time.Time      `json:"deadline_at" gorm:"type:timestamp"` // 等待原訊息送達的期限
+	Status            FallbackStatus `json:"status"`                            // 備援狀態，使用 FallbackStatus 枚舉
+	FallbackMessageId string         `json:"fallback_message_id"`               // 建立的備援訊息 message.id
+	CreatedAt         time.Time      `json:"created_at" gorm:"type:timestamp"`  // 創建時間
+	UpdatedAt         time.Time      `json:"updated_at" gorm:"type:timestamp"`  // 更新時間
+}
+
+func (MessageFallback) TableName() string {
+	return "notify.message_fallbacks"
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type FrequencyCapAction string
+
+const (
+	FrequencyCapAction_DROP  FrequencyCapAction = "drop"  // 超過上限時不發送
+	FrequencyCapAction_DEFER FrequencyCapAction = "defer" // 超過上限時延後至窗口內數量低於上限後發送
+)
+
+// 發送頻率上限規則，同一接收者在 window_seconds 秒內於同通道、同分類最多發送 max_count 則，
+// channel、category 為空值時表示適用全部，同時符合多筆時皆須滿足
+type FrequencyCapRule struct {
+	Id            string             `gorm:"primaryKey" json:"id"`
+	Name          string             `json:"name"`                             // 規則名稱，顯示於狀態原因
+	Channel       *MessageType       `json:"channel"`                          // 發送通道，使用 MessageType 枚舉
+	Category      *string            `json:"category"`                         // 訊息分類
+	MaxCount      int                `json:"max_count"`                        // 窗口內最多發送數量
+	WindowSeconds int                `json:"window_seconds"`                   // 滾動窗口長度（秒）
+	Action        FrequencyCapAction `json:"action"`                           // 超過上限時的處理方式，使用 FrequencyCapAction 枚舉
+	Enabled       bool               `json:"enabled"`                          // 是否啟用
+	CreatedAt     time.Time          `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt     time.Time          `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt     gorm.DeletedAt     `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (FrequencyCapRule) TableName() string {
+	return "notify.frequency_cap_rules"
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type InboundAction string
+
+const (
+	InboundAction_OPT_OUT InboundAction = "opt_out" // 退訂
+	InboundAction_NONE    InboundAction = "none"    // 未符合任何關鍵字
+)
+
+type ConfirmationStatus string
+
+const (
+	ConfirmationStatus_SENT    ConfirmationStatus = "sent"    // 確認簡訊已加入發送佇列
+	ConfirmationStatus_FAILED  ConfirmationStatus = "failed"  // 確認簡訊加入佇列失敗
+	ConfirmationStatus_SKIPPED ConfirmationStatus = "skipped" // 未發送確認簡訊
+)
+
+// 收件者回覆的上行簡訊
+type InboundMessage struct {
+	Id                 string             `gorm:"primaryKey" json:"id"`
+	Provider           Provider           `json:"provider"`                          // 發送商，使用 Provider 枚舉
+	ProviderMessageId  string             `json:"provider_message_id"`               // 發送商的上行簡訊 ID
+	Sender             string             `json:"sender"`                            // 回覆者 (AES-128 加密的 phone)
+	SenderHash         string             `json:"sender_hash"`                       // 回覆者的 MD5 哈希值
+	Recipient          string             `json:"recipient"`                         // 接收門號
+	Body               string             `json:"body"`                              // 簡訊內容
+	Keyword            string             `json:"keyword"`                           // 符合的關鍵字
+	Language           string             `json:"language"`                          // 關鍵字語系
+	Action             InboundAction      `json:"action"`                            // 處理動作，使用 InboundAction 枚舉
+	ConfirmationStatus ConfirmationStatus `json:"confirmation_status"`               // 確認簡訊狀態，使用 ConfirmationStatus 枚舉
+	ReceivedAt         time.Time          `json:"received_at" gorm:"type:timestamp"` // 接收時間
+	CreatedAt          time.Time          `json:"created_at" gorm:"type:timestamp"`  // 創建時間
+	UpdatedAt          time.Time          `json:"updated_at" gorm:"type:timestamp"`  // 更新時間
+	DeletedAt          gorm.DeletedAt     `json:"deleted_at" gorm:"type:timestamp"`  // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (InboundMessage) TableName() string {
+	return "notify.inbound_messages"
+}
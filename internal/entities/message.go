@@ -11,31 +11,51 @@ type MessageType string
 const (
 	MessageType_SMS  MessageType = "sms"  // 簡訊
 	MessageType_MAIL MessageType = "mail" // 郵件
+
+	MessageType_WEBHOOK MessageType = "webhook" // 外部 HTTP 推送
+	MessageType_PUSH    MessageType = "push"    // 推播通知
+	MessageType_LINE    MessageType = "line"    // LINE 訊息
 )
 
 type MessageStatus string
 
 const (
-	MessageStatus_PENDING   MessageStatus = "pending"   // 待處理
-	MessageStatus_ENQUEUED  MessageStatus = "enqueued"  // 已加入佇列
-	MessageStatus_SCHEDULED MessageStatus = "scheduled" // 已排定
-	MessageStatus_SENT      MessageStatus = "sent"      // 已發送
-	MessageStatus_FAILED    MessageStatus = "failed"    // 發送失敗
-	MessageStatus_CANCELED  MessageStatus = "canceled"  // 已取消
+	MessageStatus_PENDING    MessageStatus = "pending"    // 待處理
+	MessageStatus_ENQUEUED   MessageStatus = "enqueued"   // 已加入佇列
+	MessageStatus_SCHEDULED  MessageStatus = "scheduled"  // 已排定
+	MessageStatus_SENT       MessageStatus = "sent"       // 已發送
+	MessageStatus_FAILED     MessageStatus = "failed"     // 發送失敗
+	MessageStatus_CANCELED   MessageStatus = "canceled"   // 已取消
+	MessageStatus_EXPIRED    MessageStatus = "expired"    // 已逾期未發送
+	MessageStatus_SUPPRESSED MessageStatus = "suppressed" // 所有接收者皆在抑制名單中或超過發送頻率上限
+)
+
+type MessagePriority string
+
+const (
+	MessagePriority_HIGH   MessagePriority = "high"   // 高優先級（OTP、交易通知）
+	MessagePriority_NORMAL MessagePriority = "normal" // 一般
+	MessagePriority_BULK   MessagePriority = "bulk"   // 大量發送（行銷）
 )
 
 type Message struct {
-	Id            string         `gorm:"primaryKey" json:"id"`
-	Type          MessageType    `json:"type"`                             // 消息類型，使用 MessageType 枚舉
-	Data          string         `json:"data"`                             // 消息內容
-	SenderName    string         `json:"sender_name"`                      // 寄件者名稱
-	SenderAddress string         `json:"sender_address"`                   // 寄件者地址
-	Subject       string         `json:"subject"`                          // 主旨
-	ScheduledAt   *time.Time     `json:"scheduled_at"`                     // 預約時間，使用指標來處理空值
-	Status        MessageStatus  `json:"status"`                           // 消息狀態，使用 MessageStatus 枚舉
-	CreatedAt     time.Time      `json:"created_at" gorm:"type:timestamp"` // 創建時間
-	UpdatedAt     time.Time      `json:"updated_at" gorm:"type:timestamp"` // 更新時間
-	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+	Id                    string          `gorm:"primaryKey" json:"id"`
+	Type                  MessageType     `json:"type"`                             // 消息類型，使用 MessageType 枚舉
+	Data                  string          `json:"data"`                             // 消息內容
+	SenderName            string          `json:"sender_name"`                      // 寄件者名稱
+	SenderAddress         string          `json:"sender_address"`                   // 寄件者地址
+	Subject               string          `json:"subject"`                          // 主旨
+	ScheduledAt           *time.Time      `json:"scheduled_at"`                     // 預約時間，使用指標來處理空值
+	ValidUntil            *time.Time      `json:"valid_until"`                      // 有效期限，逾期未發送則不再發送
+	Status                MessageStatus   `json:"status"`                           // 消息狀態，使用 MessageStatus 枚舉
+	Priority              MessagePriority `json:"priority"`                         // 發送優先級，使用 MessagePriority 枚舉
+	Category              string          `json:"category"`                         // 訊息分類，用於發送頻率上限計算
+	ProviderScheduled     bool            `json:"provider_scheduled"`               // 已交由發送商預約發送，取消時需呼叫發送商取消
+	FallbackFromMessageId string          `json:"fallback_from_message_id"`         // 備援訊息的原訊息 message.id
+	NotificationId        string          `json:"notification_id"`                  // 所屬多通道通知 notification.id
+	CreatedAt             time.Time       `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt             time.Time       `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt             gorm.DeletedAt  `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
 
 	// 外鍵關聯
 	Queues  []*Queue  `gorm:"foreignKey:MessageId" json:"queues"`
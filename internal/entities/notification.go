@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+)
+
+type NotificationStatus string
+
+const (
+	NotificationStatus_SCHEDULED      NotificationStatus = "scheduled"      // 所有通道訊息皆已排定
+	NotificationStatus_PROCESSING     NotificationStatus = "processing"     // 有通道訊息處理中
+	NotificationStatus_SENT           NotificationStatus = "sent"           // 所有通道訊息皆已發送
+	NotificationStatus_PARTIALLY_SENT NotificationStatus = "partially_sent" // 部分通道訊息已發送
+	NotificationStatus_FAILED         NotificationStatus = "failed"         // 所有通道訊息皆未發送
+	NotificationStatus_CANCELED       NotificationStatus = "canceled"       // 所有通道訊息皆已取消
+)
+
+// 多通道通知，各通道的訊息以 messages.notification_id 關聯，狀態由各通道訊息彙整
+type Notification struct {
+	Id             string     `gorm:"primaryKey" json:"id"`
+	Category       string     `json:"category"`                         // 訊息分類
+	ScheduledAt    *time.Time `json:"scheduled_at"`                     // 預約時間，使用指標來處理空值
+	FailedChannels string     `json:"failed_channels"`                  // 建立訊息失敗的通道，以逗號分隔
+	CreatedAt      time.Time  `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+}
+
+func (Notification) TableName() string {
+	return "notify.notifications"
+}
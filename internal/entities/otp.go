@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Otp struct {
+	Id           string         `gorm:"primaryKey" json:"id"`
+	ReceiverHash string         `json:"receiver_hash"`                    // 接收者的 MD5 哈希值 (email 或 phone)
+	Channel      MessageType    `json:"channel"`                          // 發送通道，使用 MessageType 枚舉
+	Purpose      string         `json:"purpose"`                          // 用途
+	CodeHash     string         `json:"code_hash"`                        // 驗證碼的 HMAC-SHA256 雜湊值
+	Attempts     int            `json:"attempts"`                         // 已驗證次數
+	MaxAttempts  int            `json:"max_attempts"`                     // 最大驗證次數
+	MessageId    string         `json:"message_id"`                       // 關聯的 message.id
+	ExpiresAt    time.Time      `json:"expires_at" gorm:"type:timestamp"` // 到期時間
+	VerifiedAt   *time.Time     `json:"verified_at"`                      // 驗證成功時間，使用指標來處理空值
+	CreatedAt    time.Time      `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt    time.Time      `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt    gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (Otp) TableName() string {
+	return "notify.otps"
+}
@@ -16,6 +16,7 @@ const (
 	QueueStatus_SUCCESS  QueueStatus = "success"  // 發送成功
 	QueueStatus_FAILED   QueueStatus = "failed"   // 發送失敗
 	QueueStatus_CANCELED QueueStatus = "canceled" // 已取消
+	QueueStatus_EXPIRED  QueueStatus = "expired"  // 已逾期未發送
 )
 
 type QueueDriver string
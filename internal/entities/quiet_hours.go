@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 勿擾時段設定，client_id、channel、priority 為空值時表示適用全部，
+// 同時符合多筆時以條件最明確者為準
+type QuietHoursPolicy struct {
+	Id        string           `gorm:"primaryKey" json:"id"`
+	ClientId  *string          `json:"client_id"`                        // 呼叫端識別碼
+	Channel   *MessageType     `json:"channel"`                          // 發送通道，使用 MessageType 枚舉
+	Priority  *MessagePriority `json:"priority"`                         // 發送優先級，使用 MessagePriority 枚舉
+	StartTime string           `json:"start_time"`                       // 開始時間 (HH:MM，收件者當地時間)
+	EndTime   string           `json:"end_time"`                         // 結束時間 (HH:MM，收件者當地時間)
+	Enabled   bool             `json:"enabled"`                          // 是否啟用
+	CreatedAt time.Time        `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt time.Time        `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt gorm.DeletedAt   `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (QuietHoursPolicy) TableName() string {
+	return "notify.quiet_hours_policies"
+}
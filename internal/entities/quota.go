@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type QuotaPeriod string
+
+const (
+	QuotaPeriod_DAILY   QuotaPeriod = "daily"   // 每日
+	QuotaPeriod_MONTHLY QuotaPeriod = "monthly" // 每月
+)
+
+// 呼叫端配額設定，未設定的欄位使用服務預設值
+type ClientQuota struct {
+	Id            string         `gorm:"primaryKey" json:"id"`
+	ClientId      string         `json:"client_id"`                        // 呼叫端識別碼
+	Channel       MessageType    `json:"channel"`                          // 發送通道，使用 MessageType 枚舉
+	DailyLimit    *int64         `json:"daily_limit"`                      // 每日發送上限
+	MonthlyLimit  *int64         `json:"monthly_limit"`                    // 每月發送上限
+	RatePerSecond *float64       `json:"rate_per_second"`                  // 每秒發送速率
+	RateBurst     *int           `json:"rate_burst"`                       // 瞬間發送容量
+	CreatedAt     time.Time      `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt     time.Time      `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (ClientQuota) TableName() string {
+	return "notify.client_quotas"
+}
+
+// 呼叫端配額使用量，依週期累計
+type QuotaUsage struct {
+	ClientId    string      `gorm:"primaryKey" json:"client_id"`                   // 呼叫端識別碼
+	Channel     MessageType `gorm:"primaryKey" json:"channel"`                     // 發送通道，使用 MessageType 枚舉
+	Period      QuotaPeriod `gorm:"primaryKey" json:"period"`                      // 統計週期，使用 QuotaPeriod 枚舉
+	PeriodStart time.Time   `gorm:"primaryKey;type:timestamp" json:"period_start"` // 週期起始時間
+	Used        int64       `json:"used"`                                          // 已使用數量
+	CreatedAt   time.Time   `json:"created_at" gorm:"type:timestamp"`              // 創建時間
+	UpdatedAt   time.Time   `json:"updated_at" gorm:"type:timestamp"`              // 更新時間
+}
+
+func (QuotaUsage) TableName() string {
+	return "notify.quota_usages"
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SuppressionReason string
+
+const (
+	SuppressionReason_BOUNCE         SuppressionReason = "bounce"         // 硬退信
+	SuppressionReason_SPAM_REPORT    SuppressionReason = "spam_report"    // 垃圾郵件檢舉
+	SuppressionReason_INVALID_NUMBER SuppressionReason = "invalid_number" // 無效的手機號碼
+	SuppressionReason_MANUAL         SuppressionReason = "manual"         // 手動加入
+	SuppressionReason_OPT_OUT        SuppressionReason = "opt_out"        // 收件者回覆退訂
+	SuppressionReason_INVALID_TOKEN  SuppressionReason = "invalid_token"  // 無效的推播裝置 token
+)
+
+const (
+	SuppressionSource_ADMIN = "admin" // 由管理介面加入
+)
+
+// 抑制名單，以接收者雜湊值與發送通道為唯一鍵
+type Suppression struct {
+	Id           string            `gorm:"primaryKey" json:"id"`
+	ReceiverHash string            `json:"receiver_hash"`                    // 接收者的 MD5 哈希值 (email 或 phone)
+	Receiver     string            `json:"receiver"`                         // 接收者 (AES-128 加密的 email 或 receiver_phone)
+	Channel      MessageType       `json:"channel"`                          // 發送通道，使用 MessageType 枚舉
+	Reason       SuppressionReason `json:"reason"`                           // 抑制原因，使用 SuppressionReason 枚舉
+	Source       string            `json:"source"`                           // 來源 (發送商名稱或 admin)
+	ExpiresAt    *time.Time        `json:"expires_at" gorm:"type:timestamp"` // 到期時間，空值表示永久
+	CreatedAt    time.Time         `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt    time.Time         `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt    gorm.DeletedAt    `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (Suppression) TableName() string {
+	return "notify.suppressions"
+}
@@ -10,19 +10,31 @@ type Provider string
 
 const (
 	Provider_SENDGRID Provider = "sendgrid" // SendGrid 發送商
+	Provider_SES      Provider = "ses"      // Amazon SES 發送商
+	Provider_MAILGUN  Provider = "mailgun"  // Mailgun 發送商
 	Provider_MITAKE   Provider = "mitake"   // Mitake 發送商
+	Provider_TWILIO   Provider = "twilio"   // Twilio 發送商
+	Provider_EVERY8D  Provider = "every8d"  // EVERY8D 發送商
+	Provider_WEBHOOK  Provider = "webhook"  // 外部 HTTP 推送，由 worker 直接發送
+	Provider_FCM      Provider = "fcm"      // Firebase Cloud Messaging 推播
+	Provider_APNS     Provider = "apns"     // Apple Push Notification service 推播
+	Provider_LINE     Provider = "line"     // LINE Messaging API
 )
 
 type TargetStatus string
 
 const (
-	TargetStatus_PENDING  TargetStatus = "pending"  // 待處理
-	TargetStatus_ENQUEUED TargetStatus = "enqueued" // 已加入佇列
-	TargetStatus_SENDING  TargetStatus = "sending"  // 發送中
-	TargetStatus_SENT     TargetStatus = "sent"     // 已發送
-	TargetStatus_SUCCESS  TargetStatus = "success"  // 發送成功
-	TargetStatus_FAILED   TargetStatus = "failed"   // 發送失敗
-	TargetStatus_CANCELED TargetStatus = "canceled" // 已取消
+	TargetStatus_PENDING    TargetStatus = "pending"    // 待處理
+	TargetStatus_ENQUEUED   TargetStatus = "enqueued"   // 已加入佇列
+	TargetStatus_SENDING    TargetStatus = "sending"    // 發送中
+	TargetStatus_SENT       TargetStatus = "sent"       // 已發送
+	TargetStatus_SUCCESS    TargetStatus = "success"    // 發送成功
+	TargetStatus_FAILED     TargetStatus = "failed"     // 發送失敗
+	TargetStatus_CANCELED   TargetStatus = "canceled"   // 已取消
+	TargetStatus_EXPIRED    TargetStatus = "expired"    // 已逾期未發送
+	TargetStatus_SUPPRESSED TargetStatus = "suppressed" // 在抑制名單中，不發送
+	TargetStatus_DROPPED    TargetStatus = "dropped"    // 超過發送頻率上限，不發送
+	TargetStatus_DEFERRED   TargetStatus = "deferred"   // 超過發送頻率上限，延後至另一則訊息發送
 )
 
 type Target struct {
@@ -35,6 +47,8 @@ type Target struct {
 	DriverTraceId   string         `json:"driver_trace_id"`                  // 驅動器的 trace_id
 	Provider        Provider       `json:"provider"`                         // 發送商，使用 Provider 枚舉
 	ProviderTraceId string         `json:"provider_trace_id"`                // 發送商的 trace_id
+	StatusReason    string         `json:"status_reason"`                    // 狀態原因，例如超過發送頻率上限
+	Variables       string         `json:"variables"`                        // 個人化變數 (AES 加密的 JSON)，發送時套用至訊息內容
 	CreatedAt       time.Time      `json:"created_at" gorm:"type:timestamp"` // 創建時間
 	UpdatedAt       time.Time      `json:"updated_at" gorm:"type:timestamp"` // 更新時間
 	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
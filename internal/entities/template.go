@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 訊息範本，內容以 {變數名稱} 表示個人化變數
+type Template struct {
+	Id            string         `gorm:"primaryKey" json:"id"`
+	Name          string         `json:"name"`                             // 範本名稱
+	Channel       MessageType    `json:"channel"`                          // 發送通道，使用 MessageType 枚舉
+	SenderName    string         `json:"sender_name"`                      // 寄件者名稱
+	SenderAddress string         `json:"sender_address"`                   // 寄件者地址
+	Subject       string         `json:"subject"`                          // 主旨
+	Body          string         `json:"body"`                             // 內容
+	Enabled       bool           `json:"enabled"`                          // 是否啟用
+	CreatedAt     time.Time      `json:"created_at" gorm:"type:timestamp"` // 創建時間
+	UpdatedAt     time.Time      `json:"updated_at" gorm:"type:timestamp"` // 更新時間
+	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"type:timestamp"` // 刪除時間，使用 GORM 的軟刪除
+}
+
+func (Template) TableName() string {
+	return "notify.templates"
+}
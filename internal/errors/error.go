@@ -1,6 +1,9 @@
 package error
 
-import errorpb "proto/pkg/notify/v1/error"
+import (
+	errorpb "proto/pkg/notify/v1/error"
+	"time"
+)
 
 type BaseError struct {
 	MsgCode errorpb.ErrorReasonCode
@@ -30,3 +33,26 @@ type NotFoundError struct {
 type ServerError struct {
 	BaseError
 }
+
+// FailedPreconditionError 目前狀態無法執行請求，例如收件者在抑制名單中
+type FailedPreconditionError struct {
+	BaseError
+}
+
+type ResourceExhaustedError struct {
+	BaseError
+	RetryAfter time.Duration
+}
+
+type FieldViolation struct {
+	Field       string // 欄位路徑，陣列元素以索引表示，例如 receivers[3]
+	Description string
+	Reason      errorpb.ErrorReasonCode
+}
+
+// ValidationError 請求欄位驗證失敗，附帶各欄位的錯誤說明
+type ValidationError struct {
+	BaseError
+	Locale          string // 錯誤說明的語系
+	FieldViolations []FieldViolation
+}
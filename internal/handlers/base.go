@@ -0,0 +1,12 @@
+package handler
+
+import (
+	"time"
+
+	entity "notify-service/internal/entities"
+)
+
+// 訊息是否已超過有效期限
+func isExpired(message entity.Message) bool {
+	return message.ValidUntil != nil && !time.Now().Before(*message.ValidUntil)
+}
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	shared "notify-service/internal"
+	consumer "notify-service/internal/consumer"
+	entity "notify-service/internal/entities"
+	liner "notify-service/internal/liner"
+	service "notify-service/internal/services"
+	util "notify-service/internal/utils"
+
+	"github.com/IBM/sarama"
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type LineHandler struct {
+	db        *gorm.DB
+	config    *shared.Config
+	snowflake *snowflake.Node
+	service   *service.LineService
+}
+
+func NewLineHandler(
+	db *gorm.DB,
+	config *shared.Config,
+	snowflake *snowflake.Node,
+	service *service.LineService,
+) consumer.TopicHandler {
+	return &LineHandler{
+		db:        db,
+		config:    config,
+		snowflake: snowflake,
+		service:   service,
+	}
+}
+
+func (h LineHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	logCtx := log.WithContext(ctx).WithFields(log.Fields{
+		"topic":     msg.Topic,
+		"partition": msg.Partition,
+		"offset":    msg.Offset,
+		"timestamp": msg.Timestamp,
+	})
+
+	var req entity.Queue
+	if err := json.Unmarshal(msg.Value, &req); err != nil {
+		logCtx.WithError(err).Error("Invalid message format")
+		return err
+	}
+
+	queue, err := h.fetchQueueData(ctx, req)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to fetch queue")
+		return err
+	}
+
+	// 逾期未發送的訊息不再呼叫發送商
+	if isExpired(queue.Message) {
+		return h.createExpiredEventAndUpdateStatus(ctx, queue)
+	}
+
+	if err := h.updateProcessingStatus(ctx, queue); err != nil {
+		logCtx.WithError(err).Error("Failed to update sending status")
+		return err
+	}
+
+	response := h.service.SendLine(ctx, queue.Targets, queue.Message)
+
+	if response.Status != string(liner.LineStatus_SENT) {
+		return h.createFailureEventAndUpdateStatus(ctx, queue, &response)
+	}
+	return h.createSuccessEventAndUpdateStatus(ctx, queue, &response)
+}
+
+func (h LineHandler) fetchQueueData(ctx context.Context, req entity.Queue) (*entity.Queue, error) {
+	startAt, err := util.ConvertSnowflakeToTime(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error convert snowflake to time: %w", err)
+	}
+
+	var queue entity.Queue
+	err = h.db.WithContext(ctx).
+		Preload("Targets").
+		Preload("Message").
+		Where("created_at >= ?", startAt).
+		First(&queue, "id = ?", req.Id).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching queue: %w", err)
+	}
+	return &queue, nil
+}
+
+func (h LineHandler) updateProcessingStatus(ctx context.Context, queue *entity.Queue) error {
+	return h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Update("status", entity.TargetStatus_SENDING).
+			Error; err != nil {
+			return fmt.Errorf("error updating target status to SENDING: %w", err)
+		}
+
+		if err := tx.Model(entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_SENDING).
+			Error; err != nil {
+			return fmt.Errorf("error updating queue status to SENDING: %w", err)
+		}
+		return nil
+	})
+}
+
+func (h LineHandler) createFailureEventAndUpdateStatus(ctx context.Context, queue *entity.Queue, response *liner.LineBatchResponse) error {
+	return h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_FAILED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update queue status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", queue.MessageId).
+			Update("status", entity.MessageStatus_FAILED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update message status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Updates(map[string]interface{}{
+				"provider": string(entity.Provider_LINE),
+				"status":   entity.TargetStatus_FAILED,
+			}).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update targets status")
+			return err
+		}
+
+		h.createEvents(ctx, tx, queue, response)
+		return nil
+	})
+}
+
+func (h LineHandler) createSuccessEventAndUpdateStatus(ctx context.Context, queue *entity.Queue, response *liner.LineBatchResponse) error {
+	return h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_SUCCESS).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update queue status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", queue.MessageId).
+			Update("status", entity.MessageStatus_SENT).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update message status")
+			return err
+		}
+
+		for _, row := range response.LineResponse {
+			if err := tx.Model(entity.Target{}).
+				Where("id = ?", row.TraceId).
+				Updates(map[string]interface{}{
+					"provider":          string(entity.Provider_LINE),
+					"provider_trace_id": row.ProviderTraceId,
+					"status":            row.Status,
+				}).
+				Error; err != nil {
+				log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+					"target_id": row.TraceId,
+				}).Error("Error updating target status")
+			}
+		}
+
+		h.createEvents(ctx, tx, queue, response)
+		return nil
+	})
+}
+
+// 每個使用者各記錄一筆事件，建立失敗時僅記錄不影響發送結果
+func (h LineHandler) createEvents(ctx context.Context, tx *gorm.DB, queue *entity.Queue, response *liner.LineBatchResponse) {
+	for _, row := range response.LineResponse {
+		jsonResponse, err := json.Marshal(row.ProviderResponse)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to marshal provider response")
+			continue
+		}
+
+		event := entity.Event{
+			Id:              h.snowflake.Generate().String(),
+			Provider:        string(entity.Provider_LINE),
+			Status:          entity.ConvertEventStatusWithString[row.Status],
+			ProviderTraceId: row.TraceId,
+			QueueId:         queue.Id,
+			Data:            jsonResponse,
+			CreatedAt:       time.Now(),
+		}
+		if err := tx.Create(&event).Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Error creating event")
+		}
+	}
+}
+
+func (h LineHandler) createExpiredEventAndUpdateStatus(ctx context.Context, queue *entity.Queue) error {
+	return h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update queue status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", queue.MessageId).
+			Update("status", entity.MessageStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update message status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Update("status", entity.TargetStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update targets status")
+			return err
+		}
+
+		jsonData, err := json.Marshal(map[string]interface{}{
+			"valid_until": queue.Message.ValidUntil,
+		})
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to marshal expired event data")
+			return err
+		}
+
+		event := entity.Event{
+			Id:        h.snowflake.Generate().String(),
+			Provider:  string(entity.Provider_LINE),
+			Status:    entity.EventStatus_EXPIRED,
+			QueueId:   queue.Id,
+			Data:      jsonData,
+			CreatedAt: time.Now(),
+		}
+		if err := tx.Create(&event).Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Error creating event")
+			return err
+		}
+		return nil
+	})
+}
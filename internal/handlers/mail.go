@@ -54,6 +54,11 @@ func (h MailHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) er
 		return h.createFailureEventAndUpdateStatus(ctx, queue, nil)
 	}
 
+	// 逾期未發送的訊息不再呼叫發送商
+	if isExpired(queue.Message) {
+		return h.createExpiredEventAndUpdateStatus(ctx, queue)
+	}
+
 	if err := h.updateProcessingStatus(ctx, queue); err != nil {
 		return h.createFailureEventAndUpdateStatus(ctx, queue, nil)
 	}
@@ -228,12 +233,23 @@ func (h MailHandler) createSuccessEventAndUpdateStatus(ctx context.Context, queu
 			log.WithContext(ctx).WithError(err).Error("Error creating event")
 		}
 
+		recipients := make(map[string]mailer.MailRecipientResponse, len(response.Recipients))
+		for _, recipient := range response.Recipients {
+			recipients[recipient.TargetId] = recipient
+		}
+
 		for _, target := range queue.Targets {
+			providerTraceId, status := response.TraceId, response.Status
+			if recipient, ok := recipients[target.Id]; ok {
+				providerTraceId, status = recipient.ProviderTraceId, recipient.Status
+			}
+
 			if err := tx.Model(entity.Target{}).
 				Where("id = ?", target.Id).
 				Updates(map[string]interface{}{
-					"provider_trace_id": response.TraceId,
-					"status":            string(response.Status),
+					"provider":          h.config.MailProvider,
+					"provider_trace_id": providerTraceId,
+					"status":            status,
 				}).
 				Error; err != nil {
 				log.WithContext(ctx).WithError(err).WithFields(log.Fields{
@@ -248,3 +264,56 @@ func (h MailHandler) createSuccessEventAndUpdateStatus(ctx context.Context, queu
 
 	return err
 }
+
+func (h MailHandler) createExpiredEventAndUpdateStatus(ctx context.Context, queue *entity.Queue) error {
+	err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update queue status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", queue.MessageId).
+			Update("status", entity.MessageStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update message status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Update("status", entity.TargetStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update targets status")
+			return err
+		}
+
+		jsonData, err := json.Marshal(map[string]interface{}{
+			"valid_until": queue.Message.ValidUntil,
+		})
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to marshal expired event data")
+			return err
+		}
+
+		event := entity.Event{
+			Id:        h.snowflake.Generate().String(),
+			Provider:  h.config.MailProvider,
+			Status:    entity.EventStatus_EXPIRED,
+			QueueId:   queue.Id,
+			Data:      jsonData,
+			CreatedAt: time.Now(),
+		}
+		if err := tx.Create(&event).Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Error creating event")
+			return err
+		}
+
+		return nil
+	})
+
+	return err
+}
@@ -24,6 +24,8 @@ type SmsHandler struct {
 	config    *shared.Config
 	snowflake *snowflake.Node
 	service   *service.SmsService
+
+	suppressionService *service.SuppressionService
 }
 
 func NewSmsHandler(
@@ -31,12 +33,14 @@ func NewSmsHandler(
 	config *shared.Config,
 	snowflake *snowflake.Node,
 	service *service.SmsService,
+	suppressionService *service.SuppressionService,
 ) consumer.TopicHandler {
 	return &SmsHandler{
-		db:        db,
-		config:    config,
-		snowflake: snowflake,
-		service:   service,
+		db:                 db,
+		config:             config,
+		snowflake:          snowflake,
+		service:            service,
+		suppressionService: suppressionService,
 	}
 }
 
@@ -54,6 +58,11 @@ func (h SmsHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) err
 		return h.createFailureEventAndUpdateStatus(ctx, queue, nil)
 	}
 
+	// 逾期未發送的訊息不再呼叫發送商
+	if isExpired(queue.Message) {
+		return h.createExpiredEventAndUpdateStatus(ctx, queue)
+	}
+
 	if err := h.updateProcessingStatus(ctx, queue); err != nil {
 		return h.createFailureEventAndUpdateStatus(ctx, queue, nil)
 	}
@@ -130,13 +139,65 @@ func (h SmsHandler) updateProcessingStatus(ctx context.Context, queue *entity.Qu
 }
 
 func (h SmsHandler) handleSendResult(ctx context.Context, queue *entity.Queue, response *smser.SmsBatchResponse) error {
-	if response.Status != "sent" {
+	h.suppressInvalidReceivers(ctx, queue, response)
+
+	if response.Status != string(smser.SmsSendStatus_SENT) {
 		return h.createFailureEventAndUpdateStatus(ctx, queue, response)
 	}
 
 	return h.createSuccessEventAndUpdateStatus(ctx, queue, response)
 }
 
+// 將發送商回報無效的門號加入抑制名單，失敗時僅記錄不影響發送結果
+func (h SmsHandler) suppressInvalidReceivers(ctx context.Context, queue *entity.Queue, response *smser.SmsBatchResponse) {
+	targets := make(map[string]entity.Target, len(queue.Targets))
+	for _, target := range queue.Targets {
+		targets[target.Id] = target
+	}
+
+	for _, row := range response.SmsResponse {
+		target, ok := targets[row.TraceId]
+		if !row.InvalidReceiver || !ok {
+			continue
+		}
+
+		_, err := h.suppressionService.SuppressTarget(
+			ctx,
+			entity.MessageType_SMS,
+			target,
+			entity.SuppressionReason_INVALID_NUMBER,
+			h.getSmsProvider(row),
+		)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+				"target_id": target.Id,
+			}).Error("Failed to suppress invalid receiver")
+		}
+	}
+}
+
+func (h SmsHandler) getSmsProvider(row smser.SmsResponse) string {
+	if row.Provider != "" {
+		return row.Provider
+	}
+	return h.config.SmsProvider
+}
+
+// 發送商有回傳訊息 ID 時記錄該 ID，供之後查詢送達狀態
+func getSmsProviderTraceId(row smser.SmsResponse) string {
+	if row.ProviderTraceId != "" {
+		return row.ProviderTraceId
+	}
+	return row.TraceId
+}
+
+func getSmsEventStatus(row smser.SmsResponse, status entity.EventStatus) entity.EventStatus {
+	if row.InvalidReceiver {
+		return entity.EventStatus_INVALID_NUMBER
+	}
+	return status
+}
+
 func (h SmsHandler) createFailureEventAndUpdateStatus(ctx context.Context, queue *entity.Queue, response *smser.SmsBatchResponse) error {
 	err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&entity.Queue{}).
@@ -172,8 +233,8 @@ func (h SmsHandler) createFailureEventAndUpdateStatus(ctx context.Context, queue
 
 			event := entity.Event{
 				Id:              h.snowflake.Generate().String(),
-				Provider:        h.config.SmsProvider,
-				Status:          entity.EventStatus_FAILED,
+				Provider:        h.getSmsProvider(row),
+				Status:          getSmsEventStatus(row, entity.EventStatus_FAILED),
 				ProviderTraceId: row.TraceId,
 				QueueId:         queue.Id,
 				Data:            jsonResponse,
@@ -222,8 +283,8 @@ func (h SmsHandler) createSuccessEventAndUpdateStatus(ctx context.Context, queue
 
 			event := entity.Event{
 				Id:              h.snowflake.Generate().String(),
-				Provider:        h.config.SmsProvider,
-				Status:          entity.EventStatus_SENT,
+				Provider:        h.getSmsProvider(row),
+				Status:          getSmsEventStatus(row, entity.EventStatus_SENT),
 				ProviderTraceId: row.TraceId,
 				QueueId:         queue.Id,
 				Data:            jsonResponse,
@@ -238,7 +299,8 @@ func (h SmsHandler) createSuccessEventAndUpdateStatus(ctx context.Context, queue
 			if err := tx.Model(entity.Target{}).
 				Where("id = ?", row.TraceId).
 				Updates(map[string]interface{}{
-					"provider_trace_id": row.TraceId,
+					"provider":          h.getSmsProvider(row),
+					"provider_trace_id": getSmsProviderTraceId(row),
 					"status":            string(row.Status),
 				}).
 				Error; err != nil {
@@ -254,3 +316,56 @@ func (h SmsHandler) createSuccessEventAndUpdateStatus(ctx context.Context, queue
 
 	return err
 }
+
+func (h SmsHandler) createExpiredEventAndUpdateStatus(ctx context.Context, queue *entity.Queue) error {
+	err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update queue status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", queue.MessageId).
+			Update("status", entity.MessageStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update message status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Update("status", entity.TargetStatus_EXPIRED).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update targets status")
+			return err
+		}
+
+		jsonData, err := json.Marshal(map[string]interface{}{
+			"valid_until": queue.Message.ValidUntil,
+		})
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to marshal expired event data")
+			return err
+		}
+
+		event := entity.Event{
+			Id:        h.snowflake.Generate().String(),
+			Provider:  h.config.SmsProvider,
+			Status:    entity.EventStatus_EXPIRED,
+			QueueId:   queue.Id,
+			Data:      jsonData,
+			CreatedAt: time.Now(),
+		}
+		if err := tx.Create(&event).Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Error creating event")
+			return err
+		}
+
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	consumer "notify-service/internal/consumer"
+	entity "notify-service/internal/entities"
+	service "notify-service/internal/services"
+	util "notify-service/internal/utils"
+
+	"github.com/IBM/sarama"
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// WebhookHandler 送出外部 HTTP 推送，並將回應狀態碼與內容記錄為事件
+type WebhookHandler struct {
+	db        *gorm.DB
+	snowflake *snowflake.Node
+	service   *service.WebhookService
+}
+
+func NewWebhookHandler(
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+	service *service.WebhookService,
+) consumer.TopicHandler {
+	return &WebhookHandler{
+		db:        db,
+		snowflake: snowflake,
+		service:   service,
+	}
+}
+
+func (h WebhookHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	logCtx := log.WithContext(ctx).WithFields(log.Fields{
+		"topic":     msg.Topic,
+		"partition": msg.Partition,
+		"offset":    msg.Offset,
+		"timestamp": msg.Timestamp,
+	})
+
+	var req entity.Queue
+	if err := json.Unmarshal(msg.Value, &req); err != nil {
+		logCtx.WithError(err).Error("Invalid message format")
+		return err
+	}
+
+	queue, err := h.fetchQueueData(ctx, req)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to fetch queue")
+		return err
+	}
+
+	// 逾期未發送的訊息不再推送
+	if isExpired(queue.Message) {
+		return h.updateStatusAndCreateEvent(ctx, queue, entity.EventStatus_EXPIRED, map[string]interface{}{
+			"valid_until": queue.Message.ValidUntil,
+		})
+	}
+
+	if err := h.updateSendingStatus(ctx, queue); err != nil {
+		logCtx.WithError(err).Error("Failed to update sending status")
+		return err
+	}
+
+	// 每個佇列僅有一個推送網址
+	if len(queue.Targets) == 0 {
+		return nil
+	}
+	response := h.service.Send(ctx, queue.Targets[0], queue.Message)
+	if !response.IsSuccess() {
+		logCtx.WithFields(log.Fields{
+			"message_id":  queue.MessageId,
+			"status_code": response.StatusCode,
+			"error":       response.Error,
+		}).Warn("Webhook request failed")
+		return h.updateStatusAndCreateEvent(ctx, queue, entity.EventStatus_FAILED, response)
+	}
+	return h.updateStatusAndCreateEvent(ctx, queue, entity.EventStatus_DELIVERED, response)
+}
+
+func (h WebhookHandler) fetchQueueData(ctx context.Context, req entity.Queue) (*entity.Queue, error) {
+	startAt, err := util.ConvertSnowflakeToTime(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error convert snowflake to time: %w", err)
+	}
+
+	var queue entity.Queue
+	err = h.db.WithContext(ctx).
+		Preload("Targets").
+		Preload("Message").
+		Where("created_at >= ?", startAt).
+		First(&queue, "id = ?", req.Id).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching queue: %w", err)
+	}
+	return &queue, nil
+}
+
+func (h WebhookHandler) updateSendingStatus(ctx context.Context, queue *entity.Queue) error {
+	return h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Update("status", entity.TargetStatus_SENDING).
+			Error; err != nil {
+			return fmt.Errorf("error updating target status to SENDING: %w", err)
+		}
+
+		if err := tx.Model(entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", entity.QueueStatus_SENDING).
+			Error; err != nil {
+			return fmt.Errorf("error updating queue status to SENDING: %w", err)
+		}
+		return nil
+	})
+}
+
+// 依推送結果更新佇列、訊息與接收者狀態，並記錄回應內容為事件
+func (h WebhookHandler) updateStatusAndCreateEvent(ctx context.Context, queue *entity.Queue, eventStatus entity.EventStatus, data interface{}) error {
+	queueStatus, messageStatus, targetStatus := entity.QueueStatus_SUCCESS, entity.MessageStatus_SENT, entity.TargetStatus_SUCCESS
+	statusReason := ""
+	switch eventStatus {
+	case entity.EventStatus_FAILED:
+		queueStatus, messageStatus, targetStatus = entity.QueueStatus_FAILED, entity.MessageStatus_FAILED, entity.TargetStatus_FAILED
+		if response, ok := data.(service.WebhookResponse); ok {
+			statusReason = response.Error
+			if response.StatusCode > 0 {
+				statusReason = fmt.Sprintf("HTTP %d", response.StatusCode)
+			}
+		}
+	case entity.EventStatus_EXPIRED:
+		queueStatus, messageStatus, targetStatus = entity.QueueStatus_EXPIRED, entity.MessageStatus_EXPIRED, entity.TargetStatus_EXPIRED
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to marshal webhook event data")
+		return err
+	}
+
+	return h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Queue{}).
+			Where("id = ?", queue.Id).
+			Update("status", queueStatus).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update queue status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", queue.MessageId).
+			Update("status", messageStatus).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update message status")
+			return err
+		}
+
+		if err := tx.Model(&entity.Target{}).
+			Where("queue_id = ?", queue.Id).
+			Updates(map[string]interface{}{
+				"status":        targetStatus,
+				"status_reason": statusReason,
+			}).
+			Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to update targets status")
+			return err
+		}
+
+		event := entity.Event{
+			Id:        h.snowflake.Generate().String(),
+			Provider:  string(entity.Provider_WEBHOOK),
+			Status:    eventStatus,
+			QueueId:   queue.Id,
+			Data:      jsonData,
+			CreatedAt: time.Now(),
+		}
+		if err := tx.Create(&event).Error; err != nil {
+			log.WithContext(ctx).WithError(err).Error("Error creating event")
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,232 @@
+package job
+
+import (
+	"context"
+
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	util "notify-service/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const receiverHashBackfillBatchSize = 500
+
+// 簡訊發送商，用於篩選手機號碼的發送紀錄
+var smsProviders = []entity.Provider{
+	entity.Provider_MITAKE,
+	entity.Provider_EVERY8D,
+	entity.Provider_TWILIO,
+}
+
+// BackfillReceiverHashesJob 將正規化前寫入的手機號碼轉為 E.164 格式，重新加密並計算雜湊值，
+// 已是 E.164 格式的資料不會更新，可重複執行；otps 僅保存雜湊值無法回填，有效期限短不影響
+type BackfillReceiverHashesJob struct {
+	db          *gorm.DB
+	aesGcm      *component.AesGcm
+	phoneNumber *component.PhoneNumber
+}
+
+func NewBackfillReceiverHashesJob(
+	db *gorm.DB,
+	aesGcm *component.AesGcm,
+	phoneNumber *component.PhoneNumber,
+) *BackfillReceiverHashesJob {
+	return &BackfillReceiverHashesJob{
+		db:          db,
+		aesGcm:      aesGcm,
+		phoneNumber: phoneNumber,
+	}
+}
+
+func (j *BackfillReceiverHashesJob) Execute(ctx context.Context) error {
+	steps := []func(context.Context) error{
+		j.backfillSuppressions,
+		j.backfillContacts,
+		j.backfillInboundMessages,
+		j.backfillTargets,
+	}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *BackfillReceiverHashesJob) backfillSuppressions(ctx context.Context) error {
+	var suppressions []entity.Suppression
+	result := j.db.WithContext(ctx).
+		Unscoped().
+		Where("channel = ?", entity.MessageType_SMS).
+		FindInBatches(&suppressions, receiverHashBackfillBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, suppression := range suppressions {
+				receiver, hash, ok := j.normalize(ctx, suppression.Receiver)
+				if !ok {
+					continue
+				}
+
+				// 已有正規化後的紀錄時刪除舊格式紀錄，避免違反 (receiver_hash, channel) 唯一鍵
+				var count int64
+				err := j.db.WithContext(ctx).
+					Unscoped().
+					Model(&entity.Suppression{}).
+					Where("receiver_hash = ? AND channel = ?", hash, suppression.Channel).
+					Count(&count).
+					Error
+				if err == nil && count > 0 {
+					err = j.db.WithContext(ctx).Unscoped().Delete(&entity.Suppression{}, "id = ?", suppression.Id).Error
+				} else if err == nil {
+					err = j.db.WithContext(ctx).
+						Unscoped().
+						Model(&entity.Suppression{}).
+						Where("id = ?", suppression.Id).
+						Updates(map[string]any{"receiver": receiver, "receiver_hash": hash}).
+						Error
+				}
+				if err != nil {
+					log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+						"suppression_id": suppression.Id,
+					}).Error("error backfilling suppression receiver hash")
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).WithError(result.Error).Error("error fetching sms suppressions")
+		return result.Error
+	}
+	return nil
+}
+
+func (j *BackfillReceiverHashesJob) backfillContacts(ctx context.Context) error {
+	var contacts []entity.Contact
+	result := j.db.WithContext(ctx).
+		Where("phone <> ''").
+		FindInBatches(&contacts, receiverHashBackfillBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, contact := range contacts {
+				phone, hash, ok := j.normalize(ctx, contact.Phone)
+				if !ok {
+					continue
+				}
+
+				// 已有相同號碼的聯絡人時無法自動合併，保留舊資料待人工處理
+				var count int64
+				err := j.db.WithContext(ctx).
+					Model(&entity.Contact{}).
+					Where("phone_hash = ?", hash).
+					Count(&count).
+					Error
+				if err == nil && count > 0 {
+					log.WithContext(ctx).WithFields(log.Fields{
+						"contact_id": contact.Id,
+					}).Warn("contact with normalized phone already exists, skip backfilling")
+					continue
+				}
+				if err == nil {
+					err = j.db.WithContext(ctx).
+						Model(&entity.Contact{}).
+						Where("id = ?", contact.Id).
+						Updates(map[string]any{"phone": phone, "phone_hash": hash}).
+						Error
+				}
+				if err != nil {
+					log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+						"contact_id": contact.Id,
+					}).Error("error backfilling contact phone hash")
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).WithError(result.Error).Error("error fetching contacts")
+		return result.Error
+	}
+	return nil
+}
+
+func (j *BackfillReceiverHashesJob) backfillInboundMessages(ctx context.Context) error {
+	var inboundMessages []entity.InboundMessage
+	result := j.db.WithContext(ctx).
+		FindInBatches(&inboundMessages, receiverHashBackfillBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, inboundMessage := range inboundMessages {
+				sender, hash, ok := j.normalize(ctx, inboundMessage.Sender)
+				if !ok {
+					continue
+				}
+
+				err := j.db.WithContext(ctx).
+					Model(&entity.InboundMessage{}).
+					Where("id = ?", inboundMessage.Id).
+					Updates(map[string]any{"sender": sender, "sender_hash": hash}).
+					Error
+				if err != nil {
+					log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+						"inbound_message_id": inboundMessage.Id,
+					}).Error("error backfilling inbound message sender hash")
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).WithError(result.Error).Error("error fetching inbound messages")
+		return result.Error
+	}
+	return nil
+}
+
+func (j *BackfillReceiverHashesJob) backfillTargets(ctx context.Context) error {
+	var targets []entity.Target
+	result := j.db.WithContext(ctx).
+		Unscoped().
+		Where("provider IN ?", smsProviders).
+		FindInBatches(&targets, receiverHashBackfillBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, target := range targets {
+				receiver, hash, ok := j.normalize(ctx, target.Receiver)
+				if !ok {
+					continue
+				}
+
+				// 帶上建立時間以命中對應的分區
+				err := j.db.WithContext(ctx).
+					Unscoped().
+					Model(&entity.Target{}).
+					Where("id = ? AND created_at = ?", target.Id, target.CreatedAt).
+					Updates(map[string]any{"receiver": receiver, "receiver_hash": hash}).
+					Error
+				if err != nil {
+					log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+						"target_id": target.Id,
+					}).Error("error backfilling target receiver hash")
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).WithError(result.Error).Error("error fetching sms targets")
+		return result.Error
+	}
+	return nil
+}
+
+// 解密手機號碼並正規化為 E.164 格式，回傳加密後的號碼與雜湊值；無法解析或已正規化時 ok 為 false
+func (j *BackfillReceiverHashesJob) normalize(ctx context.Context, encrypted string) (string, string, bool) {
+	phone, err := j.aesGcm.AesDecrypt(encrypted)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warn("error decrypting phone, skip backfilling")
+		return "", "", false
+	}
+
+	normalized, err := j.phoneNumber.Normalize(phone)
+	if err != nil || normalized == phone {
+		return "", "", false
+	}
+
+	reencrypted, err := j.aesGcm.AesEncrypt(normalized)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warn("error encrypting normalized phone, skip backfilling")
+		return "", "", false
+	}
+	return reencrypted, util.Md5(normalized), true
+}
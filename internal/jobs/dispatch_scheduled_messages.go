@@ -56,8 +56,16 @@ func (j *DispatchScheduledMessagesJob) Execute(ctx context.Context) error {
 	}
 
 	for _, message := range messages {
+		// 已超過有效期限的預約訊息不再發送
+		if message.ValidUntil != nil && !now.Before(*message.ValidUntil) {
+			if err := j.notifyService.HandleExpire(ctx, message.Id); err != nil {
+				log.WithContext(ctx).WithError(err).Error("error handling expire")
+			}
+			continue
+		}
+
 		transaction := j.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			err = j.notifyService.HandleEnqueue(ctx, message.Type, message.Queues)
+			err = j.notifyService.HandleEnqueue(ctx, message.Type, message.Priority, message.Queues)
 			if err != nil {
 				log.WithContext(ctx).WithError(err).Error("error handling enqueue")
 				return err
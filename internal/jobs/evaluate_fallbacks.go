@@ -0,0 +1,61 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	shared "notify-service/internal"
+	entity "notify-service/internal/entities"
+	service "notify-service/internal/services"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const fallbackBatchSize = 500
+
+// EvaluateFallbacksJob 檢查等待中的備援設定，原訊息未送達的接收者改由下一個通道發送
+type EvaluateFallbacksJob struct {
+	db              *gorm.DB
+	config          *shared.Config
+	fallbackService *service.FallbackService
+}
+
+func NewEvaluateFallbacksJob(
+	db *gorm.DB,
+	config *shared.Config,
+	fallbackService *service.FallbackService,
+) *EvaluateFallbacksJob {
+	return &EvaluateFallbacksJob{
+		db:              db,
+		config:          config,
+		fallbackService: fallbackService,
+	}
+}
+
+func (j *EvaluateFallbacksJob) Execute(ctx context.Context) error {
+	// 預約訊息最晚於 ScheduleLimitDays 天後發送，等待期限最長 1 天
+	now := time.Now()
+	startAt := now.Add(-time.Duration(j.config.ScheduleLimitDays+1) * 24 * time.Hour)
+
+	var fallbacks []entity.MessageFallback
+	result := j.db.WithContext(ctx).
+		Where("status = ?", entity.FallbackStatus_PENDING).
+		Where("created_at >= ?", startAt).
+		FindInBatches(&fallbacks, fallbackBatchSize, func(tx *gorm.DB, batch int) error {
+			// 單筆處理失敗時僅記錄，未觸發的備援下次執行會再檢查
+			for _, fallback := range fallbacks {
+				if err := j.fallbackService.Evaluate(ctx, fallback, now); err != nil {
+					log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+						"message_id": fallback.MessageId,
+					}).Error("error evaluating message fallback")
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).WithError(result.Error).Error("error fetching pending message fallbacks")
+		return result.Error
+	}
+	return nil
+}
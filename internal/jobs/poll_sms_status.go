@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	shared "notify-service/internal"
+	entity "notify-service/internal/entities"
+	service "notify-service/internal/services"
+	smser "notify-service/internal/smser"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PollSmsStatusJob 查詢已發送但尚未確認送達的簡訊狀態，將送達或失敗的結果寫回 targets 與 events
+type PollSmsStatusJob struct {
+	db                 *gorm.DB
+	config             *shared.Config
+	querier            smser.SmsStatusQuerier
+	smsDeliveryService *service.SmsDeliveryService
+}
+
+func NewPollSmsStatusJob(
+	db *gorm.DB,
+	config *shared.Config,
+	querier smser.SmsStatusQuerier,
+	smsDeliveryService *service.SmsDeliveryService,
+) *PollSmsStatusJob {
+	return &PollSmsStatusJob{
+		db:                 db,
+		config:             config,
+		querier:            querier,
+		smsDeliveryService: smsDeliveryService,
+	}
+}
+
+func (j *PollSmsStatusJob) Execute(ctx context.Context) error {
+	if j.querier == nil {
+		return fmt.Errorf("sms provider %s does not support status query", j.config.SmsProvider)
+	}
+
+	startAt := time.Now().Add(-time.Duration(j.config.SmsStatusPollHours) * time.Hour)
+
+	var targets []entity.Target
+	result := j.db.WithContext(ctx).
+		Where("provider = ?", j.config.SmsProvider).
+		Where("status = ?", entity.TargetStatus_SENT).
+		Where("provider_trace_id <> ''").
+		Where("created_at >= ?", startAt).
+		FindInBatches(&targets, j.config.SmsStatusPollBatchSize, func(tx *gorm.DB, batch int) error {
+			j.pollTargets(ctx, targets)
+			return nil
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).WithError(result.Error).Error("error fetching sent sms targets")
+		return result.Error
+	}
+	return nil
+}
+
+// 單批查詢失敗時僅記錄，下次執行會再查詢
+func (j *PollSmsStatusJob) pollTargets(ctx context.Context, targets []entity.Target) {
+	targetsByTraceId := make(map[string]entity.Target, len(targets))
+	providerTraceIds := make([]string, 0, len(targets))
+	for _, target := range targets {
+		targetsByTraceId[target.ProviderTraceId] = target
+		providerTraceIds = append(providerTraceIds, target.ProviderTraceId)
+	}
+
+	statuses, err := j.querier.QuerySmsStatus(ctx, providerTraceIds)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("error querying sms status")
+		return
+	}
+
+	for _, status := range statuses {
+		target, ok := targetsByTraceId[status.ProviderTraceId]
+		if !ok {
+			continue
+		}
+		if err := j.smsDeliveryService.UpdateDeliveryStatus(ctx, target, status); err != nil {
+			log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+				"target_id": target.Id,
+			}).Error("error updating sms target status")
+		}
+	}
+}
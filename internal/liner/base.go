@@ -0,0 +1,48 @@
+package liner
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type LineProvider interface {
+	SendBatchLine(ctx context.Context, request LineBatchRequest) LineBatchResponse
+}
+
+type LineStatus string
+
+const (
+	LineStatus_SENT   LineStatus = "sent"   // 已發送
+	LineStatus_FAILED LineStatus = "failed" // 失敗
+)
+
+type LineReceiver struct {
+	TargetId string `json:"target_id"`
+	UserId   string `json:"user_id"`
+}
+
+// LineMessage 文字訊息與 Flex 訊息擇一
+type LineMessage struct {
+	MessageId    string          `json:"message_id"`
+	Text         string          `json:"text"`
+	AltText      string          `json:"alt_text"`
+	FlexContents json.RawMessage `json:"flex_contents"`
+}
+
+type LineBatchRequest struct {
+	Receivers []LineReceiver `json:"receivers"`
+	Message   LineMessage    `json:"message"`
+}
+
+type LineResponse struct {
+	Status           string `json:"status"`
+	TraceId          string `json:"trace_id"`
+	ProviderTraceId  string `json:"provider_trace_id"` // LINE 的請求 ID (X-Line-Request-Id)
+	ProviderResponse string `json:"provider_response"`
+}
+
+type LineBatchResponse struct {
+	Status       string         `json:"status"`
+	MessageId    string         `json:"message_id"`
+	LineResponse []LineResponse `json:"line_response"`
+}
@@ -0,0 +1,128 @@
+package liner
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	LineAPIDomain     = "https://api.line.me"
+	LinePushPath      = "/v2/bot/message/push"
+	LineMulticastPath = "/v2/bot/message/multicast"
+
+	// multicast 每次請求的使用者上限
+	LineMulticastLimit = 500
+
+	lineRequestIdHeader = "X-Line-Request-Id"
+)
+
+// MessagingApiLiner 使用 LINE Messaging API 發送，單一使用者以 push 發送，多位使用者以 multicast 分批發送
+type MessagingApiLiner struct {
+	config *shared.Config
+	resty  *resty.Client
+}
+
+func NewMessagingApiLiner(config *shared.Config, resty *resty.Client) *MessagingApiLiner {
+	return &MessagingApiLiner{config: config, resty: resty}
+}
+
+type lineErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func (s *MessagingApiLiner) SendBatchLine(ctx context.Context, request LineBatchRequest) LineBatchResponse {
+	response := LineBatchResponse{
+		Status:    string(LineStatus_FAILED),
+		MessageId: request.Message.MessageId,
+	}
+
+	messages := []interface{}{buildLineMessage(request.Message)}
+	for start := 0; start < len(request.Receivers); start += LineMulticastLimit {
+		end := min(start+LineMulticastLimit, len(request.Receivers))
+		receivers := request.Receivers[start:end]
+
+		lineResponses := s.send(ctx, receivers, messages)
+		if len(lineResponses) > 0 && lineResponses[0].Status == string(LineStatus_SENT) {
+			response.Status = string(LineStatus_SENT)
+		}
+		response.LineResponse = append(response.LineResponse, lineResponses...)
+	}
+	return response
+}
+
+// multicast 不回傳個別使用者的結果，同一請求的使用者共用相同結果與請求 ID
+func (s *MessagingApiLiner) send(ctx context.Context, receivers []LineReceiver, messages []interface{}) []LineResponse {
+	path := LineMulticastPath
+	body := map[string]interface{}{"messages": messages}
+	if len(receivers) == 1 {
+		path = LinePushPath
+		body["to"] = receivers[0].UserId
+	} else {
+		userIds := make([]string, 0, len(receivers))
+		for _, receiver := range receivers {
+			userIds = append(userIds, receiver.UserId)
+		}
+		body["to"] = userIds
+	}
+
+	status, requestId, providerResponse := string(LineStatus_SENT), "", ""
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetAuthToken(s.config.LineChannelAccessToken).
+		SetBody(body).
+		Post(s.getApiDomain() + path)
+	switch {
+	case err != nil:
+		log.WithContext(ctx).WithError(err).Error("LINE request failed")
+		status, providerResponse = string(LineStatus_FAILED), err.Error()
+	case resp.IsError():
+		var result lineErrorResponse
+		_ = json.Unmarshal(resp.Body(), &result)
+		log.WithContext(ctx).WithFields(log.Fields{
+			"status_code": resp.StatusCode(),
+			"message":     result.Message,
+		}).Error("LINE rejected message")
+		status, requestId, providerResponse = string(LineStatus_FAILED), resp.Header().Get(lineRequestIdHeader), resp.String()
+	default:
+		requestId, providerResponse = resp.Header().Get(lineRequestIdHeader), resp.String()
+	}
+
+	lineResponses := make([]LineResponse, 0, len(receivers))
+	for _, receiver := range receivers {
+		lineResponses = append(lineResponses, LineResponse{
+			Status:           status,
+			TraceId:          receiver.TargetId,
+			ProviderTraceId:  requestId,
+			ProviderResponse: providerResponse,
+		})
+	}
+	return lineResponses
+}
+
+// 可設定 API 網域以指向測試用的替代服務
+func (s *MessagingApiLiner) getApiDomain() string {
+	if s.config.LineApiBaseUrl != "" {
+		return strings.TrimRight(s.config.LineApiBaseUrl, "/")
+	}
+	return LineAPIDomain
+}
+
+func buildLineMessage(message LineMessage) map[string]interface{} {
+	if len(message.FlexContents) > 0 {
+		return map[string]interface{}{
+			"type":     "flex",
+			"altText":  message.AltText,
+			"contents": message.FlexContents,
+		}
+	}
+	return map[string]interface{}{
+		"type": "text",
+		"text": message.Text,
+	}
+}
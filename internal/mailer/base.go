@@ -1,6 +1,10 @@
 package mailer
 
-import "context"
+import (
+	"context"
+	"net/mail"
+	"strings"
+)
 
 type MailProvider interface {
 	SendEmail(ctx context.Context, request MailRequest) MailResponse
@@ -14,7 +18,9 @@ const (
 )
 
 type MailReceiver struct {
-	Email string `json:"email"`
+	TargetId      string            `json:"target_id"`
+	Email         string            `json:"email"`
+	Substitutions map[string]string `json:"substitutions"` // 個人化變數，key 為範本中的 {變數名稱}
 }
 
 type MailMessage struct {
@@ -30,7 +36,60 @@ type MailRequest struct {
 }
 
 type MailResponse struct {
+	Status           string                  `json:"status"`
+	TraceId          string                  `json:"trace_id"`
+	ProviderResponse string                  `json:"provider_response"`
+	Recipients       []MailRecipientResponse `json:"recipients"` // 各收件者的發送結果，空值時所有收件者皆套用整批的結果
+}
+
+type MailRecipientResponse struct {
+	TargetId         string `json:"target_id"`
 	Status           string `json:"status"`
-	TraceId          string `json:"trace_id"`
+	ProviderTraceId  string `json:"provider_trace_id"` // 發送商的訊息 ID
 	ProviderResponse string `json:"provider_response"`
 }
+
+// 任一收件者發送成功即視為整批成功，失敗的收件者以個別結果回報
+func newRecipientsResponse(recipients []MailRecipientResponse) MailResponse {
+	response := MailResponse{
+		Status:     string(SendgidStatus_FAILED),
+		Recipients: recipients,
+	}
+	for _, recipient := range recipients {
+		if recipient.Status == string(SendgidStatus_SENT) {
+			response.Status = recipient.Status
+			response.TraceId = recipient.ProviderTraceId
+			break
+		}
+	}
+	if len(recipients) > 0 {
+		response.ProviderResponse = recipients[0].ProviderResponse
+	}
+	return response
+}
+
+// 將內容中的 {變數名稱} 替換為收件者的個人化變數
+func renderSubstitutions(content string, substitutions map[string]string) string {
+	if len(substitutions) == 0 {
+		return content
+	}
+	pairs := make([]string, 0, len(substitutions)*2)
+	for key, value := range substitutions {
+		pairs = append(pairs, key, value)
+	}
+	return strings.NewReplacer(pairs...).Replace(content)
+}
+
+func newFailedRecipientResponse(receiver MailReceiver, reason string) MailRecipientResponse {
+	return MailRecipientResponse{
+		TargetId:         receiver.TargetId,
+		Status:           string(SendgidStatus_FAILED),
+		ProviderResponse: reason,
+	}
+}
+
+// 寄件者名稱含非 ASCII 字元時以 RFC 2047 編碼
+func formatFromAddress(message MailMessage) string {
+	address := mail.Address{Name: message.SenderName, Address: message.SenderAddress}
+	return address.String()
+}
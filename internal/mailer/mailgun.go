@@ -0,0 +1,122 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	MailgunAPIDomain    = "https://api.mailgun.net"
+	MailgunMessagesPath = "/v3/{domain}/messages"
+
+	mailgunApiUser = "api"
+)
+
+// MailgunMailer 使用 Mailgun Messages API 發送，一律帶上 recipient-variables 以批次模式發送，
+// 每位收件者各自收到一封信且看不到其他收件者
+type MailgunMailer struct {
+	config *shared.Config
+	resty  *resty.Client
+}
+
+func NewMailgunMailer(
+	config *shared.Config,
+	resty *resty.Client,
+) *MailgunMailer {
+	return &MailgunMailer{
+		config: config,
+		resty:  resty,
+	}
+}
+
+type mailgunResponse struct {
+	Id      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func (s *MailgunMailer) SendEmail(ctx context.Context, request MailRequest) MailResponse {
+	formData := url.Values{}
+	formData.Set("from", formatFromAddress(request.Message))
+
+	// 個人化變數 {變數名稱} 轉為 Mailgun 的 %recipient.變數名稱% 語法
+	recipientVariables := make(map[string]map[string]string, len(request.Receivers))
+	replacements := make(map[string]string)
+	for _, receiver := range request.Receivers {
+		formData.Add("to", receiver.Email)
+
+		variables := make(map[string]string, len(receiver.Substitutions))
+		for key, value := range receiver.Substitutions {
+			name := strings.TrimSuffix(strings.TrimPrefix(key, "{"), "}")
+			variables[name] = value
+			replacements[key] = "%recipient." + name + "%"
+		}
+		recipientVariables[receiver.Email] = variables
+	}
+
+	formData.Set("subject", renderSubstitutions(request.Message.Subject, replacements))
+	formData.Set("html", renderSubstitutions(request.Message.Body, replacements))
+
+	variables, err := json.Marshal(recipientVariables)
+	if err != nil {
+		return newMailResponse(SendgidStatus_FAILED, "", err.Error())
+	}
+	formData.Set("recipient-variables", string(variables))
+
+	var result mailgunResponse
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetBasicAuth(mailgunApiUser, s.config.MailgunApiKey).
+		SetPathParam("domain", s.config.MailgunDomain).
+		SetFormDataFromValues(formData).
+		Post(s.getApiDomain() + MailgunMessagesPath)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Mailgun request failed")
+		return newRecipientsResponse(newFailedRecipientResponses(request.Receivers, err.Error()))
+	}
+	if resp.IsError() {
+		log.WithContext(ctx).WithFields(log.Fields{
+			"status_code": resp.StatusCode(),
+			"body":        resp.String(),
+		}).Error("Mailgun rejected message")
+		return newRecipientsResponse(newFailedRecipientResponses(request.Receivers, resp.String()))
+	}
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		log.WithContext(ctx).WithError(err).Error("Invalid mailgun response")
+	}
+
+	// 同一批次的收件者共用同一個訊息 ID，事件回呼中的 message-id 不含角括號
+	providerTraceId := strings.Trim(result.Id, "<>")
+	recipients := make([]MailRecipientResponse, 0, len(request.Receivers))
+	for _, receiver := range request.Receivers {
+		recipients = append(recipients, MailRecipientResponse{
+			TargetId:         receiver.TargetId,
+			Status:           string(SendgidStatus_SENT),
+			ProviderTraceId:  providerTraceId,
+			ProviderResponse: resp.String(),
+		})
+	}
+	return newRecipientsResponse(recipients)
+}
+
+// 可設定 API 網域，例如 EU 區域的 https://api.eu.mailgun.net 或測試用的替代服務
+func (s *MailgunMailer) getApiDomain() string {
+	if s.config.MailgunApiBaseUrl != "" {
+		return strings.TrimRight(s.config.MailgunApiBaseUrl, "/")
+	}
+	return MailgunAPIDomain
+}
+
+func newFailedRecipientResponses(receivers []MailReceiver, reason string) []MailRecipientResponse {
+	recipients := make([]MailRecipientResponse, 0, len(receivers))
+	for _, receiver := range receivers {
+		recipients = append(recipients, newFailedRecipientResponse(receiver, reason))
+	}
+	return recipients
+}
@@ -0,0 +1,116 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func newTestMailgunMailer(t *testing.T, handler http.HandlerFunc) *MailgunMailer {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewMailgunMailer(&shared.Config{
+		MailgunApiKey:     "key-123",
+		MailgunDomain:     "mg.example.com",
+		MailgunApiBaseUrl: server.URL,
+	}, resty.New())
+}
+
+func TestMailgunMailerSendEmail(t *testing.T) {
+	mailer := newTestMailgunMailer(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/mg.example.com/messages" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if user, password, ok := r.BasicAuth(); !ok || user != mailgunApiUser || password != "key-123" {
+			t.Errorf("basic auth = %s:%s", user, password)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+
+		if got := r.Form["to"]; len(got) != 2 || got[0] != "alice@example.com" || got[1] != "bob@example.com" {
+			t.Errorf("to = %v", got)
+		}
+		if got := r.Form.Get("subject"); got != "Hi %recipient.name%" {
+			t.Errorf("subject = %q", got)
+		}
+		if got := r.Form.Get("html"); got != "<p>Hello %recipient.name%</p>" {
+			t.Errorf("html = %q", got)
+		}
+
+		var variables map[string]map[string]string
+		if err := json.Unmarshal([]byte(r.Form.Get("recipient-variables")), &variables); err != nil {
+			t.Fatalf("decode recipient-variables: %v", err)
+		}
+		if got := variables["alice@example.com"]["name"]; got != "Alice" {
+			t.Errorf("alice name = %q", got)
+		}
+		if got, ok := variables["bob@example.com"]; !ok || len(got) != 0 {
+			t.Errorf("bob variables = %v", got)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id":"<20240102.1@mg.example.com>","message":"Queued. Thank you."}`))
+	})
+
+	response := mailer.SendEmail(context.Background(), MailRequest{
+		Receivers: []MailReceiver{
+			{TargetId: "target-1", Email: "alice@example.com", Substitutions: map[string]string{"{name}": "Alice"}},
+			{TargetId: "target-2", Email: "bob@example.com"},
+		},
+		Message: MailMessage{
+			SenderAddress: "noreply@example.com",
+			Subject:       "Hi {name}",
+			Body:          "<p>Hello {name}</p>",
+		},
+	})
+
+	if response.Status != string(SendgidStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, SendgidStatus_SENT)
+	}
+	if len(response.Recipients) != 2 {
+		t.Fatalf("recipients = %d, want 2", len(response.Recipients))
+	}
+	for _, recipient := range response.Recipients {
+		// 事件回呼的 message-id 不含角括號，發送紀錄需以相同格式保存
+		if recipient.Status != string(SendgidStatus_SENT) || recipient.ProviderTraceId != "20240102.1@mg.example.com" {
+			t.Errorf("recipient %s = %+v", recipient.TargetId, recipient)
+		}
+	}
+}
+
+func TestMailgunMailerSendEmailRejected(t *testing.T) {
+	mailer := newTestMailgunMailer(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"message":"'to' parameter is not a valid address. please check documentation"}`))
+	})
+
+	response := mailer.SendEmail(context.Background(), MailRequest{
+		Receivers: []MailReceiver{
+			{TargetId: "target-1", Email: "invalid"},
+			{TargetId: "target-2", Email: "bob@example.com"},
+		},
+		Message: MailMessage{SenderAddress: "noreply@example.com", Subject: "Hi", Body: "Hello"},
+	})
+
+	if response.Status != string(SendgidStatus_FAILED) {
+		t.Fatalf("status = %s, want %s", response.Status, SendgidStatus_FAILED)
+	}
+	if len(response.Recipients) != 2 {
+		t.Fatalf("recipients = %d, want 2", len(response.Recipients))
+	}
+	for _, recipient := range response.Recipients {
+		if recipient.Status != string(SendgidStatus_FAILED) || recipient.ProviderTraceId != "" {
+			t.Errorf("recipient %s = %+v", recipient.TargetId, recipient)
+		}
+	}
+}
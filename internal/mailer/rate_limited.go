@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"context"
+
+	component "notify-service/internal/components"
+)
+
+// RateLimitedMailer 在呼叫發送商前取得共用的發送額度，超過速率時等待而非直接失敗
+type RateLimitedMailer struct {
+	provider      MailProvider
+	limiter       *component.DbRateLimiter
+	key           string
+	ratePerSecond float64
+	burst         int
+}
+
+func NewRateLimitedMailer(
+	provider MailProvider,
+	limiter *component.DbRateLimiter,
+	key string,
+	ratePerSecond float64,
+	burst int,
+) MailProvider {
+	if ratePerSecond <= 0 {
+		return provider
+	}
+	return &RateLimitedMailer{
+		provider:      provider,
+		limiter:       limiter,
+		key:           key,
+		ratePerSecond: ratePerSecond,
+		burst:         burst,
+	}
+}
+
+func (s *RateLimitedMailer) SendEmail(ctx context.Context, request MailRequest) MailResponse {
+	// 發送商以收件者數計算速率，每位收件者取一個令牌
+	if err := s.limiter.Wait(ctx, "mail:"+s.key, len(request.Receivers), s.ratePerSecond, s.burst); err != nil {
+		return newMailResponse(SendgidStatus_FAILED, "", "RATE_LIMIT_WAIT_FAILED: "+err.Error())
+	}
+	return s.provider.SendEmail(ctx, request)
+}
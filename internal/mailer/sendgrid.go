@@ -31,6 +31,9 @@ func (s *SendGridMailer) SendEmail(ctx context.Context, request MailRequest) Mai
 		toEmail := helper.NewEmail("", receiver.Email)
 		personalization := helper.NewPersonalization()
 		personalization.AddTos(toEmail)
+		for key, value := range receiver.Substitutions {
+			personalization.SetSubstitution(key, value)
+		}
 		message.AddPersonalizations(personalization)
 	}
 
@@ -0,0 +1,292 @@
+package mailer
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SesAPIDomain      = "https://email.%s.amazonaws.com"
+	SesSendEmailPath  = "/v2/email/outbound-emails"
+	SesSendBulkPath   = "/v2/email/outbound-bulk-emails"
+	SesBulkEntryLimit = 50 // SendBulkEmail 每次請求的收件者上限
+
+	sesService       = "ses"
+	sesSigningMethod = "AWS4-HMAC-SHA256"
+	sesCharset       = "UTF-8"
+
+	sesBulkStatus_SUCCESS = "SUCCESS"
+)
+
+// SesMailer 使用 Amazon SES v2 HTTP API 發送，請求以 SigV4 簽章；
+// 無個人化變數的收件者以 SendBulkEmail 分批發送，有個人化變數的收件者以 SendEmail 逐筆發送
+type SesMailer struct {
+	config *shared.Config
+	resty  *resty.Client
+}
+
+func NewSesMailer(
+	config *shared.Config,
+	resty *resty.Client,
+) *SesMailer {
+	return &SesMailer{
+		config: config,
+		resty:  resty,
+	}
+}
+
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+type sesDestination struct {
+	ToAddresses []string `json:"ToAddresses"`
+}
+
+type sesSendEmailRequest struct {
+	FromEmailAddress string         `json:"FromEmailAddress"`
+	Destination      sesDestination `json:"Destination"`
+	Content          struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				Html sesContent `json:"Html"`
+			} `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+}
+
+type sesSendEmailResponse struct {
+	MessageId string `json:"MessageId"`
+}
+
+type sesBulkEmailEntry struct {
+	Destination sesDestination `json:"Destination"`
+}
+
+type sesSendBulkEmailRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	DefaultContent   struct {
+		Template struct {
+			TemplateContent struct {
+				Subject string `json:"Subject"`
+				Html    string `json:"Html"`
+			} `json:"TemplateContent"`
+			TemplateData string `json:"TemplateData"`
+		} `json:"Template"`
+	} `json:"DefaultContent"`
+	BulkEmailEntries []sesBulkEmailEntry `json:"BulkEmailEntries"`
+}
+
+type sesSendBulkEmailResponse struct {
+	BulkEmailEntryResults []struct {
+		Status    string `json:"Status"`
+		Error     string `json:"Error"`
+		MessageId string `json:"MessageId"`
+	} `json:"BulkEmailEntryResults"`
+}
+
+type sesErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func (s *SesMailer) SendEmail(ctx context.Context, request MailRequest) MailResponse {
+	var bulkReceivers []MailReceiver
+	recipients := make([]MailRecipientResponse, 0, len(request.Receivers))
+	for _, receiver := range request.Receivers {
+		if len(receiver.Substitutions) > 0 {
+			recipients = append(recipients, s.sendSingleEmail(ctx, request.Message, receiver))
+			continue
+		}
+		bulkReceivers = append(bulkReceivers, receiver)
+	}
+
+	for start := 0; start < len(bulkReceivers); start += SesBulkEntryLimit {
+		end := min(start+SesBulkEntryLimit, len(bulkReceivers))
+		recipients = append(recipients, s.sendBulkEmail(ctx, request.Message, bulkReceivers[start:end])...)
+	}
+	return newRecipientsResponse(recipients)
+}
+
+func (s *SesMailer) sendSingleEmail(ctx context.Context, message MailMessage, receiver MailReceiver) MailRecipientResponse {
+	var body sesSendEmailRequest
+	body.FromEmailAddress = formatFromAddress(message)
+	body.Destination.ToAddresses = []string{receiver.Email}
+	body.Content.Simple.Subject = sesContent{Data: renderSubstitutions(message.Subject, receiver.Substitutions), Charset: sesCharset}
+	body.Content.Simple.Body.Html = sesContent{Data: renderSubstitutions(message.Body, receiver.Substitutions), Charset: sesCharset}
+
+	var result sesSendEmailResponse
+	content, err := s.post(ctx, SesSendEmailPath, body, &result)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("SES SendEmail failed")
+		return newFailedRecipientResponse(receiver, err.Error())
+	}
+	return MailRecipientResponse{
+		TargetId:         receiver.TargetId,
+		Status:           string(SendgidStatus_SENT),
+		ProviderTraceId:  result.MessageId,
+		ProviderResponse: content,
+	}
+}
+
+// TemplateContent 不含 Handlebars 變數，僅用於取得各收件者的發送結果與訊息 ID
+func (s *SesMailer) sendBulkEmail(ctx context.Context, message MailMessage, receivers []MailReceiver) []MailRecipientResponse {
+	var body sesSendBulkEmailRequest
+	body.FromEmailAddress = formatFromAddress(message)
+	body.DefaultContent.Template.TemplateContent.Subject = message.Subject
+	body.DefaultContent.Template.TemplateContent.Html = message.Body
+	body.DefaultContent.Template.TemplateData = "{}"
+	for _, receiver := range receivers {
+		body.BulkEmailEntries = append(body.BulkEmailEntries, sesBulkEmailEntry{
+			Destination: sesDestination{ToAddresses: []string{receiver.Email}},
+		})
+	}
+
+	var result sesSendBulkEmailResponse
+	content, err := s.post(ctx, SesSendBulkPath, body, &result)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("SES SendBulkEmail failed")
+		recipients := make([]MailRecipientResponse, 0, len(receivers))
+		for _, receiver := range receivers {
+			recipients = append(recipients, newFailedRecipientResponse(receiver, err.Error()))
+		}
+		return recipients
+	}
+
+	// 結果依請求的收件者順序回傳
+	recipients := make([]MailRecipientResponse, 0, len(receivers))
+	for i, receiver := range receivers {
+		if i >= len(result.BulkEmailEntryResults) {
+			recipients = append(recipients, newFailedRecipientResponse(receiver, "MISSING_BULK_EMAIL_ENTRY_RESULT"))
+			continue
+		}
+
+		entry := result.BulkEmailEntryResults[i]
+		if entry.Status != sesBulkStatus_SUCCESS {
+			recipients = append(recipients, newFailedRecipientResponse(receiver, entry.Status+": "+entry.Error))
+			continue
+		}
+		recipients = append(recipients, MailRecipientResponse{
+			TargetId:         receiver.TargetId,
+			Status:           string(SendgidStatus_SENT),
+			ProviderTraceId:  entry.MessageId,
+			ProviderResponse: content,
+		})
+	}
+	return recipients
+}
+
+func (s *SesMailer) post(ctx context.Context, path string, body interface{}, result interface{}) (string, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("marshal ses request failed: %w", err)
+	}
+
+	endpoint := s.getApiDomain() + path
+	headers, err := s.sign(endpoint, payload, time.Now().UTC())
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetHeaders(headers).
+		SetBody(payload).
+		Post(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if resp.IsError() {
+		var sesError sesErrorResponse
+		_ = json.Unmarshal(resp.Body(), &sesError)
+		return resp.String(), fmt.Errorf("ses request failed with status code %d: %s", resp.StatusCode(), sesError.Message)
+	}
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return resp.String(), fmt.Errorf("invalid ses response: %w", err)
+	}
+	return resp.String(), nil
+}
+
+// sign 產生 SigV4 簽章所需的標頭，簽署 content-type、host 與 x-amz-date
+func (s *SesMailer) sign(endpoint string, payload []byte, now time.Time) (map[string]string, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ses endpoint: %w", err)
+	}
+
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	contentType := "application/json"
+	signedHeaders := "content-type;host;x-amz-date"
+
+	canonicalRequest := strings.Join([]string{
+		"POST",
+		parsed.EscapedPath(),
+		"",
+		"content-type:" + contentType,
+		"host:" + parsed.Host,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	scope := strings.Join([]string{date, s.config.SesRegion, sesService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		sesSigningMethod,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSha256([]byte("AWS4"+s.config.SesSecretAccessKey), date)
+	signingKey = hmacSha256(signingKey, s.config.SesRegion)
+	signingKey = hmacSha256(signingKey, sesService)
+	signingKey = hmacSha256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(signingKey, stringToSign))
+
+	return map[string]string{
+		"Content-Type": contentType,
+		"X-Amz-Date":   amzDate,
+		"Authorization": fmt.Sprintf(
+			"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+			sesSigningMethod,
+			s.config.SesAccessKeyId,
+			scope,
+			signedHeaders,
+			signature,
+		),
+	}, nil
+}
+
+// 可設定 API 網域以指向測試用的替代服務
+func (s *SesMailer) getApiDomain() string {
+	if s.config.SesApiBaseUrl != "" {
+		return strings.TrimRight(s.config.SesApiBaseUrl, "/")
+	}
+	return fmt.Sprintf(SesAPIDomain, s.config.SesRegion)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
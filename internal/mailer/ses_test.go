@@ -0,0 +1,189 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func newTestSesMailer(t *testing.T, handler http.HandlerFunc) *SesMailer {
+	t.Helper()
+
+	config := &shared.Config{
+		SesRegion:          "us-east-1",
+		SesAccessKeyId:     "AKID",
+		SesSecretAccessKey: "SECRET",
+	}
+	mailer := NewSesMailer(config, resty.New())
+
+	// 以收到的請求重新計算簽章，確認簽署的 host、路徑與內容與實際送出的一致
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		signedAt, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+		if err != nil {
+			t.Fatalf("parse X-Amz-Date: %v", err)
+		}
+		headers, err := mailer.sign("http://"+r.Host+r.URL.Path, body, signedAt)
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		if got := r.Header.Get("Authorization"); got != headers["Authorization"] {
+			t.Errorf("Authorization = %q, want %q", got, headers["Authorization"])
+		}
+
+		r.Body = io.NopCloser(strings.NewReader(string(body)))
+		handler(rw, r)
+	}))
+	t.Cleanup(server.Close)
+
+	config.SesApiBaseUrl = server.URL
+	return mailer
+}
+
+func TestSesMailerSign(t *testing.T) {
+	mailer := NewSesMailer(&shared.Config{
+		SesRegion:          "us-east-1",
+		SesAccessKeyId:     "AKID",
+		SesSecretAccessKey: "SECRET",
+	}, resty.New())
+
+	headers, err := mailer.sign(
+		"https://email.us-east-1.amazonaws.com/v2/email/outbound-emails",
+		[]byte("{}"),
+		time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	want := "AWS4-HMAC-SHA256 Credential=AKID/20240102/us-east-1/ses/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-date, " +
+		"Signature=91d9576d5631695e910c6fe800163d453645f9478213334783dace12a32850e8"
+	if got := headers["Authorization"]; got != want {
+		t.Fatalf("Authorization = %q, want %q", got, want)
+	}
+	if got := headers["X-Amz-Date"]; got != "20240102T030405Z" {
+		t.Fatalf("X-Amz-Date = %q", got)
+	}
+}
+
+func TestSesMailerSendEmail(t *testing.T) {
+	mailer := newTestSesMailer(t, func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SesSendEmailPath:
+			var body sesSendEmailRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode SendEmail request: %v", err)
+			}
+			if got := body.Destination.ToAddresses; len(got) != 1 || got[0] != "alice@example.com" {
+				t.Errorf("ToAddresses = %v", got)
+			}
+			if got := body.Content.Simple.Subject.Data; got != "Hi Alice" {
+				t.Errorf("Subject = %q", got)
+			}
+			if got := body.Content.Simple.Body.Html.Data; got != "<p>Hello Alice</p>" {
+				t.Errorf("Html = %q", got)
+			}
+			if got := body.FromEmailAddress; got != `"Notify" <noreply@example.com>` {
+				t.Errorf("FromEmailAddress = %q", got)
+			}
+			rw.Write([]byte(`{"MessageId":"ses-single"}`))
+		case SesSendBulkPath:
+			var body sesSendBulkEmailRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode SendBulkEmail request: %v", err)
+			}
+			if got := len(body.BulkEmailEntries); got != 2 {
+				t.Fatalf("BulkEmailEntries = %d, want 2", got)
+			}
+			if got := body.DefaultContent.Template.TemplateContent.Subject; got != "Hi {name}" {
+				t.Errorf("TemplateContent.Subject = %q", got)
+			}
+			rw.Write([]byte(`{"BulkEmailEntryResults":[
+				{"Status":"SUCCESS","MessageId":"ses-bulk-1"},
+				{"Status":"MESSAGE_REJECTED","Error":"Email address is on the suppression list"}
+			]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	response := mailer.SendEmail(context.Background(), MailRequest{
+		Receivers: []MailReceiver{
+			{TargetId: "target-1", Email: "alice@example.com", Substitutions: map[string]string{"{name}": "Alice"}},
+			{TargetId: "target-2", Email: "bob@example.com"},
+			{TargetId: "target-3", Email: "suppressed@example.com"},
+		},
+		Message: MailMessage{
+			SenderName:    "Notify",
+			SenderAddress: "noreply@example.com",
+			Subject:       "Hi {name}",
+			Body:          "<p>Hello {name}</p>",
+		},
+	})
+
+	if response.Status != string(SendgidStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, SendgidStatus_SENT)
+	}
+	want := map[string]struct {
+		status          SendgidStatus
+		providerTraceId string
+	}{
+		"target-1": {SendgidStatus_SENT, "ses-single"},
+		"target-2": {SendgidStatus_SENT, "ses-bulk-1"},
+		"target-3": {SendgidStatus_FAILED, ""},
+	}
+	if len(response.Recipients) != len(want) {
+		t.Fatalf("recipients = %d, want %d", len(response.Recipients), len(want))
+	}
+	for _, recipient := range response.Recipients {
+		expected, ok := want[recipient.TargetId]
+		if !ok {
+			t.Errorf("unexpected recipient %s", recipient.TargetId)
+			continue
+		}
+		if recipient.Status != string(expected.status) || recipient.ProviderTraceId != expected.providerTraceId {
+			t.Errorf("recipient %s = %+v", recipient.TargetId, recipient)
+		}
+	}
+}
+
+func TestSesMailerSendEmailRejected(t *testing.T) {
+	mailer := newTestSesMailer(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"message":"Email address is not verified."}`))
+	})
+
+	response := mailer.SendEmail(context.Background(), MailRequest{
+		Receivers: []MailReceiver{
+			{TargetId: "target-1", Email: "alice@example.com"},
+			{TargetId: "target-2", Email: "bob@example.com"},
+		},
+		Message: MailMessage{SenderAddress: "noreply@example.com", Subject: "Hi", Body: "Hello"},
+	})
+
+	if response.Status != string(SendgidStatus_FAILED) {
+		t.Fatalf("status = %s, want %s", response.Status, SendgidStatus_FAILED)
+	}
+	for _, recipient := range response.Recipients {
+		if recipient.Status != string(SendgidStatus_FAILED) {
+			t.Errorf("recipient %s status = %s", recipient.TargetId, recipient.Status)
+		}
+		if !strings.Contains(recipient.ProviderResponse, "Email address is not verified.") {
+			t.Errorf("recipient %s provider response = %q", recipient.TargetId, recipient.ProviderResponse)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// FallbackPolicy 前一通道未在期限內送達時，依序改由備援通道發送
+type FallbackPolicy struct {
+	Steps []FallbackStep `json:"steps" validate:"required,max=3,dive"`
+}
+
+// FallbackStep 的收件者依序對應原請求的收件者，空字串表示該收件者不改由此通道及後續通道發送
+type FallbackStep struct {
+	Channel   MessageType   `json:"channel" validate:"required,oneof=sms mail push line"`
+	Deadline  time.Duration `json:"deadline" validate:"min=1m,max=24h"`
+	Receivers []string      `json:"receivers" validate:"required,max=1000"`
+	Sms       *Sms          `json:"sms,omitempty" validate:"required_if=Channel sms"`
+	Mail      *Mail         `json:"mail,omitempty" validate:"required_if=Channel mail"`
+	Push      *Push         `json:"push,omitempty" validate:"required_if=Channel push"`
+	Line      *Line         `json:"line,omitempty" validate:"required_if=Channel line"`
+}
+
+// FallbackPlan 保存於 message_fallbacks.data 的備援內容，Receivers 為受監看訊息的收件者，依序對應各通道的收件者
+type FallbackPlan struct {
+	ClientId  string         `json:"client_id"`
+	Timezone  string         `json:"timezone,omitempty"`
+	Receivers []string       `json:"receivers"`
+	Steps     []FallbackStep `json:"steps"`
+}
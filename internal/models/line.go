@@ -0,0 +1,16 @@
+package model
+
+import "encoding/json"
+
+type Line struct {
+	Text         string `json:"text" validate:"required_without=FlexContents,max=5000"`
+	AltText      string `json:"alt_text" validate:"required_with=FlexContents,max=1500"`
+	FlexContents string `json:"flex_contents" validate:"omitempty,json"`
+}
+
+// LineContent 保存於 messages.data 的 LINE 訊息內容
+type LineContent struct {
+	Text         string          `json:"text,omitempty"`
+	AltText      string          `json:"alt_text,omitempty"`
+	FlexContents json.RawMessage `json:"flex_contents,omitempty"`
+}
@@ -0,0 +1,14 @@
+package model
+
+type Push struct {
+	Title string            `json:"title" validate:"required,max=256"`
+	Body  string            `json:"body" validate:"required,max=4096"`
+	Data  map[string]string `json:"data" validate:"max=100"`
+}
+
+// PushContent 保存於 messages.data 的推播內容
+type PushContent struct {
+	Title string            `json:"title"`
+	Body  string            `json:"body"`
+	Data  map[string]string `json:"data,omitempty"`
+}
@@ -1,6 +1,9 @@
 package model
 
-import notifypb "proto/pkg/notify/v1/notify"
+import (
+	util "notify-service/internal/utils"
+	notifypb "proto/pkg/notify/v1/notify"
+)
 
 type Sms struct {
 	Body string `json:"body" validate:"required"`
@@ -11,11 +14,43 @@ type MessageType string
 const (
 	MessageType_SMS  MessageType = "sms"
 	MessageType_MAIL MessageType = "mail"
+
+	MessageType_WEBHOOK MessageType = "webhook"
+	MessageType_PUSH    MessageType = "push"
+	MessageType_LINE    MessageType = "line"
+)
+
+type Priority string
+
+const (
+	Priority_HIGH   Priority = "high"
+	Priority_NORMAL Priority = "normal"
+	Priority_BULK   Priority = "bulk"
+)
+
+var (
+	ConvertPriorityWithProto = map[notifypb.Priority]Priority{
+		notifypb.Priority_PRIORITY_UNSPECIFIED: Priority_NORMAL,
+		notifypb.Priority_HIGH:                 Priority_HIGH,
+		notifypb.Priority_NORMAL:               Priority_NORMAL,
+		notifypb.Priority_BULK:                 Priority_BULK,
+	}
 )
 
 var (
 	ConvertMessageTypeWithProto = map[notifypb.MessageType]MessageType{
 		notifypb.MessageType_SMS:  MessageType_SMS,
 		notifypb.MessageType_MAIL: MessageType_MAIL,
+
+		notifypb.MessageType_WEBHOOK: MessageType_WEBHOOK,
+		notifypb.MessageType_PUSH:    MessageType_PUSH,
+		notifypb.MessageType_LINE:    MessageType_LINE,
+	}
+)
+
+var (
+	ConvertSmsEncodingToProto = map[util.SmsEncoding]notifypb.SmsEncoding{
+		util.SmsEncoding_GSM7: notifypb.SmsEncoding_GSM7,
+		util.SmsEncoding_UCS2: notifypb.SmsEncoding_UCS2,
 	}
 )
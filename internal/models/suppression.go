@@ -0,0 +1,43 @@
+package model
+
+import notifypb "proto/pkg/notify/v1/notify"
+
+type SuppressionReason string
+
+const (
+	SuppressionReason_BOUNCE         SuppressionReason = "bounce"
+	SuppressionReason_SPAM_REPORT    SuppressionReason = "spam_report"
+	SuppressionReason_INVALID_NUMBER SuppressionReason = "invalid_number"
+	SuppressionReason_MANUAL         SuppressionReason = "manual"
+	SuppressionReason_OPT_OUT        SuppressionReason = "opt_out"
+	SuppressionReason_INVALID_TOKEN  SuppressionReason = "invalid_token"
+)
+
+var (
+	ConvertSuppressionReasonWithProto = map[notifypb.SuppressionReason]SuppressionReason{
+		notifypb.SuppressionReason_BOUNCE:         SuppressionReason_BOUNCE,
+		notifypb.SuppressionReason_SPAM_REPORT:    SuppressionReason_SPAM_REPORT,
+		notifypb.SuppressionReason_INVALID_NUMBER: SuppressionReason_INVALID_NUMBER,
+		notifypb.SuppressionReason_MANUAL:         SuppressionReason_MANUAL,
+		notifypb.SuppressionReason_OPT_OUT:        SuppressionReason_OPT_OUT,
+		notifypb.SuppressionReason_INVALID_TOKEN:  SuppressionReason_INVALID_TOKEN,
+	}
+
+	ConvertSuppressionReasonToProto = map[SuppressionReason]notifypb.SuppressionReason{
+		SuppressionReason_BOUNCE:         notifypb.SuppressionReason_BOUNCE,
+		SuppressionReason_SPAM_REPORT:    notifypb.SuppressionReason_SPAM_REPORT,
+		SuppressionReason_INVALID_NUMBER: notifypb.SuppressionReason_INVALID_NUMBER,
+		SuppressionReason_MANUAL:         notifypb.SuppressionReason_MANUAL,
+		SuppressionReason_OPT_OUT:        notifypb.SuppressionReason_OPT_OUT,
+		SuppressionReason_INVALID_TOKEN:  notifypb.SuppressionReason_INVALID_TOKEN,
+	}
+
+	ConvertMessageTypeToProto = map[MessageType]notifypb.MessageType{
+		MessageType_SMS:  notifypb.MessageType_SMS,
+		MessageType_MAIL: notifypb.MessageType_MAIL,
+
+		MessageType_WEBHOOK: notifypb.MessageType_WEBHOOK,
+		MessageType_PUSH:    notifypb.MessageType_PUSH,
+		MessageType_LINE:    notifypb.MessageType_LINE,
+	}
+)
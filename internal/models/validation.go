@@ -7,22 +7,137 @@ import (
 
 type SendSmsRequest struct {
 	Sms
-	Receivers   []string   `json:"receivers" validate:"required,min=1,max=1000,dive,regexp=09[0-9]{8}"`
-	ScheduledAt *time.Time `json:"scheduled_at"`
+	Receivers   []string        `json:"receivers" validate:"required_without=GroupIds,max=1000,dive,phone"`
+	GroupIds    []string        `json:"group_ids" validate:"required_without=Receivers,max=100,dive,required"`
+	ScheduledAt *time.Time      `json:"scheduled_at"`
+	Priority    Priority        `json:"priority" validate:"required,oneof=high normal bulk"`
+	ValidUntil  *time.Time      `json:"valid_until"`
+	Timezone    string          `json:"timezone" validate:"omitempty,timezone"`
+	Category    string          `json:"category" validate:"omitempty,max=64"`
+	ClientId    string          `json:"client_id"`
+	Fallback    *FallbackPolicy `json:"fallback"`
+
+	// 內部使用，超過頻率上限而延後發送的訊息不再重複計算上限
+	SkipFrequencyCap bool `json:"-"`
+	// 內部使用，備援訊息記錄觸發的原訊息
+	FallbackFromMessageId string `json:"-"`
+	// 內部使用，多通道通知的通道訊息記錄所屬通知
+	NotificationId string `json:"-"`
+	// 內部使用，各接收者的範本變數（已加密），例如一次性密碼的驗證碼不保存於訊息內容
+	ReceiverVariables map[string]string `json:"-"`
 }
 
 type SendMailRequest struct {
 	Mail
-	Receivers   []string   `json:"receivers" validate:"required,min=1,max=1000,dive,email"`
+	Receivers   []string        `json:"receivers" validate:"required_without=GroupIds,max=1000,dive,email"`
+	GroupIds    []string        `json:"group_ids" validate:"required_without=Receivers,max=100,dive,required"`
+	ScheduledAt *time.Time      `json:"scheduled_at"`
+	Priority    Priority        `json:"priority" validate:"required,oneof=high normal bulk"`
+	ValidUntil  *time.Time      `json:"valid_until"`
+	Timezone    string          `json:"timezone" validate:"omitempty,timezone"`
+	Category    string          `json:"category" validate:"omitempty,max=64"`
+	ClientId    string          `json:"client_id"`
+	Fallback    *FallbackPolicy `json:"fallback"`
+
+	// 內部使用，超過頻率上限而延後發送的訊息不再重複計算上限
+	SkipFrequencyCap bool `json:"-"`
+	// 內部使用，備援訊息記錄觸發的原訊息
+	FallbackFromMessageId string `json:"-"`
+	// 內部使用，多通道通知的通道訊息記錄所屬通知
+	NotificationId string `json:"-"`
+	// 內部使用，各接收者的範本變數（已加密），例如一次性密碼的驗證碼不保存於訊息內容
+	ReceiverVariables map[string]string `json:"-"`
+}
+
+type SendPushRequest struct {
+	Push
+	Receivers   []string        `json:"device_tokens" validate:"required,max=1000,dive,required,max=4096"`
+	ScheduledAt *time.Time      `json:"scheduled_at"`
+	Priority    Priority        `json:"priority" validate:"required,oneof=high normal bulk"`
+	ValidUntil  *time.Time      `json:"valid_until"`
+	Timezone    string          `json:"timezone" validate:"omitempty,timezone"`
+	Category    string          `json:"category" validate:"omitempty,max=64"`
+	ClientId    string          `json:"client_id"`
+	Fallback    *FallbackPolicy `json:"fallback"`
+
+	// 內部使用，超過頻率上限而延後發送的訊息不再重複計算上限
+	SkipFrequencyCap bool `json:"-"`
+	// 內部使用，備援訊息記錄觸發的原訊息
+	FallbackFromMessageId string `json:"-"`
+	// 內部使用，多通道通知的通道訊息記錄所屬通知
+	NotificationId string `json:"-"`
+}
+
+type SendLineRequest struct {
+	Line
+	Receivers   []string        `json:"user_ids" validate:"required,max=1000,dive,line_user_id"`
+	ScheduledAt *time.Time      `json:"scheduled_at"`
+	Priority    Priority        `json:"priority" validate:"required,oneof=high normal bulk"`
+	ValidUntil  *time.Time      `json:"valid_until"`
+	Timezone    string          `json:"timezone" validate:"omitempty,timezone"`
+	Category    string          `json:"category" validate:"omitempty,max=64"`
+	ClientId    string          `json:"client_id"`
+	Fallback    *FallbackPolicy `json:"fallback"`
+
+	// 內部使用，超過頻率上限而延後發送的訊息不再重複計算上限
+	SkipFrequencyCap bool `json:"-"`
+	// 內部使用，備援訊息記錄觸發的原訊息
+	FallbackFromMessageId string `json:"-"`
+	// 內部使用，多通道通知的通道訊息記錄所屬通知
+	NotificationId string `json:"-"`
+}
+
+type SendWebhookRequest struct {
+	Webhook
 	ScheduledAt *time.Time `json:"scheduled_at"`
+	Priority    Priority   `json:"priority" validate:"required,oneof=high normal bulk"`
+	ValidUntil  *time.Time `json:"valid_until"`
+	Category    string     `json:"category" validate:"omitempty,max=64"`
+	ClientId    string     `json:"client_id"`
+}
+
+// 各通道的內容與收件者成對填寫，至少填寫一個通道
+type SendNotificationRequest struct {
+	Sms           *Sms       `json:"sms" validate:"required_with=SmsReceivers,required_without_all=Mail Push Line"`
+	SmsReceivers  []string   `json:"sms_receivers" validate:"required_with=Sms,max=1000,dive,phone"`
+	Mail          *Mail      `json:"mail" validate:"required_with=MailReceivers"`
+	MailReceivers []string   `json:"mail_receivers" validate:"required_with=Mail,max=1000,dive,email"`
+	Push          *Push      `json:"push" validate:"required_with=DeviceTokens"`
+	DeviceTokens  []string   `json:"device_tokens" validate:"required_with=Push,max=1000,dive,required,max=4096"`
+	Line          *Line      `json:"line" validate:"required_with=LineUserIds"`
+	LineUserIds   []string   `json:"line_user_ids" validate:"required_with=Line,max=1000,dive,line_user_id"`
+	ScheduledAt   *time.Time `json:"scheduled_at"`
+	Priority      Priority   `json:"priority" validate:"required,oneof=high normal bulk"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	Timezone      string     `json:"timezone" validate:"omitempty,timezone"`
+	Category      string     `json:"category" validate:"omitempty,max=64"`
+	ClientId      string     `json:"client_id"`
+}
+
+type EstimateSmsRequest struct {
+	Sms
+	Receivers []string `json:"receivers" validate:"max=1000,dive,phone"`
+	GroupIds  []string `json:"group_ids" validate:"max=100,dive,required"`
 }
 
 type CancelScheduledByMessageIdRequest struct {
 	MessageId string `json:"message_id" validate:"required"`
 }
 
+type GetMessageRequest struct {
+	MessageId string `json:"message_id" validate:"required"`
+}
+
+type CancelNotificationRequest struct {
+	NotificationId string `json:"notification_id" validate:"required"`
+}
+
+type GetNotificationRequest struct {
+	NotificationId string `json:"notification_id" validate:"required"`
+}
+
 type ListStatusWithPagingRequest struct {
-	MessageType string                `json:"message_type" validate:"required,oneof=sms mail"`
+	MessageType string                `json:"message_type" validate:"required,oneof=sms mail webhook push line"`
 	MessageId   string                `json:"message_id" validate:"omitempty,required_without=receiver"`
 	Receiver    string                `json:"receiver" validate:"omitempty,required_without=message_id"`
 	Page        *notifypb.PageRequest `json:"page" validate:"required"`
@@ -36,3 +151,138 @@ type PageRequest struct {
 	SortField *string `json:"sort_field" validate:"omitempty"`                // 排序字段
 	SortOrder *string `json:"sort_order" validate:"omitempty,oneof=asc desc"` // 排序方式 asc 或 desc
 }
+
+type RequestOtpRequest struct {
+	Receiver string        `json:"receiver" validate:"required"`
+	Channel  MessageType   `json:"channel" validate:"required,oneof=sms mail"`
+	Purpose  string        `json:"purpose" validate:"required,max=64"`
+	Ttl      time.Duration `json:"ttl" validate:"gte=0"`
+}
+
+type VerifyOtpRequest struct {
+	Receiver string `json:"receiver" validate:"required"`
+	Purpose  string `json:"purpose" validate:"required,max=64"`
+	Code     string `json:"code" validate:"required,numeric"`
+}
+
+// 依發送通道驗證收件者格式
+type SmsReceiver struct {
+	Receiver string `json:"receiver" validate:"required,phone"`
+}
+
+type MailReceiver struct {
+	Receiver string `json:"receiver" validate:"required,email"`
+}
+
+type PushReceiver struct {
+	Receiver string `json:"receiver" validate:"required,max=4096"`
+}
+
+type LineReceiver struct {
+	Receiver string `json:"receiver" validate:"required,line_user_id"`
+}
+
+type ListSuppressionsRequest struct {
+	Channel   MessageType           `json:"channel" validate:"required,oneof=sms mail push line"`
+	Receiver  string                `json:"receiver" validate:"omitempty"`
+	Reason    SuppressionReason     `json:"reason" validate:"omitempty,oneof=bounce spam_report invalid_number manual opt_out invalid_token"`
+	Page      *notifypb.PageRequest `json:"page" validate:"required"`
+	SortField string                `json:"sort_field" validate:"omitempty,oneof=reason expires_at created_at updated_at"` // 排序欄位，僅允許列出的欄位
+	SortOrder string                `json:"sort_order" validate:"omitempty,oneof=asc desc ASC DESC"`                       // 排序方向
+}
+
+type AddSuppressionRequest struct {
+	Channel   MessageType       `json:"channel" validate:"required,oneof=sms mail push line"`
+	Receiver  string            `json:"receiver" validate:"required"`
+	Reason    SuppressionReason `json:"reason" validate:"required,oneof=bounce spam_report invalid_number manual opt_out invalid_token"`
+	ExpiresAt *time.Time        `json:"expires_at"`
+}
+
+type RemoveSuppressionRequest struct {
+	Channel  MessageType `json:"channel" validate:"required,oneof=sms mail push line"`
+	Receiver string      `json:"receiver" validate:"required"`
+}
+
+type ListInboundMessagesWithPagingRequest struct {
+	Sender    string                `json:"sender" validate:"omitempty"`
+	Page      *notifypb.PageRequest `json:"page" validate:"required"`
+	StartAt   *time.Time            `json:"start_at" validate:"required"`
+	EndAt     *time.Time            `json:"end_at" validate:"required"`
+	SortField string                `json:"sort_field" validate:"omitempty,oneof=received_at created_at"` // 排序欄位，僅允許列出的欄位
+	SortOrder string                `json:"sort_order" validate:"omitempty,oneof=asc desc ASC DESC"`      // 排序方向
+}
+
+type ContactRequest struct {
+	Name  string `json:"name" validate:"max=128"`
+	Phone string `json:"phone" validate:"required_without=Email,omitempty,phone"`
+	Email string `json:"email" validate:"required_without=Phone,omitempty,email"`
+}
+
+type UpdateContactRequest struct {
+	ContactRequest
+	Id string `json:"id" validate:"required"`
+}
+
+type DeleteContactRequest struct {
+	Id string `json:"id" validate:"required"`
+}
+
+type ListContactsWithPagingRequest struct {
+	GroupId   string                `json:"group_id" validate:"omitempty"`
+	Phone     string                `json:"phone" validate:"omitempty"`
+	Email     string                `json:"email" validate:"omitempty"`
+	Page      *notifypb.PageRequest `json:"page" validate:"required"`
+	SortField string                `json:"sort_field" validate:"omitempty,oneof=name created_at updated_at"` // 排序欄位，僅允許列出的欄位
+	SortOrder string                `json:"sort_order" validate:"omitempty,oneof=asc desc ASC DESC"`          // 排序方向
+}
+
+type ImportContactsRequest struct {
+	Contacts []ContactRequest `json:"contacts" validate:"required,min=1,max=1000,dive"`
+	GroupId  string           `json:"group_id" validate:"omitempty"`
+}
+
+type CreateGroupRequest struct {
+	Name        string `json:"name" validate:"required,max=128"`
+	Description string `json:"description" validate:"max=512"`
+}
+
+type UpdateGroupRequest struct {
+	CreateGroupRequest
+	Id string `json:"id" validate:"required"`
+}
+
+type DeleteGroupRequest struct {
+	Id string `json:"id" validate:"required"`
+}
+
+type ListGroupsWithPagingRequest struct {
+	Name      string                `json:"name" validate:"omitempty"`
+	Page      *notifypb.PageRequest `json:"page" validate:"required"`
+	SortField string                `json:"sort_field" validate:"omitempty,oneof=name member_count created_at updated_at"` // 排序欄位，僅允許列出的欄位
+	SortOrder string                `json:"sort_order" validate:"omitempty,oneof=asc desc ASC DESC"`                       // 排序方向
+}
+
+type GroupMembersRequest struct {
+	GroupId    string   `json:"group_id" validate:"required"`
+	ContactIds []string `json:"contact_ids" validate:"required,min=1,max=1000,dive,required"`
+}
+
+type BulkSendHeaderRequest struct {
+	TemplateId  string     `json:"template_id" validate:"required"`
+	Priority    Priority   `json:"priority" validate:"required,oneof=high normal bulk"`
+	ScheduledAt *time.Time `json:"scheduled_at"`
+	ValidUntil  *time.Time `json:"valid_until"`
+	Timezone    string     `json:"timezone" validate:"omitempty,timezone"`
+	Category    string     `json:"category" validate:"omitempty,max=64"`
+	ClientId    string     `json:"client_id"`
+}
+
+type GetBulkSendJobRequest struct {
+	JobId    string `json:"job_id" validate:"required"`
+	ClientId string `json:"client_id"`
+}
+
+type DownloadBulkSendReportRequest struct {
+	JobId    string `json:"job_id" validate:"required"`
+	ClientId string `json:"client_id"`
+}
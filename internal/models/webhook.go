@@ -0,0 +1,14 @@
+package model
+
+type Webhook struct {
+	Url     string            `json:"url" validate:"required,http_url,max=2048"`
+	Method  string            `json:"method" validate:"required,oneof=GET POST PUT PATCH DELETE"`
+	Headers map[string]string `json:"headers" validate:"max=50"`
+	Body    string            `json:"body" validate:"max=65536"`
+}
+
+// WebhookContent 保存於 messages.data 的推送內容，自訂標頭可能含憑證，另以加密方式保存於 targets.variables
+type WebhookContent struct {
+	Method string `json:"method"`
+	Body   string `json:"body"`
+}
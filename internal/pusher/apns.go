@@ -0,0 +1,200 @@
+package pusher
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ApnsAPIDomain = "https://api.push.apple.com"
+	ApnsSendPath  = "/3/device/{token}"
+
+	// 驗證權杖需在 20 至 60 分鐘之間更新
+	apnsTokenTTL = 40 * time.Minute
+
+	apnsReason_EXPIRED_PROVIDER_TOKEN = "ExpiredProviderToken"
+)
+
+// 代表裝置 token 無效的錯誤原因，裝置 token 會被加入抑制名單
+var ApnsInvalidTokenReasons = map[string]bool{
+	"BadDeviceToken":         true, // token 格式錯誤或與環境不符
+	"DeviceTokenNotForTopic": true, // token 不屬於此 App
+	"Unregistered":           true, // App 已移除或 token 已失效
+}
+
+// ApnsPusher 使用 APNs HTTP/2 API 發送，以 .p8 金鑰簽署的 ES256 JWT 驗證，JWT 於程序內快取並定期更新
+type ApnsPusher struct {
+	config *shared.Config
+	resty  *resty.Client
+	key    crypto.Signer
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+type apnsErrorResponse struct {
+	Reason string `json:"reason"`
+}
+
+func NewApnsPusher(config *shared.Config) (*ApnsPusher, error) {
+	content, err := os.ReadFile(config.ApnsKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read apns key file failed: %w", err)
+	}
+	key, err := parsePrivateKey(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apns key: %w", err)
+	}
+
+	client, err := newPushClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &ApnsPusher{
+		config: config,
+		resty:  client,
+		key:    key,
+	}, nil
+}
+
+func (p *ApnsPusher) SendBatchPush(ctx context.Context, request PushBatchRequest) PushBatchResponse {
+	return sendConcurrently(ctx, p.config, request, p.sendPush)
+}
+
+func (p *ApnsPusher) sendPush(ctx context.Context, message PushMessage, receiver PushReceiver) PushResponse {
+	// 自訂資料置於 aps 以外的最上層欄位
+	payload := map[string]interface{}{}
+	for key, value := range message.Data {
+		payload[key] = value
+	}
+	payload["aps"] = map[string]interface{}{
+		"alert": map[string]string{
+			"title": message.Title,
+			"body":  message.Body,
+		},
+		"sound": "default",
+	}
+
+	priority := "5"
+	if message.HighPriority {
+		priority = "10"
+	}
+	expiration := "0"
+	if message.ValidUntil != nil {
+		expiration = strconv.FormatInt(message.ValidUntil.Unix(), 10)
+	}
+	headers := map[string]string{
+		"apns-topic":      p.config.ApnsTopic,
+		"apns-push-type":  "alert",
+		"apns-priority":   priority,
+		"apns-expiration": expiration,
+	}
+
+	resp, err := p.post(ctx, receiver.Token, headers, payload)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"target_id": receiver.TargetId,
+		}).Error("APNs request failed")
+		return newFailedPushResponse(receiver, err.Error(), false)
+	}
+
+	if resp.IsError() {
+		var result apnsErrorResponse
+		_ = json.Unmarshal(resp.Body(), &result)
+		log.WithContext(ctx).WithFields(log.Fields{
+			"target_id":   receiver.TargetId,
+			"status_code": resp.StatusCode(),
+			"reason":      result.Reason,
+		}).Error("APNs rejected message")
+		return newFailedPushResponse(receiver, resp.String(), ApnsInvalidTokenReasons[result.Reason])
+	}
+
+	// 成功時回應內容為空，訊息 ID 位於 apns-id 標頭
+	apnsId := resp.Header().Get("apns-id")
+	providerResponse, _ := json.Marshal(map[string]interface{}{
+		"ProviderTraceId": apnsId,
+		"StatusCode":      resp.StatusCode(),
+	})
+	return PushResponse{
+		Status:           string(PushStatus_SENT),
+		TraceId:          receiver.TargetId,
+		ProviderTraceId:  apnsId,
+		ProviderResponse: string(providerResponse),
+	}
+}
+
+// 驗證權杖逾期時 API 回傳 403 ExpiredProviderToken，重新簽署後重試一次
+func (p *ApnsPusher) post(ctx context.Context, deviceToken string, headers map[string]string, payload interface{}) (*resty.Response, error) {
+	for attempt := 0; ; attempt++ {
+		token, err := p.getToken(attempt > 0)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := p.resty.R().
+			SetContext(ctx).
+			SetHeaders(headers).
+			SetHeader("Authorization", "bearer "+token).
+			SetPathParam("token", deviceToken).
+			SetBody(payload).
+			Post(p.getApiDomain() + ApnsSendPath)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() == http.StatusForbidden && attempt == 0 {
+			var result apnsErrorResponse
+			if json.Unmarshal(resp.Body(), &result) == nil && result.Reason == apnsReason_EXPIRED_PROVIDER_TOKEN {
+				continue
+			}
+		}
+		return resp, nil
+	}
+}
+
+func (p *ApnsPusher) getToken(refresh bool) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !refresh && p.token != "" && time.Now().Before(p.expiresAt) {
+		return p.token, nil
+	}
+
+	now := time.Now()
+	token, err := signJwt(
+		map[string]string{"alg": "ES256", "kid": p.config.ApnsKeyId},
+		map[string]interface{}{
+			"iss": p.config.ApnsTeamId,
+			"iat": now.Unix(),
+		},
+		p.key,
+	)
+	if err != nil {
+		return "", fmt.Errorf("sign apns jwt failed: %w", err)
+	}
+
+	p.token = token
+	p.expiresAt = now.Add(apnsTokenTTL)
+	return p.token, nil
+}
+
+// 可設定 API 網域，例如開發環境的 https://api.sandbox.push.apple.com 或測試用的替代服務
+func (p *ApnsPusher) getApiDomain() string {
+	if p.config.ApnsApiBaseUrl != "" {
+		return strings.TrimRight(p.config.ApnsApiBaseUrl, "/")
+	}
+	return ApnsAPIDomain
+}
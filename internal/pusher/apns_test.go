@@ -0,0 +1,162 @@
+package pusher
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testApnsToken        = "a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	testApnsInvalidToken = "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+)
+
+type testApnsServer struct {
+	key *ecdsa.PrivateKey
+
+	mu sync.Mutex
+	// 視為已逾期的驗證權杖，使用時回傳 403 ExpiredProviderToken
+	expiredTokens map[string]bool
+	tokens        []string
+}
+
+func (s *testApnsServer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("apns-topic") != "com.example.app" || r.Header.Get("apns-push-type") != "alert" {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"reason":"MissingTopic"}`))
+		return
+	}
+
+	// 以 .p8 金鑰的公鑰驗證 ES256 的 r||s 簽章
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte(`{"reason":"MissingProviderToken"}`))
+		return
+	}
+	header, claims, digest, signature := decodeJwtParts(parts)
+	if header["kid"] != "KEY123" || claims["iss"] != "TEAM123" || len(signature) != 64 ||
+		!ecdsa.Verify(&s.key.PublicKey, digest, new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])) {
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte(`{"reason":"InvalidProviderToken"}`))
+		return
+	}
+
+	s.mu.Lock()
+	s.tokens = append(s.tokens, token)
+	expired := s.expiredTokens[token]
+	s.mu.Unlock()
+	if expired {
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte(`{"reason":"ExpiredProviderToken"}`))
+		return
+	}
+
+	if strings.HasSuffix(r.URL.Path, "/"+testApnsInvalidToken) {
+		rw.WriteHeader(http.StatusGone)
+		rw.Write([]byte(`{"reason":"Unregistered","timestamp":1700000000000}`))
+		return
+	}
+
+	var payload map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload["aps"] == nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"reason":"PayloadEmpty"}`))
+		return
+	}
+	rw.Header().Set("apns-id", "apns-id-1")
+	rw.WriteHeader(http.StatusOK)
+}
+
+func newTestApnsPusher(t *testing.T, apns *testApnsServer) *ApnsPusher {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	apns.key = key
+	server, config := newTestPushServer(t, apns)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	config.ApnsKeyFile = filepath.Join(t.TempDir(), "AuthKey_KEY123.p8")
+	if err := os.WriteFile(config.ApnsKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	config.ApnsKeyId = "KEY123"
+	config.ApnsTeamId = "TEAM123"
+	config.ApnsTopic = "com.example.app"
+	config.ApnsApiBaseUrl = server.URL
+
+	pusher, err := NewApnsPusher(config)
+	if err != nil {
+		t.Fatalf("new apns pusher: %v", err)
+	}
+	return pusher
+}
+
+// 驗證權杖逾期時重新簽署後重試一次
+func TestApnsPusherRetriesExpiredProviderToken(t *testing.T) {
+	apns := &testApnsServer{expiredTokens: map[string]bool{}}
+	pusher := newTestApnsPusher(t, apns)
+
+	expired, err := pusher.getToken(false)
+	if err != nil {
+		t.Fatalf("get token: %v", err)
+	}
+	apns.expiredTokens[expired] = true
+
+	response := pusher.SendBatchPush(context.Background(), PushBatchRequest{
+		Receivers: []PushReceiver{{TargetId: "target-1", Token: testApnsToken}},
+		Message:   PushMessage{MessageId: "message-1", Title: "title", Body: "body"},
+	})
+
+	if response.Status != string(PushStatus_SENT) {
+		t.Fatalf("status = %s, want %s: %+v", response.Status, PushStatus_SENT, response.PushResponse)
+	}
+	if row := response.PushResponse[0]; row.ProviderTraceId != "apns-id-1" {
+		t.Fatalf("provider trace id = %q, want apns-id-1", row.ProviderTraceId)
+	}
+	if len(apns.tokens) != 2 || apns.tokens[0] != expired || apns.tokens[1] == expired {
+		t.Fatalf("requests should retry once with a new provider token, got %d requests", len(apns.tokens))
+	}
+}
+
+func TestApnsPusherInvalidDeviceToken(t *testing.T) {
+	apns := &testApnsServer{}
+	pusher := newTestApnsPusher(t, apns)
+
+	response := pusher.SendBatchPush(context.Background(), PushBatchRequest{
+		Receivers: []PushReceiver{
+			{TargetId: "target-1", Token: testApnsToken},
+			{TargetId: "target-2", Token: testApnsInvalidToken},
+		},
+		Message: PushMessage{MessageId: "message-1", Title: "title", Body: "body", Data: map[string]string{"order_id": "1"}},
+	})
+
+	if response.Status != string(PushStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, PushStatus_SENT)
+	}
+	sent, invalid := response.PushResponse[0], response.PushResponse[1]
+	if sent.Status != string(PushStatus_SENT) || sent.InvalidToken {
+		t.Errorf("sent row = %+v", sent)
+	}
+	if invalid.Status != string(PushStatus_FAILED) || !invalid.InvalidToken {
+		t.Errorf("invalid row = %+v, want invalid token", invalid)
+	}
+}
@@ -0,0 +1,140 @@
+package pusher
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+type PushProvider interface {
+	SendBatchPush(ctx context.Context, request PushBatchRequest) PushBatchResponse
+}
+
+type PushStatus string
+
+const (
+	PushStatus_SENT   PushStatus = "sent"   // 已發送
+	PushStatus_FAILED PushStatus = "failed" // 失敗
+)
+
+// 未設定併發數時同時送出的請求數
+const defaultPushConcurrency = 10
+
+type PushReceiver struct {
+	TargetId string `json:"target_id"`
+	Token    string `json:"token"` // 裝置 token
+}
+
+type PushMessage struct {
+	MessageId    string            `json:"message_id"`
+	Title        string            `json:"title"`
+	Body         string            `json:"body"`
+	Data         map[string]string `json:"data"`          // 自訂資料，由 App 自行解讀
+	HighPriority bool              `json:"high_priority"` // 高優先級訊息要求裝置立即顯示
+	ValidUntil   *time.Time        `json:"valid_until"`   // 裝置離線時保留至有效期限
+}
+
+type PushBatchRequest struct {
+	Receivers []PushReceiver `json:"receivers"`
+	Message   PushMessage    `json:"message"`
+}
+
+type PushResponse struct {
+	Status           string `json:"status"`
+	TraceId          string `json:"trace_id"`
+	ProviderTraceId  string `json:"provider_trace_id"` // 發送商的訊息 ID
+	ProviderResponse string `json:"provider_response"`
+	InvalidToken     bool   `json:"invalid_token"` // 發送商回報裝置 token 無效，需加入抑制名單
+	Provider         string `json:"provider"`      // 依裝置平台分流時實際使用的發送商，空值時為預設發送商
+}
+
+type PushBatchResponse struct {
+	Status       string         `json:"status"`
+	MessageId    string         `json:"message_id"`
+	PushResponse []PushResponse `json:"push_response"`
+}
+
+// FCM 與 APNs 一次請求僅能發送給一個裝置，以有限的併發數逐筆送出，任一裝置發送成功即視為成功
+func sendConcurrently(
+	ctx context.Context,
+	config *shared.Config,
+	request PushBatchRequest,
+	send func(ctx context.Context, message PushMessage, receiver PushReceiver) PushResponse,
+) PushBatchResponse {
+	concurrency := config.PushConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultPushConcurrency
+	}
+
+	pushResponses := make([]PushResponse, len(request.Receivers))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, receiver := range request.Receivers {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, receiver PushReceiver) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			pushResponses[i] = send(ctx, request.Message, receiver)
+		}(i, receiver)
+	}
+	wg.Wait()
+
+	status := string(PushStatus_FAILED)
+	for _, pushResponse := range pushResponses {
+		if pushResponse.Status == string(PushStatus_SENT) {
+			status = pushResponse.Status
+			break
+		}
+	}
+	return PushBatchResponse{
+		Status:       status,
+		MessageId:    request.Message.MessageId,
+		PushResponse: pushResponses,
+	}
+}
+
+// newPushClient 建立支援 HTTP/2 的 HTTP 客戶端，設定 CA 憑證時額外信任該憑證，用於指向本機的 TLS 替代服務
+func newPushClient(config *shared.Config) (*resty.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ForceAttemptHTTP2 = true
+
+	if config.PushTlsCaFile != "" {
+		pem, err := os.ReadFile(config.PushTlsCaFile)
+		if err != nil {
+			return nil, fmt.Errorf("read push tls ca file failed: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("invalid push tls ca file: %s", config.PushTlsCaFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return resty.NewWithClient(&http.Client{Transport: otelhttp.NewTransport(transport)}), nil
+}
+
+func newFailedPushResponse(receiver PushReceiver, providerResponse string, invalidToken bool) PushResponse {
+	return PushResponse{
+		Status:           string(PushStatus_FAILED),
+		TraceId:          receiver.TargetId,
+		ProviderResponse: providerResponse,
+		InvalidToken:     invalidToken,
+	}
+}
@@ -0,0 +1,30 @@
+package pusher
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	shared "notify-service/internal"
+)
+
+// newTestPushServer 啟動 TLS 測試服務，並將其憑證設為 PUSH_TLS_CA_FILE 以驗證自訂 CA 的設定
+func newTestPushServer(t *testing.T, handler http.Handler) (*httptest.Server, *shared.Config) {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(handler)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, certificate, 0o600); err != nil {
+		t.Fatalf("write ca file: %v", err)
+	}
+
+	return server, &shared.Config{PushTlsCaFile: caFile}
+}
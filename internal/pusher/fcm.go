@@ -0,0 +1,259 @@
+package pusher
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	shared "notify-service/internal"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	FcmAPIDomain = "https://fcm.googleapis.com"
+	FcmSendPath  = "/v1/projects/{projectId}/messages:send"
+
+	fcmScope     = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+	// 存取權杖到期前提早更新的時間
+	fcmTokenRefreshMargin = time.Minute
+)
+
+// 代表裝置 token 無效的錯誤碼，裝置 token 會被加入抑制名單
+var FcmInvalidTokenCodes = map[string]bool{
+	"UNREGISTERED":       true, // App 已移除或 token 已失效
+	"SENDER_ID_MISMATCH": true, // token 屬於其他專案
+}
+
+// FcmPusher 使用 FCM HTTP v1 API 發送，以服務帳戶私鑰簽署的 JWT 換取 OAuth 存取權杖，權杖於程序內快取
+type FcmPusher struct {
+	config  *shared.Config
+	resty   *resty.Client
+	account fcmServiceAccount
+	key     crypto.Signer
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// 服務帳戶金鑰檔中使用的欄位，token_uri 可指向測試用的替代服務
+type fcmServiceAccount struct {
+	ProjectId    string `json:"project_id"`
+	PrivateKeyId string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenUri     string `json:"token_uri"`
+}
+
+type fcmTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type fcmSendResponse struct {
+	Name  string `json:"name"`
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func NewFcmPusher(config *shared.Config) (*FcmPusher, error) {
+	content, err := os.ReadFile(config.FcmCredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read fcm credentials file failed: %w", err)
+	}
+
+	var account fcmServiceAccount
+	if err := json.Unmarshal(content, &account); err != nil {
+		return nil, fmt.Errorf("invalid fcm credentials file: %w", err)
+	}
+	key, err := parsePrivateKey([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid fcm private key: %w", err)
+	}
+
+	client, err := newPushClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &FcmPusher{
+		config:  config,
+		resty:   client,
+		account: account,
+		key:     key,
+	}, nil
+}
+
+func (p *FcmPusher) SendBatchPush(ctx context.Context, request PushBatchRequest) PushBatchResponse {
+	return sendConcurrently(ctx, p.config, request, p.sendPush)
+}
+
+func (p *FcmPusher) sendPush(ctx context.Context, message PushMessage, receiver PushReceiver) PushResponse {
+	body := map[string]interface{}{
+		"message": p.buildMessage(message, receiver),
+	}
+
+	resp, err := p.post(ctx, body)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"target_id": receiver.TargetId,
+		}).Error("FCM request failed")
+		return newFailedPushResponse(receiver, err.Error(), false)
+	}
+
+	var result fcmSendResponse
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"target_id": receiver.TargetId,
+		}).Error("Invalid fcm response")
+		return newFailedPushResponse(receiver, resp.String(), false)
+	}
+
+	if resp.IsError() {
+		errorCode := result.Error.Status
+		for _, detail := range result.Error.Details {
+			if detail.ErrorCode != "" {
+				errorCode = detail.ErrorCode
+			}
+		}
+		log.WithContext(ctx).WithFields(log.Fields{
+			"target_id":   receiver.TargetId,
+			"status_code": resp.StatusCode(),
+			"error_code":  errorCode,
+		}).Error("FCM rejected message")
+		return newFailedPushResponse(receiver, resp.String(), FcmInvalidTokenCodes[errorCode])
+	}
+
+	return PushResponse{
+		Status:           string(PushStatus_SENT),
+		TraceId:          receiver.TargetId,
+		ProviderTraceId:  result.Name,
+		ProviderResponse: resp.String(),
+	}
+}
+
+func (p *FcmPusher) buildMessage(message PushMessage, receiver PushReceiver) map[string]interface{} {
+	androidPriority, apnsPriority := "NORMAL", "5"
+	if message.HighPriority {
+		androidPriority, apnsPriority = "HIGH", "10"
+	}
+	android := map[string]interface{}{"priority": androidPriority}
+	if message.ValidUntil != nil {
+		ttl := max(int(time.Until(*message.ValidUntil).Seconds()), 0)
+		android["ttl"] = fmt.Sprintf("%ds", ttl)
+	}
+
+	fcmMessage := map[string]interface{}{
+		"token": receiver.Token,
+		"notification": map[string]string{
+			"title": message.Title,
+			"body":  message.Body,
+		},
+		"android": android,
+		"apns": map[string]interface{}{
+			"headers": map[string]string{"apns-priority": apnsPriority},
+		},
+	}
+	if len(message.Data) > 0 {
+		fcmMessage["data"] = message.Data
+	}
+	return fcmMessage
+}
+
+// 存取權杖失效時 API 回傳 401，重新取得權杖後重試一次
+func (p *FcmPusher) post(ctx context.Context, body interface{}) (*resty.Response, error) {
+	for attempt := 0; ; attempt++ {
+		accessToken, err := p.getAccessToken(ctx, attempt > 0)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := p.resty.R().
+			SetContext(ctx).
+			SetAuthToken(accessToken).
+			SetPathParam("projectId", p.account.ProjectId).
+			SetBody(body).
+			Post(p.getApiDomain() + FcmSendPath)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() == http.StatusUnauthorized && attempt == 0 {
+			continue
+		}
+		return resp, nil
+	}
+}
+
+func (p *FcmPusher) getAccessToken(ctx context.Context, refresh bool) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !refresh && p.accessToken != "" && time.Now().Before(p.expiresAt) {
+		return p.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion, err := signJwt(
+		map[string]string{"alg": "RS256", "typ": "JWT", "kid": p.account.PrivateKeyId},
+		map[string]interface{}{
+			"iss":   p.account.ClientEmail,
+			"scope": fcmScope,
+			"aud":   p.account.TokenUri,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		},
+		p.key,
+	)
+	if err != nil {
+		return "", fmt.Errorf("sign fcm jwt failed: %w", err)
+	}
+
+	resp, err := p.resty.R().
+		SetContext(ctx).
+		SetFormData(map[string]string{
+			"grant_type": fcmGrantType,
+			"assertion":  assertion,
+		}).
+		Post(p.account.TokenUri)
+	if err != nil {
+		return "", fmt.Errorf("get fcm access token failed: %w", err)
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("get fcm access token failed with status code %d", resp.StatusCode())
+	}
+
+	var result fcmTokenResponse
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return "", fmt.Errorf("invalid fcm access token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("empty fcm access token")
+	}
+
+	p.accessToken = result.AccessToken
+	p.expiresAt = now.Add(time.Duration(result.ExpiresIn)*time.Second - fcmTokenRefreshMargin)
+	return p.accessToken, nil
+}
+
+// 可設定 API 網域以指向測試用的替代服務
+func (p *FcmPusher) getApiDomain() string {
+	if p.config.FcmApiBaseUrl != "" {
+		return strings.TrimRight(p.config.FcmApiBaseUrl, "/")
+	}
+	return FcmAPIDomain
+}
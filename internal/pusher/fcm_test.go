@@ -0,0 +1,193 @@
+package pusher
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type testFcmServer struct {
+	key          *rsa.PrivateKey
+	tokenIssued  atomic.Int32
+	sendRequests atomic.Int32
+	// 第一次 send 請求回傳 401，模擬存取權杖已被撤銷
+	rejectFirst bool
+}
+
+func (s *testFcmServer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/token":
+		s.handleToken(rw, r)
+	case r.URL.Path == "/v1/projects/test-project/messages:send":
+		s.handleSend(rw, r)
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// 驗證服務帳戶簽署的 JWT 後發放存取權杖
+func (s *testFcmServer) handleToken(rw http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != fcmGrantType {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	parts := strings.Split(r.PostForm.Get("assertion"), ".")
+	if len(parts) != 3 {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	_, claims, digest, signature := decodeJwtParts(parts)
+	if err := rsa.VerifyPKCS1v15(&s.key.PublicKey, crypto.SHA256, digest, signature); err != nil {
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if claims["iss"] != "notify@test-project.iam.gserviceaccount.com" || claims["scope"] != fcmScope {
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	issued := s.tokenIssued.Add(1)
+	json.NewEncoder(rw).Encode(map[string]interface{}{
+		"access_token": fmt.Sprintf("access-token-%d", issued),
+		"expires_in":   3600,
+	})
+}
+
+func (s *testFcmServer) handleSend(rw http.ResponseWriter, r *http.Request) {
+	count := s.sendRequests.Add(1)
+
+	if s.rejectFirst && count == 1 {
+		rw.WriteHeader(http.StatusUnauthorized)
+		rw.Write([]byte(`{"error":{"code":401,"status":"UNAUTHENTICATED"}}`))
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer access-token-") {
+		rw.WriteHeader(http.StatusUnauthorized)
+		rw.Write([]byte(`{"error":{"code":401,"status":"UNAUTHENTICATED"}}`))
+		return
+	}
+
+	var body struct {
+		Message struct {
+			Token string `json:"token"`
+		} `json:"message"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if body.Message.Token == "unregistered-token" {
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"error":{"code":404,"status":"NOT_FOUND","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`))
+		return
+	}
+	rw.Write([]byte(`{"name":"projects/test-project/messages/` + body.Message.Token + `"}`))
+}
+
+func newTestFcmPusher(t *testing.T, fcm *testFcmServer) *FcmPusher {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	fcm.key = key
+	server, config := newTestPushServer(t, fcm)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	credentials, err := json.Marshal(fcmServiceAccount{
+		ProjectId:    "test-project",
+		PrivateKeyId: "key-id",
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ClientEmail:  "notify@test-project.iam.gserviceaccount.com",
+		TokenUri:     server.URL + "/token",
+	})
+	if err != nil {
+		t.Fatalf("marshal credentials: %v", err)
+	}
+	config.FcmCredentialsFile = filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(config.FcmCredentialsFile, credentials, 0o600); err != nil {
+		t.Fatalf("write credentials: %v", err)
+	}
+	config.FcmApiBaseUrl = server.URL
+
+	pusher, err := NewFcmPusher(config)
+	if err != nil {
+		t.Fatalf("new fcm pusher: %v", err)
+	}
+	return pusher
+}
+
+func TestFcmPusherSendBatchPush(t *testing.T) {
+	fcm := &testFcmServer{}
+	pusher := newTestFcmPusher(t, fcm)
+
+	response := pusher.SendBatchPush(context.Background(), PushBatchRequest{
+		Receivers: []PushReceiver{
+			{TargetId: "target-1", Token: "device-token-1"},
+			{TargetId: "target-2", Token: "unregistered-token"},
+			{TargetId: "target-3", Token: "device-token-3"},
+		},
+		Message: PushMessage{MessageId: "message-1", Title: "title", Body: "body"},
+	})
+
+	if response.Status != string(PushStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, PushStatus_SENT)
+	}
+	// 存取權杖於程序內快取，同一批次僅換取一次
+	if issued := fcm.tokenIssued.Load(); issued != 1 {
+		t.Fatalf("access tokens issued = %d, want 1", issued)
+	}
+
+	for _, row := range response.PushResponse {
+		switch row.TraceId {
+		case "target-1", "target-3":
+			if row.Status != string(PushStatus_SENT) || !strings.HasPrefix(row.ProviderTraceId, "projects/test-project/messages/") || row.InvalidToken {
+				t.Errorf("row %s = %+v", row.TraceId, row)
+			}
+		case "target-2":
+			if row.Status != string(PushStatus_FAILED) || !row.InvalidToken {
+				t.Errorf("row %s = %+v, want invalid token", row.TraceId, row)
+			}
+		default:
+			t.Errorf("unexpected row %+v", row)
+		}
+	}
+}
+
+// 存取權杖失效回傳 401 時重新換取權杖後重試一次
+func TestFcmPusherRefreshesAccessTokenOnUnauthorized(t *testing.T) {
+	fcm := &testFcmServer{rejectFirst: true}
+	pusher := newTestFcmPusher(t, fcm)
+
+	response := pusher.SendBatchPush(context.Background(), PushBatchRequest{
+		Receivers: []PushReceiver{{TargetId: "target-1", Token: "device-token-1"}},
+		Message:   PushMessage{MessageId: "message-1", Title: "title", Body: "body"},
+	})
+
+	if response.Status != string(PushStatus_SENT) {
+		t.Fatalf("status = %s, want %s: %+v", response.Status, PushStatus_SENT, response.PushResponse)
+	}
+	if issued := fcm.tokenIssued.Load(); issued != 2 {
+		t.Fatalf("access tokens issued = %d, want 2", issued)
+	}
+	if requests := fcm.sendRequests.Load(); requests != 2 {
+		t.Fatalf("send requests = %d, want 2", requests)
+	}
+}
@@ -0,0 +1,73 @@
+package pusher
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// signJwt 以 base64url 編碼標頭與內容後簽章，RS256 用於 FCM 服務帳戶，ES256 用於 APNs
+func signJwt(header map[string]string, claims map[string]interface{}, key crypto.Signer) (string, error) {
+	headerJson, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJson, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJson) + "." + base64.RawURLEncoding.EncodeToString(claimsJson)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", err
+		}
+	case *ecdsa.PrivateKey:
+		// JWS 的 ES256 簽章為固定長度的 r||s，而非 ASN.1 格式
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			return "", err
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature = make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+	default:
+		return "", fmt.Errorf("unsupported jwt signing key type %T", key)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parsePrivateKey 解析 PEM 格式的 PKCS#8 私鑰，相容 PKCS#1 RSA 私鑰
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid pem private key")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("unsupported private key format")
+}
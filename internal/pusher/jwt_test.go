@@ -0,0 +1,101 @@
+package pusher
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func decodeJwt(t *testing.T, token string) (map[string]string, map[string]interface{}, []byte, []byte) {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("jwt parts = %d, want 3", len(parts))
+	}
+	header, claims, digest, signature := decodeJwtParts(parts)
+	if header == nil || claims == nil || signature == nil {
+		t.Fatalf("invalid jwt %q", token)
+	}
+	return header, claims, digest, signature
+}
+
+// decodeJwtParts 解析 JWT 的標頭、內容與簽章，並回傳簽署內容的 SHA-256 摘要；格式錯誤時回傳 nil
+func decodeJwtParts(parts []string) (map[string]string, map[string]interface{}, []byte, []byte) {
+	var header map[string]string
+	var claims map[string]interface{}
+	for i, v := range []interface{}{&header, &claims} {
+		content, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			return nil, nil, nil, nil
+		}
+		if err := json.Unmarshal(content, v); err != nil {
+			return nil, nil, nil, nil
+		}
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, nil, nil, nil
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	return header, claims, digest[:], signature
+}
+
+// ES256 簽章需為 64 bytes 的 r||s，而非 ASN.1 DER 格式
+func TestSignJwtES256(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	// 重複簽署以涵蓋 r 或 s 前導為 0 時仍需補足固定長度的情況
+	for i := 0; i < 50; i++ {
+		token, err := signJwt(map[string]string{"alg": "ES256", "kid": "KEY123"}, map[string]interface{}{"iss": "TEAM123"}, key)
+		if err != nil {
+			t.Fatalf("sign jwt: %v", err)
+		}
+
+		header, claims, digest, signature := decodeJwt(t, token)
+		if header["alg"] != "ES256" || header["kid"] != "KEY123" || claims["iss"] != "TEAM123" {
+			t.Fatalf("header = %v, claims = %v", header, claims)
+		}
+		if len(signature) != 64 {
+			t.Fatalf("signature length = %d, want 64", len(signature))
+		}
+
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(&key.PublicKey, digest, r, s) {
+			t.Fatal("invalid ES256 signature")
+		}
+	}
+}
+
+func TestSignJwtRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	token, err := signJwt(map[string]string{"alg": "RS256", "typ": "JWT"}, map[string]interface{}{"scope": fcmScope}, key)
+	if err != nil {
+		t.Fatalf("sign jwt: %v", err)
+	}
+
+	_, claims, digest, signature := decodeJwt(t, token)
+	if claims["scope"] != fcmScope {
+		t.Fatalf("claims = %v", claims)
+	}
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest, signature); err != nil {
+		t.Fatalf("invalid RS256 signature: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package pusher
+
+import (
+	"context"
+	"encoding/hex"
+)
+
+// APNs 裝置 token 為 32 bytes 的十六進位字串，FCM registration token 則較長且含有其他字元
+const apnsDeviceTokenLength = 64
+
+// RoutingPusher 依裝置 token 格式選擇發送商，iOS 裝置的 APNs device token 使用 iOS 發送商，
+// 其餘（Android 或透過 FCM SDK 註冊的裝置）使用預設發送商
+type RoutingPusher struct {
+	defaultProvider PushProvider
+	defaultName     string
+	ios             PushProvider
+	iosName         string
+}
+
+func NewRoutingPusher(
+	defaultProvider PushProvider,
+	defaultName string,
+	ios PushProvider,
+	iosName string,
+) PushProvider {
+	return &RoutingPusher{
+		defaultProvider: defaultProvider,
+		defaultName:     defaultName,
+		ios:             ios,
+		iosName:         iosName,
+	}
+}
+
+func (p *RoutingPusher) SendBatchPush(ctx context.Context, request PushBatchRequest) PushBatchResponse {
+	var defaultReceivers, iosReceivers []PushReceiver
+	for _, receiver := range request.Receivers {
+		if IsApnsDeviceToken(receiver.Token) {
+			iosReceivers = append(iosReceivers, receiver)
+		} else {
+			defaultReceivers = append(defaultReceivers, receiver)
+		}
+	}
+
+	response := PushBatchResponse{
+		Status:    string(PushStatus_FAILED),
+		MessageId: request.Message.MessageId,
+	}
+	p.mergeResponse(ctx, &response, p.defaultProvider, p.defaultName, request.Message, defaultReceivers)
+	p.mergeResponse(ctx, &response, p.ios, p.iosName, request.Message, iosReceivers)
+	return response
+}
+
+// 任一發送商發送成功即視為成功，各裝置保留原本的結果以便將無效的 token 加入抑制名單
+func (p *RoutingPusher) mergeResponse(
+	ctx context.Context,
+	response *PushBatchResponse,
+	provider PushProvider,
+	providerName string,
+	message PushMessage,
+	receivers []PushReceiver,
+) {
+	if len(receivers) == 0 {
+		return
+	}
+
+	result := provider.SendBatchPush(ctx, PushBatchRequest{
+		Receivers: receivers,
+		Message:   message,
+	})
+	if result.Status == string(PushStatus_SENT) {
+		response.Status = result.Status
+	}
+	for _, row := range result.PushResponse {
+		row.Provider = providerName
+		response.PushResponse = append(response.PushResponse, row)
+	}
+}
+
+// IsApnsDeviceToken 裝置 token 是否為 APNs device token
+func IsApnsDeviceToken(token string) bool {
+	if len(token) != apnsDeviceTokenLength {
+		return false
+	}
+	_, err := hex.DecodeString(token)
+	return err == nil
+}
@@ -0,0 +1,75 @@
+package pusher
+
+import (
+	"context"
+	"testing"
+)
+
+type testPushProvider struct {
+	status   PushStatus
+	requests []PushBatchRequest
+}
+
+func (p *testPushProvider) SendBatchPush(ctx context.Context, request PushBatchRequest) PushBatchResponse {
+	p.requests = append(p.requests, request)
+
+	response := PushBatchResponse{Status: string(p.status), MessageId: request.Message.MessageId}
+	for _, receiver := range request.Receivers {
+		response.PushResponse = append(response.PushResponse, PushResponse{
+			Status:       string(p.status),
+			TraceId:      receiver.TargetId,
+			InvalidToken: p.status == PushStatus_FAILED,
+		})
+	}
+	return response
+}
+
+func TestRoutingPusherRoutesByDeviceToken(t *testing.T) {
+	fcm := &testPushProvider{status: PushStatus_SENT}
+	apns := &testPushProvider{status: PushStatus_FAILED}
+	pusher := NewRoutingPusher(fcm, "fcm", apns, "apns")
+
+	response := pusher.SendBatchPush(context.Background(), PushBatchRequest{
+		Receivers: []PushReceiver{
+			{TargetId: "android", Token: "dGVzdA:APA91bHPRgkF3JUikC4ENAHEeMrd41Zxv3hVZjC9KtT8OvPVGJ-hQMRKRrZuJAEcl7B338qju59zJMjw2DELjzEvxwYv7hH5Ynpc1ODQ0aT4U4OFEeco8ohsN5PjL1iC2dNtk2BAokeMCg2ZXKqpc8FXKmhX94kIxQ"},
+			{TargetId: "ios", Token: testApnsToken},
+		},
+		Message: PushMessage{MessageId: "message-1"},
+	})
+
+	if len(fcm.requests) != 1 || len(fcm.requests[0].Receivers) != 1 || fcm.requests[0].Receivers[0].TargetId != "android" {
+		t.Fatalf("fcm requests = %+v", fcm.requests)
+	}
+	if len(apns.requests) != 1 || len(apns.requests[0].Receivers) != 1 || apns.requests[0].Receivers[0].TargetId != "ios" {
+		t.Fatalf("apns requests = %+v", apns.requests)
+	}
+
+	// 任一發送商成功即視為成功，失敗的裝置保留無效 token 的結果
+	if response.Status != string(PushStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, PushStatus_SENT)
+	}
+	rows := make(map[string]PushResponse)
+	for _, row := range response.PushResponse {
+		rows[row.TraceId] = row
+	}
+	if row := rows["android"]; row.Provider != "fcm" || row.Status != string(PushStatus_SENT) {
+		t.Errorf("android row = %+v", row)
+	}
+	if row := rows["ios"]; row.Provider != "apns" || row.Status != string(PushStatus_FAILED) || !row.InvalidToken {
+		t.Errorf("ios row = %+v", row)
+	}
+}
+
+func TestIsApnsDeviceToken(t *testing.T) {
+	tests := map[string]bool{
+		testApnsToken:                 true,
+		testApnsToken[:63]:            false,
+		testApnsToken[:63] + "g":      false,
+		"dGVzdA:APA91bHPRgkF3JUikC4E": false,
+	}
+	for token, want := range tests {
+		if got := IsApnsDeviceToken(token); got != want {
+			t.Errorf("IsApnsDeviceToken(%q) = %v, want %v", token, got, want)
+		}
+	}
+}
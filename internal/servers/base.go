@@ -1,13 +1,23 @@
 package server
 
 import (
+	"context"
+	component "notify-service/internal/components"
 	cus_error "notify-service/internal/errors"
 
 	errorpb "proto/pkg/notify/v1/error"
+	notifypb "proto/pkg/notify/v1/notify"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	CLIENT_ID_METADATA_KEY = "x-client-id"
+	DEFAULT_CLIENT_ID      = "default"
 )
 
 type BaseServer struct{}
@@ -16,9 +26,42 @@ func (s *BaseServer) HandleError(err error) error {
 
 	code, msgCode := s.parseCode(err)
 	st := status.New(code, err.Error())
+
+	// 欄位驗證錯誤附上各欄位的路徑、錯誤說明與錯誤原因代碼
+	if err, ok := err.(*cus_error.ValidationError); ok {
+		metadata := make(map[string]string, len(err.FieldViolations))
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range err.FieldViolations {
+			metadata[violation.Field] = errorpb.ErrorReasonCode_name[int32(violation.Reason)]
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+		st, _ = st.WithDetails(
+			&errdetails.ErrorInfo{
+				Reason:   errorpb.ErrorReasonCode_name[int32(msgCode)],
+				Metadata: metadata,
+			},
+			badRequest,
+			&errdetails.LocalizedMessage{
+				Locale:  err.Locale,
+				Message: err.Error(),
+			},
+		)
+		return st.Err()
+	}
+
 	st, _ = st.WithDetails(&errdetails.ErrorInfo{
 		Reason: errorpb.ErrorReasonCode_name[int32(msgCode)],
 	})
+
+	// 超過配額或速率限制時，附上建議的重試時間
+	if err, ok := err.(*cus_error.ResourceExhaustedError); ok && err.RetryAfter > 0 {
+		st, _ = st.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(err.RetryAfter),
+		})
+	}
 	return st.Err()
 }
 
@@ -27,6 +70,9 @@ func (s *BaseServer) parseCode(err error) (codes.Code, errorpb.ErrorReasonCode)
 	case *cus_error.ValueError:
 		return codes.InvalidArgument, err.MsgCode
 
+	case *cus_error.ValidationError:
+		return codes.InvalidArgument, err.MsgCode
+
 	case *cus_error.KeyError:
 		return codes.InvalidArgument, err.MsgCode
 
@@ -36,6 +82,12 @@ func (s *BaseServer) parseCode(err error) (codes.Code, errorpb.ErrorReasonCode)
 	case *cus_error.NotFoundError:
 		return codes.NotFound, err.MsgCode
 
+	case *cus_error.ResourceExhaustedError:
+		return codes.ResourceExhausted, err.MsgCode
+
+	case *cus_error.FailedPreconditionError:
+		return codes.FailedPrecondition, err.MsgCode
+
 	case *cus_error.ServerError:
 		return codes.Internal, err.MsgCode
 
@@ -43,3 +95,42 @@ func (s *BaseServer) parseCode(err error) (codes.Code, errorpb.ErrorReasonCode)
 		return codes.Internal, errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL
 	}
 }
+
+// 將錯誤轉換為批量發送單筆回應的錯誤資訊
+func (s *BaseServer) convertItemError(err error) *notifypb.ItemError {
+	_, msgCode := s.parseCode(err)
+	itemError := &notifypb.ItemError{
+		Reason:  errorpb.ErrorReasonCode_name[int32(msgCode)],
+		Message: err.Error(),
+	}
+
+	if err, ok := err.(*cus_error.ValidationError); ok {
+		for _, violation := range err.FieldViolations {
+			itemError.FieldViolations = append(itemError.FieldViolations, &notifypb.FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+				Reason:      errorpb.ErrorReasonCode_name[int32(violation.Reason)],
+			})
+		}
+	}
+	return itemError
+}
+
+// 設定 CLIENT_API_KEYS 時以 API key 驗證的呼叫端識別碼為準；未設定時 x-client-id 須由前方驗證身分的 gateway 帶入，
+// 未帶入時使用預設值
+func (s *BaseServer) getClientId(ctx context.Context) string {
+	if clientId, ok := component.GetAuthenticatedClientId(ctx); ok {
+		return clientId
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return DEFAULT_CLIENT_ID
+	}
+
+	values := md.Get(CLIENT_ID_METADATA_KEY)
+	if len(values) == 0 || values[0] == "" {
+		return DEFAULT_CLIENT_ID
+	}
+	return values[0]
+}
@@ -1,45 +1,77 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
 	shared "notify-service/internal"
 	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
 	model "notify-service/internal/models"
 	service "notify-service/internal/services"
 	util "notify-service/internal/utils"
 	notifypb "proto/pkg/notify/v1/notify"
+	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	DEFAULT_MAIL_SENDER_ADDRESS = "notify@notify.com"
-	DEFAULT_MAIL_SENDER_NAME    = "Notify"
-)
-
 type NotifyServer struct {
 	notifypb.UnimplementedNotifyServiceServer
 	BaseServer
 	validator     *component.Validator
 	notifyService *service.NotifyService
+	quotaService  *service.QuotaService
+	otpService    *service.OtpService
 	config        *shared.Config
 	aesGcm        *component.AesGcm
+
+	suppressionService  *service.SuppressionService
+	inboundService      *service.InboundService
+	contactService      *service.ContactService
+	bulkSendService     *service.BulkSendService
+	phoneNumber         *component.PhoneNumber
+	smsEstimateService  *service.SmsEstimateService
+	notificationService *service.NotificationService
 }
 
 func NewNotifyServer(
 	validator *component.Validator,
 	notifyService *service.NotifyService,
+	quotaService *service.QuotaService,
+	otpService *service.OtpService,
 	config *shared.Config,
 	aesGcm *component.AesGcm,
+	suppressionService *service.SuppressionService,
+	inboundService *service.InboundService,
+	contactService *service.ContactService,
+	bulkSendService *service.BulkSendService,
+	phoneNumber *component.PhoneNumber,
+	smsEstimateService *service.SmsEstimateService,
+	notificationService *service.NotificationService,
 ) *NotifyServer {
 	return &NotifyServer{
-		validator:     validator,
-		notifyService: notifyService,
-		config:        config,
-		aesGcm:        aesGcm,
+		validator:           validator,
+		notifyService:       notifyService,
+		quotaService:        quotaService,
+		otpService:          otpService,
+		config:              config,
+		aesGcm:              aesGcm,
+		suppressionService:  suppressionService,
+		inboundService:      inboundService,
+		contactService:      contactService,
+		bulkSendService:     bulkSendService,
+		phoneNumber:         phoneNumber,
+		smsEstimateService:  smsEstimateService,
+		notificationService: notificationService,
 	}
 }
 
@@ -48,37 +80,161 @@ func (s NotifyServer) Register(server *grpc.Server) {
 }
 
 func (s NotifyServer) SendSms(ctx context.Context, in *notifypb.SendSmsRequest) (*notifypb.SendSmsResponse, error) {
-	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+	message, err := s.sendSms(ctx, s.getClientId(ctx), in)
 	if err != nil {
 		return nil, s.HandleError(err)
 	}
+	return &notifypb.SendSmsResponse{
+		MessageId: message.Id,
+		ClientRef: in.ClientRef,
+	}, nil
+}
 
-	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+// SendBatchSms 每則請求皆回傳一則結果，單筆失敗時回傳錯誤資訊並繼續處理後續請求
+func (s NotifyServer) SendBatchSms(stream notifypb.NotifyService_SendBatchSmsServer) error {
+	ctx := stream.Context()
+	clientId := s.getClientId(ctx)
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return s.HandleError(err)
+		}
+
+		response := &notifypb.SendSmsResponse{
+			ClientRef: in.ClientRef,
+		}
+
+		message, err := s.sendSms(ctx, clientId, in)
+		if err != nil {
+			response.Error = s.convertItemError(err)
+		} else {
+			response.MessageId = message.Id
+		}
+
+		if err := stream.Send(response); err != nil {
+			return s.HandleError(err)
+		}
+	}
+
+	return nil
+}
+
+func (s NotifyServer) EstimateSms(ctx context.Context, in *notifypb.EstimateSmsRequest) (*notifypb.EstimateSmsResponse, error) {
+	request := model.EstimateSmsRequest{
+		Sms: model.Sms{
+			Body: in.GetSms().GetBody(),
+		},
+		Receivers: s.normalizeReceivers(model.MessageType_SMS, in.Receivers),
+		GroupIds:  in.GroupIds,
+	}
+
+	if err := s.validator.CheckEstimateSmsRequest(request); err != nil {
 		return nil, s.HandleError(err)
 	}
 
+	receivers := request.Receivers
+	if len(request.GroupIds) > 0 {
+		var err error
+		receivers, err = s.contactService.ExpandReceivers(ctx, entity.MessageType_SMS, request.Receivers, request.GroupIds)
+		if err != nil {
+			return nil, s.HandleError(err)
+		}
+	}
+
+	estimate := s.smsEstimateService.Estimate(request.Body, receivers)
+	response := &notifypb.EstimateSmsResponse{
+		Encoding:      model.ConvertSmsEncodingToProto[estimate.Encoding],
+		Characters:    int32(estimate.Characters),
+		Segments:      int32(estimate.Segments),
+		MaxSegments:   int32(s.config.SmsMaxSegments),
+		EstimatedCost: estimate.EstimatedCost,
+		Currency:      s.config.SmsPriceCurrency,
+		Receivers:     make([]*notifypb.SmsReceiverEstimate, len(estimate.Receivers)),
+	}
+	for i, receiver := range estimate.Receivers {
+		response.Receivers[i] = &notifypb.SmsReceiverEstimate{
+			Receiver: receiver.Receiver,
+			Region:   receiver.Region,
+			Segments: int32(receiver.Segments),
+			Cost:     receiver.Cost,
+		}
+	}
+	return response, nil
+}
+
+func (s NotifyServer) sendSms(ctx context.Context, clientId string, in *notifypb.SendSmsRequest) (*entity.Message, error) {
+	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		return nil, err
+	}
+
+	validUntil, err := util.ConvertProtoTimestampToTime(in.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return nil, err
+	}
+
 	request := model.SendSmsRequest{
 		Sms: model.Sms{
-			Body: in.Sms.Body,
+			Body: in.GetSms().GetBody(),
 		},
-		Receivers:   in.Receivers,
+		Receivers:   s.normalizeReceivers(model.MessageType_SMS, in.Receivers),
+		GroupIds:    in.GroupIds,
 		ScheduledAt: scheduledAt,
+		Priority:    model.ConvertPriorityWithProto[in.Priority],
+		ValidUntil:  validUntil,
+		Timezone:    in.Timezone,
+		Category:    in.Category,
+		ClientId:    clientId,
+		Fallback:    s.convertFallbackPolicy(in.Fallback),
 	}
 
 	if err := s.validator.CheckSendSmsRequest(request); err != nil {
-		return nil, s.HandleError(err)
+		return nil, err
+	}
+
+	request.Receivers, err = s.contactService.ExpandReceivers(ctx, entity.MessageType_SMS, request.Receivers, request.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.quotaService.Consume(ctx, request.ClientId, entity.MessageType_SMS, len(request.Receivers)); err != nil {
+		return nil, err
 	}
 
 	message, err := s.notifyService.PublishSmsMessage(ctx, request)
+	if err != nil {
+		s.quotaService.Refund(ctx, request.ClientId, entity.MessageType_SMS, len(request.Receivers))
+		return nil, err
+	}
+	return message, nil
+}
+
+func (s NotifyServer) SendMail(ctx context.Context, in *notifypb.SendMailRequest) (*notifypb.SendMailResponse, error) {
+	message, err := s.sendMail(ctx, s.getClientId(ctx), in)
 	if err != nil {
 		return nil, s.HandleError(err)
 	}
-	return &notifypb.SendSmsResponse{
+	return &notifypb.SendMailResponse{
 		MessageId: message.Id,
+		ClientRef: in.ClientRef,
 	}, nil
 }
 
-func (s NotifyServer) SendBatchSms(stream notifypb.NotifyService_SendBatchSmsServer) error {
+// SendBatchMail 每則請求皆回傳一則結果，單筆失敗時回傳錯誤資訊並繼續處理後續請求
+func (s NotifyServer) SendBatchMail(stream notifypb.NotifyService_SendBatchMailServer) error {
+	ctx := stream.Context()
+	clientId := s.getClientId(ctx)
 	for {
 		in, err := stream.Recv()
 		if err == io.EOF {
@@ -88,35 +244,18 @@ func (s NotifyServer) SendBatchSms(stream notifypb.NotifyService_SendBatchSmsSer
 			return s.HandleError(err)
 		}
 
-		scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
-		if err != nil {
-			return s.HandleError(err)
-		}
-
-		if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
-			return s.HandleError(err)
-		}
-
-		request := model.SendSmsRequest{
-			Sms: model.Sms{
-				Body: in.Sms.Body,
-			},
-			Receivers:   in.Receivers,
-			ScheduledAt: scheduledAt,
+		response := &notifypb.SendMailResponse{
+			ClientRef: in.ClientRef,
 		}
 
-		if err := s.validator.CheckSendSmsRequest(request); err != nil {
-			return s.HandleError(err)
-		}
-
-		message, err := s.notifyService.PublishSmsMessage(context.Background(), request)
+		message, err := s.sendMail(ctx, clientId, in)
 		if err != nil {
-			return s.HandleError(err)
+			response.Error = s.convertItemError(err)
+		} else {
+			response.MessageId = message.Id
 		}
 
-		if err := stream.Send(&notifypb.SendSmsResponse{
-			MessageId: message.Id,
-		}); err != nil {
+		if err := stream.Send(response); err != nil {
 			return s.HandleError(err)
 		}
 	}
@@ -124,53 +263,74 @@ func (s NotifyServer) SendBatchSms(stream notifypb.NotifyService_SendBatchSmsSer
 	return nil
 }
 
-func (s NotifyServer) SendMail(ctx context.Context, in *notifypb.SendMailRequest) (*notifypb.SendMailResponse, error) {
+func (s NotifyServer) sendMail(ctx context.Context, clientId string, in *notifypb.SendMailRequest) (*entity.Message, error) {
 	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
 	if err != nil {
-		return nil, s.HandleError(err)
+		return nil, err
 	}
 
 	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
-		return nil, s.HandleError(err)
+		return nil, err
 	}
 
-	senderName := in.Mail.SenderName.GetValue()
-	// 默認為發件人名稱
-	if senderName == "" {
-		senderName = DEFAULT_MAIL_SENDER_NAME
+	validUntil, err := util.ConvertProtoTimestampToTime(in.ValidUntil)
+	if err != nil {
+		return nil, err
 	}
 
-	senderAddress := in.Mail.SenderAddress.GetValue()
-	// 默認為發件人電子郵件地址
-	if senderAddress == "" {
-		senderAddress = DEFAULT_MAIL_SENDER_ADDRESS
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return nil, err
 	}
 
 	request := model.SendMailRequest{
-		Mail: model.Mail{
-			SenderName:    senderName,
-			SenderAddress: senderAddress,
-			Subject:       in.Mail.Subject,
-			Body:          in.Mail.Body,
-		},
+		Mail:        convertMail(in.GetMail()),
 		Receivers:   in.Receivers,
+		GroupIds:    in.GroupIds,
 		ScheduledAt: scheduledAt,
+		Priority:    model.ConvertPriorityWithProto[in.Priority],
+		ValidUntil:  validUntil,
+		Timezone:    in.Timezone,
+		Category:    in.Category,
+		ClientId:    clientId,
+		Fallback:    s.convertFallbackPolicy(in.Fallback),
 	}
 
 	if err := s.validator.CheckSendMailRequest(request); err != nil {
-		return nil, s.HandleError(err)
+		return nil, err
+	}
+
+	request.Receivers, err = s.contactService.ExpandReceivers(ctx, entity.MessageType_MAIL, request.Receivers, request.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.quotaService.Consume(ctx, request.ClientId, entity.MessageType_MAIL, len(request.Receivers)); err != nil {
+		return nil, err
 	}
 
 	message, err := s.notifyService.PublishMailMessage(ctx, request)
+	if err != nil {
+		s.quotaService.Refund(ctx, request.ClientId, entity.MessageType_MAIL, len(request.Receivers))
+		return nil, err
+	}
+	return message, nil
+}
+
+func (s NotifyServer) SendPush(ctx context.Context, in *notifypb.SendPushRequest) (*notifypb.SendPushResponse, error) {
+	message, err := s.sendPush(ctx, s.getClientId(ctx), in)
 	if err != nil {
 		return nil, s.HandleError(err)
 	}
-	return &notifypb.SendMailResponse{
+	return &notifypb.SendPushResponse{
 		MessageId: message.Id,
+		ClientRef: in.ClientRef,
 	}, nil
 }
 
-func (s NotifyServer) SendBatchMail(stream notifypb.NotifyService_SendBatchMailServer) error {
+// SendBatchPush 每則請求皆回傳一則結果，單筆失敗時回傳錯誤資訊並繼續處理後續請求
+func (s NotifyServer) SendBatchPush(stream notifypb.NotifyService_SendBatchPushServer) error {
+	ctx := stream.Context()
+	clientId := s.getClientId(ctx)
 	for {
 		in, err := stream.Recv()
 		if err == io.EOF {
@@ -180,55 +340,186 @@ func (s NotifyServer) SendBatchMail(stream notifypb.NotifyService_SendBatchMailS
 			return s.HandleError(err)
 		}
 
-		scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+		response := &notifypb.SendPushResponse{
+			ClientRef: in.ClientRef,
+		}
+
+		message, err := s.sendPush(ctx, clientId, in)
 		if err != nil {
-			return s.HandleError(err)
+			response.Error = s.convertItemError(err)
+		} else {
+			response.MessageId = message.Id
 		}
 
-		if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		if err := stream.Send(response); err != nil {
 			return s.HandleError(err)
 		}
+	}
 
-		senderName := in.Mail.SenderName.GetValue()
-		// 默認為發件人名稱
-		if senderName == "" {
-			senderName = DEFAULT_MAIL_SENDER_NAME
-		}
+	return nil
+}
 
-		senderAddress := in.Mail.SenderAddress.GetValue()
-		// 默認為發件人電子郵件地址
-		if senderAddress == "" {
-			senderAddress = DEFAULT_MAIL_SENDER_ADDRESS
-		}
+func (s NotifyServer) sendPush(ctx context.Context, clientId string, in *notifypb.SendPushRequest) (*entity.Message, error) {
+	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+	if err != nil {
+		return nil, err
+	}
 
-		request := model.SendMailRequest{
-			Mail: model.Mail{
-				SenderName:    senderName,
-				SenderAddress: senderAddress,
-				Subject:       in.Mail.Subject,
-				Body:          in.Mail.Body,
-			},
-			Receivers:   in.Receivers,
-			ScheduledAt: scheduledAt,
-		}
+	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		return nil, err
+	}
 
-		if err := s.validator.CheckSendMailRequest(request); err != nil {
-			return s.HandleError(err)
-		}
+	validUntil, err := util.ConvertProtoTimestampToTime(in.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
 
-		message, err := s.notifyService.PublishMailMessage(context.Background(), request)
-		if err != nil {
-			return s.HandleError(err)
-		}
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return nil, err
+	}
 
-		if err := stream.Send(&notifypb.SendMailResponse{
-			MessageId: message.Id,
-		}); err != nil {
-			return s.HandleError(err)
-		}
+	request := model.SendPushRequest{
+		Push: model.Push{
+			Title: in.GetPush().GetTitle(),
+			Body:  in.GetPush().GetBody(),
+			Data:  in.GetPush().GetData(),
+		},
+		Receivers:   in.DeviceTokens,
+		ScheduledAt: scheduledAt,
+		Priority:    model.ConvertPriorityWithProto[in.Priority],
+		ValidUntil:  validUntil,
+		Timezone:    in.Timezone,
+		Category:    in.Category,
+		ClientId:    clientId,
+		Fallback:    s.convertFallbackPolicy(in.Fallback),
 	}
 
-	return nil
+	if err := s.validator.CheckSendPushRequest(request); err != nil {
+		return nil, err
+	}
+
+	if err := s.quotaService.Consume(ctx, request.ClientId, entity.MessageType_PUSH, len(request.Receivers)); err != nil {
+		return nil, err
+	}
+
+	message, err := s.notifyService.PublishPushMessage(ctx, request)
+	if err != nil {
+		s.quotaService.Refund(ctx, request.ClientId, entity.MessageType_PUSH, len(request.Receivers))
+		return nil, err
+	}
+	return message, nil
+}
+
+func (s NotifyServer) SendLine(ctx context.Context, in *notifypb.SendLineRequest) (*notifypb.SendLineResponse, error) {
+	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	validUntil, err := util.ConvertProtoTimestampToTime(in.ValidUntil)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	request := model.SendLineRequest{
+		Line: model.Line{
+			Text:         in.GetLine().GetText(),
+			AltText:      in.GetLine().GetAltText(),
+			FlexContents: in.GetLine().GetFlexContents(),
+		},
+		Receivers:   in.UserIds,
+		ScheduledAt: scheduledAt,
+		Priority:    model.ConvertPriorityWithProto[in.Priority],
+		ValidUntil:  validUntil,
+		Timezone:    in.Timezone,
+		Category:    in.Category,
+		ClientId:    s.getClientId(ctx),
+		Fallback:    s.convertFallbackPolicy(in.Fallback),
+	}
+
+	if err := s.validator.CheckSendLineRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.quotaService.Consume(ctx, request.ClientId, entity.MessageType_LINE, len(request.Receivers)); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	message, err := s.notifyService.PublishLineMessage(ctx, request)
+	if err != nil {
+		s.quotaService.Refund(ctx, request.ClientId, entity.MessageType_LINE, len(request.Receivers))
+		return nil, s.HandleError(err)
+	}
+	return &notifypb.SendLineResponse{
+		MessageId: message.Id,
+		ClientRef: in.ClientRef,
+	}, nil
+}
+
+func (s NotifyServer) SendWebhook(ctx context.Context, in *notifypb.SendWebhookRequest) (*notifypb.SendWebhookResponse, error) {
+	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	validUntil, err := util.ConvertProtoTimestampToTime(in.ValidUntil)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	// 默認為 POST
+	method := strings.ToUpper(in.GetWebhook().GetMethod())
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	request := model.SendWebhookRequest{
+		Webhook: model.Webhook{
+			Url:     in.GetWebhook().GetUrl(),
+			Method:  method,
+			Headers: in.GetWebhook().GetHeaders(),
+			Body:    in.GetWebhook().GetBody(),
+		},
+		ScheduledAt: scheduledAt,
+		Priority:    model.ConvertPriorityWithProto[in.Priority],
+		ValidUntil:  validUntil,
+		Category:    in.Category,
+		ClientId:    s.getClientId(ctx),
+	}
+
+	if err := s.validator.CheckSendWebhookRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.quotaService.Consume(ctx, request.ClientId, entity.MessageType_WEBHOOK, 1); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	message, err := s.notifyService.PublishWebhookMessage(ctx, request)
+	if err != nil {
+		s.quotaService.Refund(ctx, request.ClientId, entity.MessageType_WEBHOOK, 1)
+		return nil, s.HandleError(err)
+	}
+	return &notifypb.SendWebhookResponse{
+		MessageId: message.Id,
+		ClientRef: in.ClientRef,
+	}, nil
 }
 
 func (s NotifyServer) CancelScheduledByMessageId(ctx context.Context, in *notifypb.CancelScheduledByMessageIdRequest) (*emptypb.Empty, error) {
@@ -260,7 +551,7 @@ func (s NotifyServer) ListStatusWithPaging(ctx context.Context, in *notifypb.Lis
 	request := model.ListStatusWithPagingRequest{
 		MessageType: string(model.ConvertMessageTypeWithProto[in.MessageType]),
 		MessageId:   in.GetMessageId(),
-		Receiver:    in.GetReceiver(),
+		Receiver:    s.normalizeReceiver(model.ConvertMessageTypeWithProto[in.MessageType], in.GetReceiver()),
 		Page:        in.Page,
 		StartAt:     startAt,
 		EndAt:       endAt,
@@ -287,6 +578,7 @@ func (s NotifyServer) ListStatusWithPaging(ctx context.Context, in *notifypb.Lis
 			MessageContent: target.Message.Data,
 			Receiver:       receiver,
 			Status:         string(target.Status),
+			StatusReason:   target.StatusReason,
 			CreatedAt:      timestamppb.New(target.CreatedAt),
 			UpdatedAt:      timestamppb.New(target.CreatedAt),
 		}
@@ -302,3 +594,1094 @@ func (s NotifyServer) ListStatusWithPaging(ctx context.Context, in *notifypb.Lis
 		},
 	}, nil
 }
+
+func (s NotifyServer) GetMessage(ctx context.Context, in *notifypb.GetMessageRequest) (*notifypb.Message, error) {
+	request := model.GetMessageRequest{
+		MessageId: in.MessageId,
+	}
+
+	if err := s.validator.CheckGetMessageRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	detail, err := s.notifyService.GetMessage(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return convertMessageDetailToProto(detail), nil
+}
+
+func (s NotifyServer) SendNotification(ctx context.Context, in *notifypb.SendNotificationRequest) (*notifypb.SendNotificationResponse, error) {
+	scheduledAt, err := util.ConvertProtoTimestampToTime(in.ScheduledAt)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	validUntil, err := util.ConvertProtoTimestampToTime(in.ValidUntil)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	request := model.SendNotificationRequest{
+		SmsReceivers:  s.normalizeReceivers(model.MessageType_SMS, in.SmsReceivers),
+		MailReceivers: s.normalizeReceivers(model.MessageType_MAIL, in.MailReceivers),
+		DeviceTokens:  in.DeviceTokens,
+		LineUserIds:   in.LineUserIds,
+		ScheduledAt:   scheduledAt,
+		Priority:      model.ConvertPriorityWithProto[in.Priority],
+		ValidUntil:    validUntil,
+		Timezone:      in.Timezone,
+		Category:      in.Category,
+		ClientId:      s.getClientId(ctx),
+	}
+	if in.Sms != nil {
+		request.Sms = &model.Sms{
+			Body: in.GetSms().GetBody(),
+		}
+	}
+	if in.Mail != nil {
+		mail := convertMail(in.GetMail())
+		request.Mail = &mail
+	}
+	if in.Push != nil {
+		request.Push = &model.Push{
+			Title: in.GetPush().GetTitle(),
+			Body:  in.GetPush().GetBody(),
+			Data:  in.GetPush().GetData(),
+		}
+	}
+	if in.Line != nil {
+		request.Line = &model.Line{
+			Text:         in.GetLine().GetText(),
+			AltText:      in.GetLine().GetAltText(),
+			FlexContents: in.GetLine().GetFlexContents(),
+		}
+	}
+
+	if err := s.validator.CheckSendNotificationRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	// 各通道分別計算配額，任一通道配額不足時退還已扣除的配額
+	quotas := []struct {
+		channel entity.MessageType
+		count   int
+	}{
+		{entity.MessageType_SMS, len(request.SmsReceivers)},
+		{entity.MessageType_MAIL, len(request.MailReceivers)},
+		{entity.MessageType_PUSH, len(request.DeviceTokens)},
+		{entity.MessageType_LINE, len(request.LineUserIds)},
+	}
+	consumed := make(map[entity.MessageType]int, len(quotas))
+	for _, quota := range quotas {
+		if quota.count == 0 {
+			continue
+		}
+		if err := s.quotaService.Consume(ctx, request.ClientId, quota.channel, quota.count); err != nil {
+			s.refundQuotas(ctx, request.ClientId, consumed)
+			return nil, s.HandleError(err)
+		}
+		consumed[quota.channel] = quota.count
+	}
+
+	notification, results, err := s.notificationService.PublishNotification(ctx, request)
+	if err != nil {
+		s.refundQuotas(ctx, request.ClientId, consumed)
+		return nil, s.HandleError(err)
+	}
+
+	// 僅退還發送失敗通道的配額
+	response := &notifypb.SendNotificationResponse{
+		NotificationId: notification.Id,
+		ClientRef:      in.ClientRef,
+		Results:        make([]*notifypb.NotificationChannelResult, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = &notifypb.NotificationChannelResult{
+			Channel: model.ConvertMessageTypeToProto[model.MessageType(result.Channel)],
+		}
+		if result.Err != nil {
+			response.Results[i].Error = s.convertItemError(result.Err)
+			continue
+		}
+		response.Results[i].MessageId = result.Message.Id
+		delete(consumed, result.Channel)
+	}
+	s.refundQuotas(ctx, request.ClientId, consumed)
+	return response, nil
+}
+
+func (s NotifyServer) refundQuotas(ctx context.Context, clientId string, counts map[entity.MessageType]int) {
+	for channel, count := range counts {
+		s.quotaService.Refund(ctx, clientId, channel, count)
+	}
+}
+
+func (s NotifyServer) CancelNotification(ctx context.Context, in *notifypb.CancelNotificationRequest) (*emptypb.Empty, error) {
+	request := model.CancelNotificationRequest{
+		NotificationId: in.NotificationId,
+	}
+
+	if err := s.validator.CheckCancelNotificationRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.notificationService.CancelNotification(ctx, request); err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s NotifyServer) GetNotification(ctx context.Context, in *notifypb.GetNotificationRequest) (*notifypb.Notification, error) {
+	request := model.GetNotificationRequest{
+		NotificationId: in.NotificationId,
+	}
+
+	if err := s.validator.CheckGetNotificationRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	detail, err := s.notificationService.GetNotification(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return convertNotificationDetailToProto(detail), nil
+}
+
+func (s NotifyServer) RequestOtp(ctx context.Context, in *notifypb.RequestOtpRequest) (*notifypb.RequestOtpResponse, error) {
+	request := model.RequestOtpRequest{
+		Receiver: s.normalizeReceiver(model.ConvertMessageTypeWithProto[in.Channel], in.Receiver),
+		Channel:  model.ConvertMessageTypeWithProto[in.Channel],
+		Purpose:  in.Purpose,
+		Ttl:      in.Ttl.AsDuration(),
+	}
+
+	if err := s.validator.CheckRequestOtpRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	clientId := s.getClientId(ctx)
+	if err := s.quotaService.Consume(ctx, clientId, entity.MessageType(request.Channel), 1); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	otp, err := s.otpService.RequestOtp(ctx, request)
+	if err != nil {
+		s.quotaService.Refund(ctx, clientId, entity.MessageType(request.Channel), 1)
+		return nil, s.HandleError(err)
+	}
+	return &notifypb.RequestOtpResponse{
+		OtpId:             otp.Id,
+		MessageId:         otp.MessageId,
+		ExpiresAt:         timestamppb.New(otp.ExpiresAt),
+		ResendAvailableAt: timestamppb.New(otp.CreatedAt.Add(time.Duration(s.config.OtpResendCooldownSeconds) * time.Second)),
+	}, nil
+}
+
+func (s NotifyServer) VerifyOtp(ctx context.Context, in *notifypb.VerifyOtpRequest) (*notifypb.VerifyOtpResponse, error) {
+	request := model.VerifyOtpRequest{
+		// 未帶通道，可解析為手機門號時才正規化
+		Receiver: s.normalizeReceiver(model.MessageType_SMS, in.Receiver),
+		Purpose:  in.Purpose,
+		Code:     in.Code,
+	}
+
+	if err := s.validator.CheckVerifyOtpRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	verified, remaining, err := s.otpService.VerifyOtp(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &notifypb.VerifyOtpResponse{
+		Verified:          verified,
+		RemainingAttempts: int32(remaining),
+	}, nil
+}
+
+func (s NotifyServer) ListSuppressions(ctx context.Context, in *notifypb.ListSuppressionsRequest) (*notifypb.ListSuppressionsResponse, error) {
+	request := model.ListSuppressionsRequest{
+		Channel:   model.ConvertMessageTypeWithProto[in.Channel],
+		Receiver:  s.normalizeReceiver(model.ConvertMessageTypeWithProto[in.Channel], in.Receiver),
+		Reason:    model.ConvertSuppressionReasonWithProto[in.Reason],
+		Page:      in.Page,
+		SortField: in.Page.GetSortField(),
+		SortOrder: in.Page.GetSortOrder(),
+	}
+
+	if err := s.validator.CheckListSuppressionsRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	suppressions, total, err := s.suppressionService.ListSuppressionsWithPaging(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response := make([]*notifypb.Suppression, len(suppressions))
+	for i, suppression := range suppressions {
+		item, err := s.convertSuppressionToProto(suppression)
+		if err != nil {
+			return nil, s.HandleError(err)
+		}
+		response[i] = item
+	}
+	return &notifypb.ListSuppressionsResponse{
+		Suppressions: response,
+		Paging: &notifypb.Paging{
+			Index:     in.Page.Index,
+			Size:      in.Page.Size,
+			Total:     int32(total),
+			SortField: in.Page.SortField,
+			SortOrder: in.Page.SortOrder,
+		},
+	}, nil
+}
+
+func (s NotifyServer) AddSuppression(ctx context.Context, in *notifypb.AddSuppressionRequest) (*notifypb.Suppression, error) {
+	expiresAt, err := util.ConvertProtoTimestampToTime(in.ExpiresAt)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	reason := model.SuppressionReason_MANUAL
+	if in.Reason != notifypb.SuppressionReason_SUPPRESSION_REASON_UNSPECIFIED {
+		reason = model.ConvertSuppressionReasonWithProto[in.Reason]
+	}
+
+	request := model.AddSuppressionRequest{
+		Channel:   model.ConvertMessageTypeWithProto[in.Channel],
+		Receiver:  s.normalizeReceiver(model.ConvertMessageTypeWithProto[in.Channel], in.Receiver),
+		Reason:    reason,
+		ExpiresAt: expiresAt,
+	}
+
+	if err := s.validator.CheckAddSuppressionRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	suppression, err := s.suppressionService.AddSuppression(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response, err := s.convertSuppressionToProto(suppression)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return response, nil
+}
+
+func (s NotifyServer) RemoveSuppression(ctx context.Context, in *notifypb.RemoveSuppressionRequest) (*emptypb.Empty, error) {
+	request := model.RemoveSuppressionRequest{
+		Channel:  model.ConvertMessageTypeWithProto[in.Channel],
+		Receiver: s.normalizeReceiver(model.ConvertMessageTypeWithProto[in.Channel], in.Receiver),
+	}
+
+	if err := s.validator.CheckRemoveSuppressionRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.suppressionService.RemoveSuppression(ctx, request); err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s NotifyServer) ListInboundMessagesWithPaging(ctx context.Context, in *notifypb.ListInboundMessagesWithPagingRequest) (*notifypb.ListInboundMessagesWithPagingResponse, error) {
+	startAt, err := util.ConvertProtoTimestampToTime(in.StartAt)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	endAt, err := util.ConvertProtoTimestampToTime(in.EndAt)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	request := model.ListInboundMessagesWithPagingRequest{
+		Sender:    in.Sender,
+		Page:      in.Page,
+		StartAt:   startAt,
+		EndAt:     endAt,
+		SortField: in.Page.GetSortField(),
+		SortOrder: in.Page.GetSortOrder(),
+	}
+
+	if err := s.validator.CheckListInboundMessagesWithPagingRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	inbounds, total, err := s.inboundService.ListInboundMessagesWithPaging(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response := make([]*notifypb.InboundMessage, len(inbounds))
+	for i, inbound := range inbounds {
+		sender, err := s.aesGcm.AesDecrypt(inbound.Sender)
+		if err != nil {
+			return nil, s.HandleError(err)
+		}
+		response[i] = &notifypb.InboundMessage{
+			Id:                 inbound.Id,
+			Provider:           string(inbound.Provider),
+			Sender:             sender,
+			Recipient:          inbound.Recipient,
+			Body:               inbound.Body,
+			Keyword:            inbound.Keyword,
+			Language:           inbound.Language,
+			Action:             string(inbound.Action),
+			ConfirmationStatus: string(inbound.ConfirmationStatus),
+			ReceivedAt:         timestamppb.New(inbound.ReceivedAt),
+			CreatedAt:          timestamppb.New(inbound.CreatedAt),
+		}
+	}
+	return &notifypb.ListInboundMessagesWithPagingResponse{
+		InboundMessages: response,
+		Paging: &notifypb.Paging{
+			Index:     in.Page.Index,
+			Size:      in.Page.Size,
+			Total:     int32(total),
+			SortField: in.Page.SortField,
+			SortOrder: in.Page.SortOrder,
+		},
+	}, nil
+}
+
+func (s NotifyServer) CreateContact(ctx context.Context, in *notifypb.ContactInput) (*notifypb.Contact, error) {
+	request := model.ContactRequest{
+		Name:  in.Name,
+		Phone: s.normalizeReceiver(model.MessageType_SMS, in.Phone),
+		Email: in.Email,
+	}
+
+	if err := s.validator.CheckContactRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	contact, err := s.contactService.CreateContact(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response, err := s.convertContactToProto(contact)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return response, nil
+}
+
+func (s NotifyServer) UpdateContact(ctx context.Context, in *notifypb.UpdateContactRequest) (*notifypb.Contact, error) {
+	request := model.UpdateContactRequest{
+		Id: in.Id,
+		ContactRequest: model.ContactRequest{
+			Name:  in.GetContact().GetName(),
+			Phone: s.normalizeReceiver(model.MessageType_SMS, in.GetContact().GetPhone()),
+			Email: in.GetContact().GetEmail(),
+		},
+	}
+
+	if err := s.validator.CheckUpdateContactRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	contact, err := s.contactService.UpdateContact(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response, err := s.convertContactToProto(contact)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return response, nil
+}
+
+func (s NotifyServer) DeleteContact(ctx context.Context, in *notifypb.DeleteContactRequest) (*emptypb.Empty, error) {
+	request := model.DeleteContactRequest{
+		Id: in.Id,
+	}
+
+	if err := s.validator.CheckDeleteContactRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.contactService.DeleteContact(ctx, request); err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s NotifyServer) ListContactsWithPaging(ctx context.Context, in *notifypb.ListContactsWithPagingRequest) (*notifypb.ListContactsWithPagingResponse, error) {
+	request := model.ListContactsWithPagingRequest{
+		GroupId:   in.GroupId,
+		Phone:     s.normalizeReceiver(model.MessageType_SMS, in.Phone),
+		Email:     in.Email,
+		Page:      in.Page,
+		SortField: in.Page.GetSortField(),
+		SortOrder: in.Page.GetSortOrder(),
+	}
+
+	if err := s.validator.CheckListContactsWithPagingRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	contacts, total, err := s.contactService.ListContactsWithPaging(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response := make([]*notifypb.Contact, len(contacts))
+	for i, contact := range contacts {
+		item, err := s.convertContactToProto(contact)
+		if err != nil {
+			return nil, s.HandleError(err)
+		}
+		response[i] = item
+	}
+	return &notifypb.ListContactsWithPagingResponse{
+		Contacts: response,
+		Paging: &notifypb.Paging{
+			Index:     in.Page.Index,
+			Size:      in.Page.Size,
+			Total:     int32(total),
+			SortField: in.Page.SortField,
+			SortOrder: in.Page.SortOrder,
+		},
+	}, nil
+}
+
+func (s NotifyServer) ImportContacts(ctx context.Context, in *notifypb.ImportContactsRequest) (*notifypb.ImportContactsResponse, error) {
+	request := model.ImportContactsRequest{
+		Contacts: make([]model.ContactRequest, len(in.Contacts)),
+		GroupId:  in.GroupId,
+	}
+	for i, contact := range in.Contacts {
+		request.Contacts[i] = model.ContactRequest{
+			Name:  contact.Name,
+			Phone: s.normalizeReceiver(model.MessageType_SMS, contact.Phone),
+			Email: contact.Email,
+		}
+	}
+
+	if err := s.validator.CheckImportContactsRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	result, err := s.contactService.ImportContacts(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &notifypb.ImportContactsResponse{
+		CreatedCount: int32(result.CreatedCount),
+		UpdatedCount: int32(result.UpdatedCount),
+		ContactIds:   result.ContactIds,
+	}, nil
+}
+
+func (s NotifyServer) CreateGroup(ctx context.Context, in *notifypb.CreateGroupRequest) (*notifypb.Group, error) {
+	request := model.CreateGroupRequest{
+		Name:        in.Name,
+		Description: in.Description,
+	}
+
+	if err := s.validator.CheckCreateGroupRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	group, err := s.contactService.CreateGroup(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return convertGroupToProto(group), nil
+}
+
+func (s NotifyServer) UpdateGroup(ctx context.Context, in *notifypb.UpdateGroupRequest) (*notifypb.Group, error) {
+	request := model.UpdateGroupRequest{
+		Id: in.Id,
+		CreateGroupRequest: model.CreateGroupRequest{
+			Name:        in.Name,
+			Description: in.Description,
+		},
+	}
+
+	if err := s.validator.CheckUpdateGroupRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	group, err := s.contactService.UpdateGroup(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return convertGroupToProto(group), nil
+}
+
+func (s NotifyServer) DeleteGroup(ctx context.Context, in *notifypb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	request := model.DeleteGroupRequest{
+		Id: in.Id,
+	}
+
+	if err := s.validator.CheckDeleteGroupRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.contactService.DeleteGroup(ctx, request); err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s NotifyServer) ListGroupsWithPaging(ctx context.Context, in *notifypb.ListGroupsWithPagingRequest) (*notifypb.ListGroupsWithPagingResponse, error) {
+	request := model.ListGroupsWithPagingRequest{
+		Name:      in.Name,
+		Page:      in.Page,
+		SortField: in.Page.GetSortField(),
+		SortOrder: in.Page.GetSortOrder(),
+	}
+
+	if err := s.validator.CheckListGroupsWithPagingRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	groups, total, err := s.contactService.ListGroupsWithPaging(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	response := make([]*notifypb.Group, len(groups))
+	for i, group := range groups {
+		response[i] = convertGroupToProto(group)
+	}
+	return &notifypb.ListGroupsWithPagingResponse{
+		Groups: response,
+		Paging: &notifypb.Paging{
+			Index:     in.Page.Index,
+			Size:      in.Page.Size,
+			Total:     int32(total),
+			SortField: in.Page.SortField,
+			SortOrder: in.Page.SortOrder,
+		},
+	}, nil
+}
+
+func (s NotifyServer) AddGroupMembers(ctx context.Context, in *notifypb.GroupMembersRequest) (*emptypb.Empty, error) {
+	request := model.GroupMembersRequest{
+		GroupId:    in.GroupId,
+		ContactIds: in.ContactIds,
+	}
+
+	if err := s.validator.CheckGroupMembersRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.contactService.AddGroupMembers(ctx, request); err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s NotifyServer) RemoveGroupMembers(ctx context.Context, in *notifypb.GroupMembersRequest) (*emptypb.Empty, error) {
+	request := model.GroupMembersRequest{
+		GroupId:    in.GroupId,
+		ContactIds: in.ContactIds,
+	}
+
+	if err := s.validator.CheckGroupMembersRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	if err := s.contactService.RemoveGroupMembers(ctx, request); err != nil {
+		return nil, s.HandleError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UploadBulkSend 第一則為發送設定，其後為 CSV 檔案內容，依批次建立發送紀錄，不在記憶體保留整份檔案
+func (s NotifyServer) UploadBulkSend(stream notifypb.NotifyService_UploadBulkSendServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return s.HandleError(s.bulkSendService.InvalidCsvError("bulk send header is required"))
+	}
+	if err != nil {
+		return s.HandleError(err)
+	}
+
+	header := first.GetHeader()
+	if header == nil {
+		return s.HandleError(s.bulkSendService.InvalidCsvError("bulk send header must be the first message"))
+	}
+
+	scheduledAt, err := util.ConvertProtoTimestampToTime(header.ScheduledAt)
+	if err != nil {
+		return s.HandleError(err)
+	}
+
+	if err := s.validator.CheckScheduledAt(scheduledAt); err != nil {
+		return s.HandleError(err)
+	}
+
+	validUntil, err := util.ConvertProtoTimestampToTime(header.ValidUntil)
+	if err != nil {
+		return s.HandleError(err)
+	}
+
+	if err := s.validator.CheckValidUntil(scheduledAt, validUntil); err != nil {
+		return s.HandleError(err)
+	}
+
+	request := model.BulkSendHeaderRequest{
+		TemplateId:  header.TemplateId,
+		Priority:    model.ConvertPriorityWithProto[header.Priority],
+		ScheduledAt: scheduledAt,
+		ValidUntil:  validUntil,
+		Timezone:    header.Timezone,
+		Category:    header.Category,
+		ClientId:    s.getClientId(ctx),
+	}
+
+	if err := s.validator.CheckBulkSendHeaderRequest(request); err != nil {
+		return s.HandleError(err)
+	}
+
+	bulk, err := s.bulkSendService.StartBulkSend(ctx, request)
+	if err != nil {
+		return s.HandleError(err)
+	}
+
+	if err := s.readBulkSendRows(stream, request.ClientId, bulk); err != nil {
+		// 串流可能已中斷，改用新的 context 取消訊息
+		s.bulkSendService.FailBulkSend(context.Background(), bulk, err.Error())
+		return s.HandleError(err)
+	}
+
+	if err := s.bulkSendService.CompleteBulkSend(context.Background(), bulk); err != nil {
+		return s.HandleError(err)
+	}
+
+	return stream.SendAndClose(&notifypb.UploadBulkSendResponse{
+		JobId:        bulk.Job.Id,
+		MessageId:    bulk.Message.Id,
+		TotalRows:    int32(bulk.Job.TotalRows),
+		AcceptedRows: int32(bulk.Job.AcceptedRows),
+		FailedRows:   int32(bulk.Job.FailedRows),
+	})
+}
+
+// 逐列讀取 CSV，收件者格式錯誤或欄位數不符的資料列記錄為錯誤，其餘依批次建立發送紀錄
+func (s NotifyServer) readBulkSendRows(
+	stream notifypb.NotifyService_UploadBulkSendServer,
+	clientId string,
+	bulk *service.BulkSend,
+) error {
+	ctx := stream.Context()
+	reader := csv.NewReader(&bulkSendChunkReader{stream: stream, bulkSendService: s.bulkSendService})
+	reader.ReuseRecord = true
+
+	columns, err := reader.Read()
+	if err != nil {
+		return s.bulkSendService.InvalidCsvError(fmt.Sprintf("read csv header failed: %v", err))
+	}
+
+	indexes, err := s.bulkSendService.CheckColumns(bulk, columns)
+	if err != nil {
+		return err
+	}
+
+	batchSize := s.config.BulkSendBatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	channel := model.MessageType(bulk.Message.Type)
+	rows := make([]service.BulkSendRow, 0, batchSize)
+	var rowErrors []service.BulkSendRowError
+	flush := func() error {
+		if err := s.bulkSendService.RecordErrors(ctx, bulk, rowErrors); err != nil {
+			return err
+		}
+		rowErrors = nil
+
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := s.quotaService.Consume(ctx, clientId, bulk.Message.Type, len(rows)); err != nil {
+			return err
+		}
+		if err := s.bulkSendService.AppendRows(ctx, bulk, rows); err != nil {
+			s.quotaService.Refund(ctx, clientId, bulk.Message.Type, len(rows))
+			return err
+		}
+		rows = rows[:0]
+		return nil
+	}
+
+	for rowNumber := 1; ; rowNumber++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			bulk.Job.TotalRows++
+			rowErrors = append(rowErrors, service.BulkSendRowError{
+				RowNumber: rowNumber,
+				Reason:    fmt.Sprintf("expected %d fields, got %d", len(columns), len(record)),
+			})
+			continue
+		}
+		if parseErr != nil {
+			return s.bulkSendService.InvalidCsvError(fmt.Sprintf("read csv failed: %v", err))
+		}
+		if err != nil {
+			return err
+		}
+
+		bulk.Job.TotalRows++
+		receiver := s.normalizeReceiver(channel, strings.TrimSpace(record[indexes[service.BulkSendReceiverColumn]]))
+		if err := s.validator.CheckBulkSendReceiver(channel, receiver); err != nil {
+			rowErrors = append(rowErrors, service.BulkSendRowError{
+				RowNumber: rowNumber,
+				Field:     service.BulkSendReceiverColumn,
+				Value:     receiver,
+				Reason:    err.Error(),
+			})
+			continue
+		}
+
+		variables := make(map[string]string, len(bulk.Variables))
+		for _, variable := range bulk.Variables {
+			variables[variable] = record[indexes[variable]]
+		}
+		rows = append(rows, service.BulkSendRow{
+			RowNumber: rowNumber,
+			Receiver:  receiver,
+			Variables: variables,
+		})
+
+		if len(rows) >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+func (s NotifyServer) GetBulkSendJob(ctx context.Context, in *notifypb.GetBulkSendJobRequest) (*notifypb.BulkSendJob, error) {
+	request := model.GetBulkSendJobRequest{
+		JobId:    in.JobId,
+		ClientId: s.getClientId(ctx),
+	}
+
+	if err := s.validator.CheckGetBulkSendJobRequest(request); err != nil {
+		return nil, s.HandleError(err)
+	}
+
+	job, err := s.bulkSendService.GetBulkSendJob(ctx, request)
+	if err != nil {
+		return nil, s.HandleError(err)
+	}
+	return convertBulkSendJobToProto(job), nil
+}
+
+// DownloadBulkSendReport 以 CSV 格式分批回傳驗證失敗的資料列
+func (s NotifyServer) DownloadBulkSendReport(in *notifypb.DownloadBulkSendReportRequest, stream notifypb.NotifyService_DownloadBulkSendReportServer) error {
+	request := model.DownloadBulkSendReportRequest{
+		JobId:    in.JobId,
+		ClientId: s.getClientId(stream.Context()),
+	}
+
+	if err := s.validator.CheckDownloadBulkSendReportRequest(request); err != nil {
+		return s.HandleError(err)
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write([]string{"row", "field", "value", "reason"}); err != nil {
+		return s.HandleError(err)
+	}
+
+	err := s.bulkSendService.StreamErrors(stream.Context(), request, func(rowErrors []service.BulkSendRowError) error {
+		for _, rowError := range rowErrors {
+			record := []string{strconv.Itoa(rowError.RowNumber), rowError.Field, rowError.Value, rowError.Reason}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		if err := stream.Send(&notifypb.BulkSendReportChunk{Data: buffer.Bytes()}); err != nil {
+			return err
+		}
+		buffer.Reset()
+		return nil
+	})
+	if err != nil {
+		return s.HandleError(err)
+	}
+
+	// 沒有錯誤資料列時仍回傳欄位名稱列
+	writer.Flush()
+	if buffer.Len() > 0 {
+		if err := stream.Send(&notifypb.BulkSendReportChunk{Data: buffer.Bytes()}); err != nil {
+			return s.HandleError(err)
+		}
+	}
+	return nil
+}
+
+// 簡訊收件者正規化為 E.164 格式後再驗證、雜湊與儲存，無法解析的號碼保留原值交由驗證回報錯誤
+func (s NotifyServer) normalizeReceiver(channel model.MessageType, receiver string) string {
+	if channel != model.MessageType_SMS || receiver == "" {
+		return receiver
+	}
+
+	normalized, err := s.phoneNumber.Normalize(receiver)
+	if err != nil {
+		return receiver
+	}
+	return normalized
+}
+
+func (s NotifyServer) normalizeReceivers(channel model.MessageType, receivers []string) []string {
+	if channel != model.MessageType_SMS {
+		return receivers
+	}
+
+	normalized := make([]string, len(receivers))
+	for i, receiver := range receivers {
+		normalized[i] = s.normalizeReceiver(channel, receiver)
+	}
+	return normalized
+}
+
+// 未指定寄件者時使用預設寄件者
+func convertMail(in *notifypb.Mail) model.Mail {
+	senderName := in.GetSenderName().GetValue()
+	// 默認為發件人名稱
+	if senderName == "" {
+		senderName = shared.DEFAULT_MAIL_SENDER_NAME
+	}
+
+	senderAddress := in.GetSenderAddress().GetValue()
+	// 默認為發件人電子郵件地址
+	if senderAddress == "" {
+		senderAddress = shared.DEFAULT_MAIL_SENDER_ADDRESS
+	}
+
+	return model.Mail{
+		SenderName:    senderName,
+		SenderAddress: senderAddress,
+		Subject:       in.GetSubject(),
+		Body:          in.GetBody(),
+	}
+}
+
+// 備援通道的收件者依各通道的規則正規化，空字串維持不變
+func (s NotifyServer) convertFallbackPolicy(in *notifypb.FallbackPolicy) *model.FallbackPolicy {
+	if in == nil {
+		return nil
+	}
+
+	policy := &model.FallbackPolicy{
+		Steps: make([]model.FallbackStep, 0, len(in.Steps)),
+	}
+	for _, step := range in.Steps {
+		channel := model.ConvertMessageTypeWithProto[step.Channel]
+		fallbackStep := model.FallbackStep{
+			Channel:   channel,
+			Deadline:  step.GetDeadline().AsDuration(),
+			Receivers: s.normalizeReceivers(channel, step.Receivers),
+		}
+		if step.Sms != nil {
+			fallbackStep.Sms = &model.Sms{
+				Body: step.GetSms().GetBody(),
+			}
+		}
+		if step.Mail != nil {
+			mail := convertMail(step.GetMail())
+			fallbackStep.Mail = &mail
+		}
+		if step.Push != nil {
+			fallbackStep.Push = &model.Push{
+				Title: step.GetPush().GetTitle(),
+				Body:  step.GetPush().GetBody(),
+				Data:  step.GetPush().GetData(),
+			}
+		}
+		if step.Line != nil {
+			fallbackStep.Line = &model.Line{
+				Text:         step.GetLine().GetText(),
+				AltText:      step.GetLine().GetAltText(),
+				FlexContents: step.GetLine().GetFlexContents(),
+			}
+		}
+		policy.Steps = append(policy.Steps, fallbackStep)
+	}
+	return policy
+}
+
+func (s NotifyServer) convertSuppressionToProto(suppression *entity.Suppression) (*notifypb.Suppression, error) {
+	receiver, err := s.aesGcm.AesDecrypt(suppression.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &notifypb.Suppression{
+		Id:        suppression.Id,
+		Channel:   model.ConvertMessageTypeToProto[model.MessageType(suppression.Channel)],
+		Receiver:  receiver,
+		Reason:    model.ConvertSuppressionReasonToProto[model.SuppressionReason(suppression.Reason)],
+		Source:    suppression.Source,
+		CreatedAt: timestamppb.New(suppression.CreatedAt),
+		UpdatedAt: timestamppb.New(suppression.UpdatedAt),
+	}
+	if suppression.ExpiresAt != nil {
+		response.ExpiresAt = timestamppb.New(*suppression.ExpiresAt)
+	}
+	return response, nil
+}
+
+func (s NotifyServer) convertContactToProto(contact *entity.Contact) (*notifypb.Contact, error) {
+	response := &notifypb.Contact{
+		Id:        contact.Id,
+		Name:      contact.Name,
+		CreatedAt: timestamppb.New(contact.CreatedAt),
+		UpdatedAt: timestamppb.New(contact.UpdatedAt),
+	}
+
+	if contact.Phone != "" {
+		phone, err := s.aesGcm.AesDecrypt(contact.Phone)
+		if err != nil {
+			return nil, err
+		}
+		response.Phone = phone
+	}
+
+	if contact.Email != "" {
+		email, err := s.aesGcm.AesDecrypt(contact.Email)
+		if err != nil {
+			return nil, err
+		}
+		response.Email = email
+	}
+	return response, nil
+}
+
+func convertGroupToProto(group *entity.Group) *notifypb.Group {
+	return &notifypb.Group{
+		Id:          group.Id,
+		Name:        group.Name,
+		Description: group.Description,
+		MemberCount: int32(group.MemberCount),
+		CreatedAt:   timestamppb.New(group.CreatedAt),
+		UpdatedAt:   timestamppb.New(group.UpdatedAt),
+	}
+}
+
+func convertBulkSendJobToProto(job *entity.BulkSendJob) *notifypb.BulkSendJob {
+	response := &notifypb.BulkSendJob{
+		Id:           job.Id,
+		TemplateId:   job.TemplateId,
+		MessageId:    job.MessageId,
+		Channel:      model.ConvertMessageTypeToProto[model.MessageType(job.Channel)],
+		Status:       string(job.Status),
+		TotalRows:    int32(job.TotalRows),
+		AcceptedRows: int32(job.AcceptedRows),
+		FailedRows:   int32(job.FailedRows),
+		ErrorMessage: job.ErrorMessage,
+		CreatedAt:    timestamppb.New(job.CreatedAt),
+	}
+	if job.CompletedAt != nil {
+		response.CompletedAt = timestamppb.New(*job.CompletedAt)
+	}
+	return response
+}
+
+func convertMessageDetailToProto(detail *service.MessageDetail) *notifypb.Message {
+	message := detail.Message
+	response := &notifypb.Message{
+		MessageId:             message.Id,
+		MessageType:           model.ConvertMessageTypeToProto[model.MessageType(message.Type)],
+		Status:                string(message.Status),
+		Category:              message.Category,
+		CreatedAt:             timestamppb.New(message.CreatedAt),
+		TargetCounts:          make(map[string]int32, len(detail.TargetCounts)),
+		FallbackFromMessageId: message.FallbackFromMessageId,
+	}
+	if message.ScheduledAt != nil {
+		response.ScheduledAt = timestamppb.New(*message.ScheduledAt)
+	}
+	for status, count := range detail.TargetCounts {
+		response.TargetCounts[string(status)] = int32(count)
+	}
+	if detail.Fallback != nil {
+		response.Fallback = &notifypb.MessageFallback{
+			Step:              int32(detail.Fallback.Step),
+			Channel:           model.ConvertMessageTypeToProto[model.MessageType(detail.Fallback.Channel)],
+			Status:            string(detail.Fallback.Status),
+			DeadlineAt:        timestamppb.New(detail.Fallback.DeadlineAt),
+			FallbackMessageId: detail.Fallback.FallbackMessageId,
+		}
+	}
+	return response
+}
+
+func convertNotificationDetailToProto(detail *service.NotificationDetail) *notifypb.Notification {
+	notification := detail.Notification
+	response := &notifypb.Notification{
+		NotificationId: notification.Id,
+		Status:         string(detail.Status),
+		Category:       notification.Category,
+		CreatedAt:      timestamppb.New(notification.CreatedAt),
+		TargetCounts:   make(map[string]int32, len(detail.TargetCounts)),
+		Messages:       make([]*notifypb.Message, len(detail.Messages)),
+	}
+	if notification.ScheduledAt != nil {
+		response.ScheduledAt = timestamppb.New(*notification.ScheduledAt)
+	}
+	for status, count := range detail.TargetCounts {
+		response.TargetCounts[string(status)] = int32(count)
+	}
+	for i, message := range detail.Messages {
+		response.Messages[i] = convertMessageDetailToProto(message)
+	}
+	for _, channel := range detail.FailedChannels {
+		response.FailedChannels = append(response.FailedChannels, model.ConvertMessageTypeToProto[model.MessageType(channel)])
+	}
+	return response
+}
+
+// bulkSendChunkReader 將上傳串流的檔案內容轉為 io.Reader，供 CSV 逐列讀取
+type bulkSendChunkReader struct {
+	stream          notifypb.NotifyService_UploadBulkSendServer
+	bulkSendService *service.BulkSendService
+	buffer          []byte
+}
+
+func (r *bulkSendChunkReader) Read(p []byte) (int, error) {
+	for len(r.buffer) == 0 {
+		in, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if in.GetHeader() != nil {
+			return 0, r.bulkSendService.InvalidCsvError("bulk send header must only be sent once")
+		}
+		r.buffer = in.GetChunk()
+	}
+
+	n := copy(p, r.buffer)
+	r.buffer = r.buffer[n:]
+	return n, nil
+}
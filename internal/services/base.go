@@ -3,8 +3,12 @@ package service
 import (
 	cus_error "notify-service/internal/errors"
 	errorpb "proto/pkg/notify/v1/error"
+	"time"
 )
 
+// 單次查詢或寫入的最大筆數，避免群組展開後的大量收件者超過 PostgreSQL 參數數量上限
+const dbBatchSize = 1000
+
 type BaseService struct{}
 
 // 通用錯誤處理
@@ -31,3 +35,11 @@ func (s *BaseService) NotFoundError(msg string, msgCode errorpb.ErrorReasonCode)
 func (s *BaseService) ServerError(msg string, msgCode errorpb.ErrorReasonCode) *cus_error.ServerError {
 	return &cus_error.ServerError{BaseError: *s.newError(msg, msgCode)}
 }
+
+func (s *BaseService) FailedPreconditionError(msg string, msgCode errorpb.ErrorReasonCode) *cus_error.FailedPreconditionError {
+	return &cus_error.FailedPreconditionError{BaseError: *s.newError(msg, msgCode)}
+}
+
+func (s *BaseService) ResourceExhaustedError(msg string, msgCode errorpb.ErrorReasonCode, retryAfter time.Duration) *cus_error.ResourceExhaustedError {
+	return &cus_error.ResourceExhaustedError{BaseError: *s.newError(msg, msgCode), RetryAfter: retryAfter}
+}
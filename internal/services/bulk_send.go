@@ -0,0 +1,525 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	errorpb "proto/pkg/notify/v1/error"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type BulkSendService struct {
+	BaseService
+	db            *gorm.DB
+	config        *shared.Config
+	snowflake     *snowflake.Node
+	aesGcm        *component.AesGcm
+	notifyService *NotifyService
+}
+
+func NewBulkSendService(
+	db *gorm.DB,
+	config *shared.Config,
+	snowflake *snowflake.Node,
+	aesGcm *component.AesGcm,
+	notifyService *NotifyService,
+) *BulkSendService {
+	return &BulkSendService{
+		db:            db,
+		config:        config,
+		snowflake:     snowflake,
+		aesGcm:        aesGcm,
+		notifyService: notifyService,
+	}
+}
+
+// 收件者欄位名稱，其餘欄位作為範本變數
+const BulkSendReceiverColumn = "receiver"
+
+// BulkSend 上傳中的批量發送，僅保留計數，不保留檔案內容與已出現的收件者
+type BulkSend struct {
+	Job       *entity.BulkSendJob
+	Message   *entity.Message
+	Variables []string // 範本使用的變數名稱
+
+	timezone string
+}
+
+// BulkSendRow 通過格式驗證的資料列
+type BulkSendRow struct {
+	RowNumber int
+	Receiver  string
+	Variables map[string]string
+}
+
+// BulkSendRowError 驗證失敗的資料列
+type BulkSendRowError struct {
+	RowNumber int
+	Field     string
+	Value     string
+	Reason    string
+}
+
+// StartBulkSend 依範本建立訊息與批量發送工作，上傳完成前訊息維持 pending，避免排程工作提前派送
+func (s BulkSendService) StartBulkSend(ctx context.Context, in model.BulkSendHeaderRequest) (*BulkSend, error) {
+	var template entity.Template
+	err := s.db.WithContext(ctx).
+		Where("id = ?", in.TemplateId).
+		Where("enabled = ?", true).
+		First(&template).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.NotFoundError("template not found", errorpb.ErrorReasonCode_ERR_NOTIFY_TEMPLATE_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get template failed with db query: %v", err))
+		return nil, s.ServerError("get template failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	now := time.Now()
+	message := &entity.Message{
+		Id:            s.snowflake.Generate().String(),
+		Type:          template.Channel,
+		Data:          template.Body,
+		SenderName:    template.SenderName,
+		SenderAddress: template.SenderAddress,
+		Subject:       template.Subject,
+		ScheduledAt:   in.ScheduledAt,
+		ValidUntil:    in.ValidUntil,
+		Priority:      entity.MessagePriority(in.Priority),
+		Category:      in.Category,
+		CreatedAt:     now,
+	}
+
+	if err := s.notifyService.applyQuietHours(ctx, message, in.ClientId, nil, in.Timezone); err != nil {
+		return nil, err
+	}
+	message.Status = entity.MessageStatus_PENDING
+
+	job := &entity.BulkSendJob{
+		Id:         s.snowflake.Generate().String(),
+		ClientId:   in.ClientId,
+		TemplateId: template.Id,
+		MessageId:  message.Id,
+		Channel:    template.Channel,
+		Status:     entity.BulkSendJobStatus_PROCESSING,
+		CreatedAt:  now,
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
+			return err
+		}
+		return tx.Create(job).Error
+	})
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("create bulk send job failed with db query: %v", err))
+		return nil, s.ServerError("create bulk send job failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	return &BulkSend{
+		Job:       job,
+		Message:   message,
+		Variables: util.GetTemplateVariables(template.Subject, template.Body),
+		timezone:  in.Timezone,
+	}, nil
+}
+
+// CheckColumns 檢查欄位名稱列須包含收件者與範本使用的所有變數，回傳欄位名稱對應的索引
+func (s BulkSendService) CheckColumns(bulk *BulkSend, columns []string) (map[string]int, error) {
+	indexes := make(map[string]int, len(columns))
+	for i, column := range columns {
+		column = strings.TrimSpace(column)
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		if _, ok := indexes[column]; ok {
+			return nil, s.InvalidCsvError(fmt.Sprintf("duplicate column %s", column))
+		}
+		indexes[column] = i
+	}
+
+	if _, ok := indexes[BulkSendReceiverColumn]; !ok {
+		return nil, s.InvalidCsvError(fmt.Sprintf("missing column %s", BulkSendReceiverColumn))
+	}
+	for _, variable := range bulk.Variables {
+		if _, ok := indexes[variable]; !ok {
+			return nil, s.InvalidCsvError(fmt.Sprintf("missing column %s", variable))
+		}
+	}
+	return indexes, nil
+}
+
+func (s BulkSendService) InvalidCsvError(msg string) error {
+	return s.ValueError(msg, errorpb.ErrorReasonCode_ERR_NOTIFY_BULK_SEND_INVALID_CSV)
+}
+
+// AppendRows 為一批資料列建立發送紀錄與佇列，重複的收件者記錄為錯誤
+func (s BulkSendService) AppendRows(ctx context.Context, bulk *BulkSend, rows []BulkSendRow) error {
+	receiverHashes := make([]string, len(rows))
+	for i, row := range rows {
+		receiverHashes[i] = util.Md5(row.Receiver)
+	}
+	seen, err := s.getAcceptedReceiverHashes(ctx, bulk.Message.Id, receiverHashes)
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get bulk send receivers failed with db query: %v", err))
+		return s.ServerError("get bulk send receivers failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	now := time.Now()
+	receivers := make([]string, 0, len(rows))
+	accepted := make([]*entity.BulkSendReceiver, 0, len(rows))
+	variables := make(map[string]string, len(rows))
+	var rowErrors []BulkSendRowError
+	for i, row := range rows {
+		receiverHash := receiverHashes[i]
+		if seen[receiverHash] {
+			rowErrors = append(rowErrors, BulkSendRowError{
+				RowNumber: row.RowNumber,
+				Field:     "receiver",
+				Value:     row.Receiver,
+				Reason:    "duplicate receiver",
+			})
+			continue
+		}
+		seen[receiverHash] = true
+
+		encoded, err := encodeTargetVariables(s.aesGcm, row.Variables)
+		if err != nil {
+			return s.ServerError("encrypt variables failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		receivers = append(receivers, row.Receiver)
+		accepted = append(accepted, &entity.BulkSendReceiver{
+			MessageId:    bulk.Message.Id,
+			ReceiverHash: receiverHash,
+			CreatedAt:    now,
+		})
+		variables[row.Receiver] = encoded
+	}
+
+	if err := s.RecordErrors(ctx, bulk, rowErrors); err != nil {
+		return err
+	}
+	if len(receivers) == 0 {
+		return nil
+	}
+
+	queues, targets, deferred, err := s.notifyService.prepareTargetsAndQueues(ctx, bulk.Message, receivers, variables, false)
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("prepare targets and queues failed with db query: %v", err))
+		return s.ServerError("prepare targets and queues failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	bulk.Job.AcceptedRows += len(receivers)
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(accepted, dbBatchSize).Error; err != nil {
+			return err
+		}
+		if err := tx.CreateInBatches(targets, dbBatchSize).Error; err != nil {
+			return err
+		}
+		if len(queues) > 0 {
+			if err := tx.Create(queues).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(bulk.Job).
+			Updates(map[string]interface{}{
+				"total_rows":    bulk.Job.TotalRows,
+				"accepted_rows": bulk.Job.AcceptedRows,
+			}).
+			Error
+	})
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("create bulk send targets failed with db query: %v", err))
+		return s.ServerError("create bulk send targets failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if deferred != nil {
+		s.notifyService.publishDeferredMessage(ctx, bulk.Message, deferred, publishRequest{
+			clientId:  bulk.Job.ClientId,
+			timezone:  bulk.timezone,
+			variables: variables,
+		})
+	}
+	return nil
+}
+
+// 查詢先前批次已接受的收件者雜湊值
+func (s BulkSendService) getAcceptedReceiverHashes(ctx context.Context, messageId string, receiverHashes []string) (map[string]bool, error) {
+	accepted := make(map[string]bool)
+	for _, chunk := range util.ChunkArray(receiverHashes, dbBatchSize) {
+		var hashes []string
+		err := s.db.WithContext(ctx).
+			Model(&entity.BulkSendReceiver{}).
+			Where("message_id = ?", messageId).
+			Where("receiver_hash IN ?", chunk).
+			Pluck("receiver_hash", &hashes).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hash := range hashes {
+			accepted[hash] = true
+		}
+	}
+	return accepted, nil
+}
+
+// 工作結束後不再新增資料列，刪除排除重複收件者用的紀錄
+func (s BulkSendService) deleteAcceptedReceivers(ctx context.Context, bulk *BulkSend) {
+	err := s.db.WithContext(ctx).
+		Where("message_id = ?", bulk.Message.Id).
+		Delete(&entity.BulkSendReceiver{}).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("delete bulk send %s receivers failed with db query: %v", bulk.Job.Id, err))
+	}
+}
+
+// RecordErrors 儲存驗證失敗的資料列，供之後下載錯誤報告
+func (s BulkSendService) RecordErrors(ctx context.Context, bulk *BulkSend, rowErrors []BulkSendRowError) error {
+	if len(rowErrors) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	records := make([]*entity.BulkSendError, len(rowErrors))
+	for i, rowError := range rowErrors {
+		value := rowError.Value
+		if value != "" {
+			encrypted, err := s.aesGcm.AesEncrypt(value)
+			if err != nil {
+				return s.ServerError("encrypt bulk send error failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+			value = encrypted
+		}
+		records[i] = &entity.BulkSendError{
+			Id:        s.snowflake.Generate().String(),
+			JobId:     bulk.Job.Id,
+			RowNumber: rowError.RowNumber,
+			Field:     rowError.Field,
+			Value:     value,
+			Reason:    rowError.Reason,
+			CreatedAt: now,
+		}
+	}
+
+	bulk.Job.FailedRows += len(rowErrors)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(records, dbBatchSize).Error; err != nil {
+			return err
+		}
+		return tx.Model(bulk.Job).
+			Updates(map[string]interface{}{
+				"total_rows":  bulk.Job.TotalRows,
+				"failed_rows": bulk.Job.FailedRows,
+			}).
+			Error
+	})
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("create bulk send errors failed with db query: %v", err))
+		return s.ServerError("create bulk send errors failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return nil
+}
+
+// CompleteBulkSend 上傳完成後派送訊息，預約訊息交由排程工作派送，沒有任何有效資料列時取消訊息
+func (s BulkSendService) CompleteBulkSend(ctx context.Context, bulk *BulkSend) error {
+	if bulk.Job.AcceptedRows == 0 {
+		if err := s.notifyService.CancelScheduledByMessageId(ctx, bulk.Message.Id); err != nil {
+			return err
+		}
+	} else {
+		var queues []*entity.Queue
+		err := s.db.WithContext(ctx).
+			Where("message_id = ?", bulk.Message.Id).
+			Where("created_at >= ?", bulk.Message.CreatedAt).
+			Find(&queues).
+			Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("get bulk send queues failed with db query: %v", err))
+			return s.ServerError("get bulk send queues failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		if err := s.dispatchMessage(ctx, bulk.Message, queues); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	bulk.Job.Status = entity.BulkSendJobStatus_COMPLETED
+	bulk.Job.CompletedAt = &now
+	err := s.db.WithContext(ctx).
+		Model(bulk.Job).
+		Updates(map[string]interface{}{
+			"status":        bulk.Job.Status,
+			"total_rows":    bulk.Job.TotalRows,
+			"accepted_rows": bulk.Job.AcceptedRows,
+			"failed_rows":   bulk.Job.FailedRows,
+			"completed_at":  bulk.Job.CompletedAt,
+		}).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("complete bulk send job failed with db query: %v", err))
+		return s.ServerError("complete bulk send job failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	s.deleteAcceptedReceivers(ctx, bulk)
+	return nil
+}
+
+// FailBulkSend 上傳中斷時取消訊息，已建立的發送紀錄不會被派送
+func (s BulkSendService) FailBulkSend(ctx context.Context, bulk *BulkSend, reason string) {
+	if err := s.notifyService.CancelScheduledByMessageId(ctx, bulk.Message.Id); err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("cancel bulk send message %s failed: %v", bulk.Message.Id, err))
+	}
+
+	now := time.Now()
+	err := s.db.WithContext(ctx).
+		Model(bulk.Job).
+		Updates(map[string]interface{}{
+			"status":        entity.BulkSendJobStatus_FAILED,
+			"error_message": reason,
+			"total_rows":    bulk.Job.TotalRows,
+			"accepted_rows": bulk.Job.AcceptedRows,
+			"failed_rows":   bulk.Job.FailedRows,
+			"completed_at":  now,
+		}).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("fail bulk send job %s failed with db query: %v", bulk.Job.Id, err))
+	}
+
+	s.deleteAcceptedReceivers(ctx, bulk)
+}
+
+// GetBulkSendJob 查詢批量發送工作，僅能查詢呼叫端自己建立的工作
+func (s BulkSendService) GetBulkSendJob(ctx context.Context, in model.GetBulkSendJobRequest) (*entity.BulkSendJob, error) {
+	var job entity.BulkSendJob
+	err := s.db.WithContext(ctx).
+		Where("id = ?", in.JobId).
+		Where("client_id = ?", in.ClientId).
+		First(&job).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.NotFoundError("bulk send job not found", errorpb.ErrorReasonCode_ERR_NOTIFY_BULK_SEND_JOB_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get bulk send job failed with db query: %v", err))
+		return nil, s.ServerError("get bulk send job failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return &job, nil
+}
+
+// StreamErrors 依資料列序號分批讀取錯誤明細，欄位值已解密
+func (s BulkSendService) StreamErrors(
+	ctx context.Context,
+	in model.DownloadBulkSendReportRequest,
+	fn func(rowErrors []BulkSendRowError) error,
+) error {
+	if _, err := s.GetBulkSendJob(ctx, model.GetBulkSendJobRequest{JobId: in.JobId, ClientId: in.ClientId}); err != nil {
+		return err
+	}
+
+	var records []*entity.BulkSendError
+	result := s.db.WithContext(ctx).
+		Where("job_id = ?", in.JobId).
+		Order("row_number, id").
+		FindInBatches(&records, dbBatchSize, func(tx *gorm.DB, batch int) error {
+			rowErrors := make([]BulkSendRowError, len(records))
+			for i, record := range records {
+				value := record.Value
+				if value != "" {
+					decrypted, err := s.aesGcm.AesDecrypt(value)
+					if err != nil {
+						return err
+					}
+					value = decrypted
+				}
+				rowErrors[i] = BulkSendRowError{
+					RowNumber: record.RowNumber,
+					Field:     record.Field,
+					Value:     value,
+					Reason:    record.Reason,
+				}
+			}
+			return fn(rowErrors)
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("stream bulk send errors failed: %v", result.Error))
+		return s.ServerError("stream bulk send errors failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return nil
+}
+
+// 預約訊息標記為 scheduled 交由排程工作派送，其餘立即加入佇列
+func (s BulkSendService) dispatchMessage(ctx context.Context, message *entity.Message, queues []*entity.Queue) error {
+	if len(queues) == 0 {
+		err := s.db.WithContext(ctx).
+			Model(message).
+			Update("status", entity.MessageStatus_SUPPRESSED).
+			Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("update message status failed with db query: %v", err))
+			return s.ServerError("update message status failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		return nil
+	}
+
+	if message.ScheduledAt != nil {
+		err := s.db.WithContext(ctx).
+			Model(message).
+			Update("status", entity.MessageStatus_SCHEDULED).
+			Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("update message status failed with db query: %v", err))
+			return s.ServerError("update message status failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		return nil
+	}
+
+	if err := s.notifyService.HandleEnqueue(ctx, message.Type, message.Priority, queues); err != nil {
+		return s.ServerError("handle enqueue failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return nil
+}
+
+// 個人化變數以 AES 加密的 JSON 儲存於發送紀錄
+func encodeTargetVariables(aesGcm *component.AesGcm, variables map[string]string) (string, error) {
+	if len(variables) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(variables)
+	if err != nil {
+		return "", err
+	}
+	return aesGcm.AesEncrypt(string(data))
+}
+
+func decodeTargetVariables(aesGcm *component.AesGcm, target entity.Target) (map[string]string, error) {
+	if target.Variables == "" {
+		return nil, nil
+	}
+	data, err := aesGcm.AesDecrypt(target.Variables)
+	if err != nil {
+		return nil, err
+	}
+	var variables map[string]string
+	if err := json.Unmarshal([]byte(data), &variables); err != nil {
+		return nil, err
+	}
+	return variables, nil
+}
@@ -0,0 +1,580 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	errorpb "proto/pkg/notify/v1/error"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContactService struct {
+	BaseService
+	db        *gorm.DB
+	snowflake *snowflake.Node
+	aesGcm    *component.AesGcm
+}
+
+func NewContactService(
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+	aesGcm *component.AesGcm,
+) *ContactService {
+	return &ContactService{
+		db:        db,
+		snowflake: snowflake,
+		aesGcm:    aesGcm,
+	}
+}
+
+func (s ContactService) CreateContact(ctx context.Context, in model.ContactRequest) (*entity.Contact, error) {
+	contact := &entity.Contact{
+		Id:        s.snowflake.Generate().String(),
+		CreatedAt: time.Now(),
+	}
+	if err := s.fillContact(contact, in); err != nil {
+		return nil, s.ServerError("encrypt contact failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if err := s.checkDuplicate(ctx, contact); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Create(contact).Error; err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("create contact failed with db query: %v", err))
+		return nil, s.ServerError("create contact failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return contact, nil
+}
+
+func (s ContactService) UpdateContact(ctx context.Context, in model.UpdateContactRequest) (*entity.Contact, error) {
+	contact, err := s.getContact(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fillContact(contact, in.ContactRequest); err != nil {
+		return nil, s.ServerError("encrypt contact failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if err := s.checkDuplicate(ctx, contact); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Select("name", "phone", "phone_hash", "email", "email_hash", "updated_at").Updates(contact).Error; err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("update contact failed with db query: %v", err))
+		return nil, s.ServerError("update contact failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return contact, nil
+}
+
+// DeleteContact 刪除聯絡人並移除所屬的群組成員關係
+func (s ContactService) DeleteContact(ctx context.Context, in model.DeleteContactRequest) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", in.Id).Delete(&entity.Contact{})
+		if result.Error != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("delete contact failed with db query: %v", result.Error))
+			return s.ServerError("delete contact failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		if result.RowsAffected == 0 {
+			return s.NotFoundError("contact not found", errorpb.ErrorReasonCode_ERR_NOTIFY_CONTACT_NOT_FOUND)
+		}
+
+		if err := tx.Where("contact_id = ?", in.Id).Delete(&entity.GroupMember{}).Error; err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("delete group members failed with db query: %v", err))
+			return s.ServerError("delete group members failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		return nil
+	})
+}
+
+func (s ContactService) ListContactsWithPaging(ctx context.Context, in model.ListContactsWithPagingRequest) ([]*entity.Contact, int64, error) {
+	var contacts []*entity.Contact
+
+	query := s.db.WithContext(ctx).Model(&entity.Contact{})
+
+	if in.GroupId != "" {
+		query = query.Where("id IN (?)", s.db.Model(&entity.GroupMember{}).Select("contact_id").Where("group_id = ?", in.GroupId))
+	}
+
+	if in.Phone != "" {
+		query = query.Where("phone_hash = ?", util.Md5(in.Phone))
+	}
+
+	if in.Email != "" {
+		query = query.Where("email_hash = ?", util.Md5(in.Email))
+	}
+
+	var total int64
+	query.Count(&total)
+
+	// 排序欄位已於請求驗證限制在允許的欄位內
+	if in.SortField != "" && in.SortOrder != "" {
+		query = query.Order(fmt.Sprintf("%s %s", in.SortField, in.SortOrder))
+	}
+
+	if in.Page.Index > 0 && in.Page.Size > 0 {
+		query = query.Offset(int((in.Page.Index - 1) * in.Page.Size)).Limit(int(in.Page.Size))
+	}
+	err := query.Find(&contacts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return contacts, total, nil
+}
+
+// ImportContactsResult 批量匯入結果，ContactIds 與請求順序相同
+type ImportContactsResult struct {
+	CreatedCount int
+	UpdatedCount int
+	ContactIds   []string
+}
+
+// ImportContacts 批量匯入聯絡人，手機號碼或電子郵件地址已存在時更新原聯絡人，指定群組時一併加入群組
+func (s ContactService) ImportContacts(ctx context.Context, in model.ImportContactsRequest) (*ImportContactsResult, error) {
+	if in.GroupId != "" {
+		if _, err := s.getGroup(ctx, in.GroupId); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &ImportContactsResult{
+		ContactIds: make([]string, len(in.Contacts)),
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := s.findContactsByHashes(tx, in.Contacts)
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("find contacts failed with db query: %v", err))
+			return s.ServerError("find contacts failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		now := time.Now()
+		var creates []*entity.Contact
+		created := make(map[string]bool)
+		updates := make(map[string]*entity.Contact)
+		for i, item := range in.Contacts {
+			contact := matchContact(existing, item)
+			if contact == nil {
+				contact = &entity.Contact{
+					Id:        s.snowflake.Generate().String(),
+					CreatedAt: now,
+				}
+				creates = append(creates, contact)
+				created[contact.Id] = true
+			} else if !created[contact.Id] {
+				updates[contact.Id] = contact
+			}
+
+			if err := s.mergeContact(contact, item); err != nil {
+				return s.ServerError("encrypt contact failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+			contact.UpdatedAt = now
+
+			// 同一批次重複的手機號碼或電子郵件地址合併為同一聯絡人
+			if contact.PhoneHash != "" {
+				existing[contact.PhoneHash] = contact
+			}
+			if contact.EmailHash != "" {
+				existing[contact.EmailHash] = contact
+			}
+			result.ContactIds[i] = contact.Id
+		}
+
+		if len(creates) > 0 {
+			if err := tx.CreateInBatches(creates, dbBatchSize).Error; err != nil {
+				log.WithContext(ctx).Error(fmt.Sprintf("create contacts failed with db query: %v", err))
+				return s.ServerError("create contacts failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+		}
+
+		for _, contact := range updates {
+			if err := tx.Select("name", "phone", "phone_hash", "email", "email_hash", "updated_at").Updates(contact).Error; err != nil {
+				log.WithContext(ctx).Error(fmt.Sprintf("update contact failed with db query: %v", err))
+				return s.ServerError("update contact failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+		}
+
+		if in.GroupId != "" {
+			if err := s.addGroupMembers(tx, in.GroupId, result.ContactIds); err != nil {
+				log.WithContext(ctx).Error(fmt.Sprintf("add group members failed with db query: %v", err))
+				return s.ServerError("add group members failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+		}
+
+		result.CreatedCount = len(creates)
+		result.UpdatedCount = len(updates)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s ContactService) CreateGroup(ctx context.Context, in model.CreateGroupRequest) (*entity.Group, error) {
+	group := &entity.Group{
+		Id:          s.snowflake.Generate().String(),
+		Name:        in.Name,
+		Description: in.Description,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.db.WithContext(ctx).Create(group).Error; err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("create group failed with db query: %v", err))
+		return nil, s.ServerError("create group failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return group, nil
+}
+
+func (s ContactService) UpdateGroup(ctx context.Context, in model.UpdateGroupRequest) (*entity.Group, error) {
+	group, err := s.getGroup(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Name = in.Name
+	group.Description = in.Description
+	if err := s.db.WithContext(ctx).Select("name", "description", "updated_at").Updates(group).Error; err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("update group failed with db query: %v", err))
+		return nil, s.ServerError("update group failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if err := s.db.WithContext(ctx).Model(&entity.GroupMember{}).Where("group_id = ?", group.Id).Count(&group.MemberCount).Error; err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("count group members failed with db query: %v", err))
+		return nil, s.ServerError("count group members failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return group, nil
+}
+
+// DeleteGroup 刪除群組與成員關係，不刪除聯絡人
+func (s ContactService) DeleteGroup(ctx context.Context, in model.DeleteGroupRequest) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", in.Id).Delete(&entity.Group{})
+		if result.Error != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("delete group failed with db query: %v", result.Error))
+			return s.ServerError("delete group failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		if result.RowsAffected == 0 {
+			return s.NotFoundError("group not found", errorpb.ErrorReasonCode_ERR_NOTIFY_GROUP_NOT_FOUND)
+		}
+
+		if err := tx.Where("group_id = ?", in.Id).Delete(&entity.GroupMember{}).Error; err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("delete group members failed with db query: %v", err))
+			return s.ServerError("delete group members failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		return nil
+	})
+}
+
+func (s ContactService) ListGroupsWithPaging(ctx context.Context, in model.ListGroupsWithPagingRequest) ([]*entity.Group, int64, error) {
+	var groups []*entity.Group
+
+	query := s.db.WithContext(ctx).Model(&entity.Group{})
+
+	if in.Name != "" {
+		query = query.Where("name ILIKE ?", "%"+in.Name+"%")
+	}
+
+	var total int64
+	query.Count(&total)
+
+	query = query.Select("*, (SELECT COUNT(*) FROM notify.group_members AS gm WHERE gm.group_id = groups.id) AS member_count")
+
+	// 排序欄位已於請求驗證限制在允許的欄位內
+	if in.SortField != "" && in.SortOrder != "" {
+		query = query.Order(fmt.Sprintf("%s %s", in.SortField, in.SortOrder))
+	}
+
+	if in.Page.Index > 0 && in.Page.Size > 0 {
+		query = query.Offset(int((in.Page.Index - 1) * in.Page.Size)).Limit(int(in.Page.Size))
+	}
+	err := query.Find(&groups).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, total, nil
+}
+
+// AddGroupMembers 將聯絡人加入群組，已是成員者略過
+func (s ContactService) AddGroupMembers(ctx context.Context, in model.GroupMembersRequest) error {
+	if _, err := s.getGroup(ctx, in.GroupId); err != nil {
+		return err
+	}
+
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&entity.Contact{}).
+		Where("id IN ?", in.ContactIds).
+		Count(&count).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("count contacts failed with db query: %v", err))
+		return s.ServerError("count contacts failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	if int(count) != len(util.Unique(in.ContactIds)) {
+		return s.NotFoundError("contact not found", errorpb.ErrorReasonCode_ERR_NOTIFY_CONTACT_NOT_FOUND)
+	}
+
+	if err := s.addGroupMembers(s.db.WithContext(ctx), in.GroupId, in.ContactIds); err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("add group members failed with db query: %v", err))
+		return s.ServerError("add group members failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return nil
+}
+
+func (s ContactService) RemoveGroupMembers(ctx context.Context, in model.GroupMembersRequest) error {
+	if _, err := s.getGroup(ctx, in.GroupId); err != nil {
+		return err
+	}
+
+	err := s.db.WithContext(ctx).
+		Where("group_id = ?", in.GroupId).
+		Where("contact_id IN ?", in.ContactIds).
+		Delete(&entity.GroupMember{}).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("remove group members failed with db query: %v", err))
+		return s.ServerError("remove group members failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return nil
+}
+
+// ExpandReceivers 將群組展開為該通道的收件者，與請求中的收件者合併去重，
+// 未設定手機號碼或電子郵件地址的成員略過
+func (s ContactService) ExpandReceivers(
+	ctx context.Context,
+	channel entity.MessageType,
+	receivers []string,
+	groupIds []string,
+) ([]string, error) {
+	if len(groupIds) > 0 {
+		groupIds = util.Unique(groupIds)
+
+		var count int64
+		err := s.db.WithContext(ctx).
+			Model(&entity.Group{}).
+			Where("id IN ?", groupIds).
+			Count(&count).
+			Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("count groups failed with db query: %v", err))
+			return nil, s.ServerError("count groups failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		if int(count) != len(groupIds) {
+			return nil, s.NotFoundError("group not found", errorpb.ErrorReasonCode_ERR_NOTIFY_GROUP_NOT_FOUND)
+		}
+
+		column := "phone"
+		if channel == entity.MessageType_MAIL {
+			column = "email"
+		}
+
+		var encrypted []string
+		err = s.db.WithContext(ctx).
+			Model(&entity.Contact{}).
+			Distinct(column).
+			Where("id IN (?)", s.db.Model(&entity.GroupMember{}).Select("contact_id").Where("group_id IN ?", groupIds)).
+			Where(fmt.Sprintf("%s <> ''", column)).
+			Pluck(column, &encrypted).
+			Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("expand group receivers failed with db query: %v", err))
+			return nil, s.ServerError("expand group receivers failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		for _, value := range encrypted {
+			receiver, err := s.aesGcm.AesDecrypt(value)
+			if err != nil {
+				return nil, s.ServerError("decrypt contact failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+			receivers = append(receivers, receiver)
+		}
+	}
+
+	receivers = util.Unique(receivers)
+	if len(receivers) == 0 {
+		return nil, s.ValueError("receivers is empty", errorpb.ErrorReasonCode_ERR_NOTIFY_RECEIVER_EMPTY)
+	}
+	return receivers, nil
+}
+
+func (s ContactService) getContact(ctx context.Context, id string) (*entity.Contact, error) {
+	var contact entity.Contact
+	err := s.db.WithContext(ctx).Where("id = ?", id).First(&contact).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.NotFoundError("contact not found", errorpb.ErrorReasonCode_ERR_NOTIFY_CONTACT_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get contact failed with db query: %v", err))
+		return nil, s.ServerError("get contact failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return &contact, nil
+}
+
+func (s ContactService) getGroup(ctx context.Context, id string) (*entity.Group, error) {
+	var group entity.Group
+	err := s.db.WithContext(ctx).Where("id = ?", id).First(&group).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.NotFoundError("group not found", errorpb.ErrorReasonCode_ERR_NOTIFY_GROUP_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get group failed with db query: %v", err))
+		return nil, s.ServerError("get group failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return &group, nil
+}
+
+// 手機號碼或電子郵件地址已屬於其他聯絡人時回傳錯誤
+func (s ContactService) checkDuplicate(ctx context.Context, contact *entity.Contact) error {
+	query := s.db.WithContext(ctx).Model(&entity.Contact{}).Where("id <> ?", contact.Id)
+	switch {
+	case contact.PhoneHash != "" && contact.EmailHash != "":
+		query = query.Where("phone_hash = ? OR email_hash = ?", contact.PhoneHash, contact.EmailHash)
+	case contact.PhoneHash != "":
+		query = query.Where("phone_hash = ?", contact.PhoneHash)
+	default:
+		query = query.Where("email_hash = ?", contact.EmailHash)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("check contact failed with db query: %v", err))
+		return s.ServerError("check contact failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	if count > 0 {
+		return s.DuplicateError("contact already exists", errorpb.ErrorReasonCode_ERR_NOTIFY_CONTACT_ALREADY_EXISTS)
+	}
+	return nil
+}
+
+// 依手機號碼與電子郵件地址的雜湊值取得已存在的聯絡人
+func (s ContactService) findContactsByHashes(tx *gorm.DB, items []model.ContactRequest) (map[string]*entity.Contact, error) {
+	hashes := make([]string, 0, len(items)*2)
+	for _, item := range items {
+		if item.Phone != "" {
+			hashes = append(hashes, util.Md5(item.Phone))
+		}
+		if item.Email != "" {
+			hashes = append(hashes, util.Md5(item.Email))
+		}
+	}
+
+	existing := make(map[string]*entity.Contact)
+	for _, chunk := range util.ChunkArray(util.Unique(hashes), dbBatchSize) {
+		var contacts []*entity.Contact
+		err := tx.
+			Where("phone_hash IN ? OR email_hash IN ?", chunk, chunk).
+			Find(&contacts).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, contact := range contacts {
+			if contact.PhoneHash != "" {
+				existing[contact.PhoneHash] = contact
+			}
+			if contact.EmailHash != "" {
+				existing[contact.EmailHash] = contact
+			}
+		}
+	}
+	return existing, nil
+}
+
+func (s ContactService) addGroupMembers(tx *gorm.DB, groupId string, contactIds []string) error {
+	now := time.Now()
+	members := make([]*entity.GroupMember, 0, len(contactIds))
+	for _, contactId := range util.Unique(contactIds) {
+		members = append(members, &entity.GroupMember{
+			GroupId:   groupId,
+			ContactId: contactId,
+			CreatedAt: now,
+		})
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(members, dbBatchSize).Error
+}
+
+// 優先以手機號碼比對已存在的聯絡人，其次為電子郵件地址
+func matchContact(existing map[string]*entity.Contact, item model.ContactRequest) *entity.Contact {
+	if item.Phone != "" {
+		if contact, ok := existing[util.Md5(item.Phone)]; ok {
+			return contact
+		}
+	}
+	if item.Email != "" {
+		if contact, ok := existing[util.Md5(item.Email)]; ok {
+			return contact
+		}
+	}
+	return nil
+}
+
+// 匯入時僅更新有提供的欄位，保留聯絡人原有的其他資料
+func (s ContactService) mergeContact(contact *entity.Contact, in model.ContactRequest) error {
+	if in.Name != "" {
+		contact.Name = in.Name
+	}
+	if in.Phone != "" {
+		phone, err := s.aesGcm.AesEncrypt(in.Phone)
+		if err != nil {
+			return err
+		}
+		contact.Phone = phone
+		contact.PhoneHash = util.Md5(in.Phone)
+	}
+	if in.Email != "" {
+		email, err := s.aesGcm.AesEncrypt(in.Email)
+		if err != nil {
+			return err
+		}
+		contact.Email = email
+		contact.EmailHash = util.Md5(in.Email)
+	}
+	return nil
+}
+
+// 更新聯絡人欄位，未提供的手機號碼或電子郵件地址清空
+func (s ContactService) fillContact(contact *entity.Contact, in model.ContactRequest) error {
+	phone, err := s.encryptOptional(in.Phone)
+	if err != nil {
+		return err
+	}
+	email, err := s.encryptOptional(in.Email)
+	if err != nil {
+		return err
+	}
+
+	contact.Name = in.Name
+	contact.Phone = phone
+	contact.PhoneHash = hashOptional(in.Phone)
+	contact.Email = email
+	contact.EmailHash = hashOptional(in.Email)
+	return nil
+}
+
+func (s ContactService) encryptOptional(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	return s.aesGcm.AesEncrypt(value)
+}
+
+func hashOptional(value string) string {
+	if value == "" {
+		return ""
+	}
+	return util.Md5(value)
+}
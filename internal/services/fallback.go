@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// 接收者在原訊息的送達結果
+type fallbackOutcome int
+
+const (
+	fallbackOutcome_WAITING     fallbackOutcome = iota // 尚待結果
+	fallbackOutcome_DELIVERED                          // 已送達
+	fallbackOutcome_UNDELIVERED                        // 未送達，改由備援通道發送
+	fallbackOutcome_EXCLUDED                           // 已取消或受頻率上限限制，不改由備援通道發送
+)
+
+// FallbackService 依原訊息的送達結果，將未送達的接收者改由下一個通道發送
+type FallbackService struct {
+	db            *gorm.DB
+	aesGcm        *component.AesGcm
+	notifyService *NotifyService
+}
+
+func NewFallbackService(
+	db *gorm.DB,
+	aesGcm *component.AesGcm,
+	notifyService *NotifyService,
+) *FallbackService {
+	return &FallbackService{
+		db:            db,
+		aesGcm:        aesGcm,
+		notifyService: notifyService,
+	}
+}
+
+// Evaluate 原訊息的接收者皆有結果或已超過等待期限時建立備援訊息，尚待結果時不處理
+func (s FallbackService) Evaluate(ctx context.Context, fallback entity.MessageFallback, now time.Time) error {
+	createdAt, err := util.ConvertSnowflakeToTime(fallback.MessageId)
+	if err != nil {
+		return fmt.Errorf("error convert snowflake to time: %w", err)
+	}
+
+	// 避免全表搜尋加上時間戳範圍
+	startAt := createdAt.Add(-1 * time.Hour)
+	endAt := createdAt.Add(1 * time.Hour)
+	var message entity.Message
+	err = s.db.WithContext(ctx).
+		Unscoped().
+		Where("id = ?", fallback.MessageId).
+		Where("created_at between ? and ?", startAt, endAt).
+		First(&message).
+		Error
+	if err != nil {
+		return fmt.Errorf("error fetching message: %w", err)
+	}
+
+	if message.Status == entity.MessageStatus_CANCELED {
+		return s.updateStatus(ctx, fallback, entity.FallbackStatus_CANCELED)
+	}
+
+	var targets []entity.Target
+	err = s.db.WithContext(ctx).
+		Where("message_id = ?", message.Id).
+		Where("created_at between ? and ?", startAt, endAt).
+		Find(&targets).
+		Error
+	if err != nil {
+		return fmt.Errorf("error fetching targets: %w", err)
+	}
+
+	timedOut := !now.Before(fallback.DeadlineAt)
+	undelivered := make(map[string]bool)
+	for _, target := range targets {
+		switch getFallbackOutcome(message.Type, target.Status) {
+		case fallbackOutcome_DELIVERED, fallbackOutcome_EXCLUDED:
+			continue
+		case fallbackOutcome_WAITING:
+			if !timedOut {
+				return nil
+			}
+		}
+
+		receiver, err := s.aesGcm.AesDecrypt(target.Receiver)
+		if err != nil {
+			return fmt.Errorf("error decrypting receiver with target id %s: %w", target.Id, err)
+		}
+		undelivered[receiver] = true
+	}
+
+	// 原訊息已逾期時備援訊息同樣不再發送
+	if len(undelivered) == 0 || (message.ValidUntil != nil && !now.Before(*message.ValidUntil)) {
+		return s.updateStatus(ctx, fallback, entity.FallbackStatus_SKIPPED)
+	}
+
+	plan, err := s.decodePlan(fallback)
+	if err != nil {
+		return err
+	}
+
+	step, receivers, policy := getFallbackReceivers(plan, undelivered)
+	if len(receivers) == 0 {
+		return s.updateStatus(ctx, fallback, entity.FallbackStatus_SKIPPED)
+	}
+
+	// 先標記為已觸發，避免排程任務重複執行時重複建立備援訊息
+	result := s.db.WithContext(ctx).
+		Model(&entity.MessageFallback{}).
+		Where("id = ?", fallback.Id).
+		Where("status = ?", entity.FallbackStatus_PENDING).
+		Update("status", entity.FallbackStatus_TRIGGERED)
+	if result.Error != nil {
+		return fmt.Errorf("error updating fallback status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	fallbackMessage, err := s.publish(ctx, &message, plan, step, receivers, policy)
+	if err != nil {
+		if updateErr := s.updateStatus(ctx, fallback, entity.FallbackStatus_FAILED); updateErr != nil {
+			return fmt.Errorf("error updating fallback status: %w", updateErr)
+		}
+		return fmt.Errorf("error publishing fallback message: %w", err)
+	}
+
+	return s.db.WithContext(ctx).
+		Model(&entity.MessageFallback{}).
+		Where("id = ?", fallback.Id).
+		Update("fallback_message_id", fallbackMessage.Id).
+		Error
+}
+
+func (s FallbackService) updateStatus(ctx context.Context, fallback entity.MessageFallback, status entity.FallbackStatus) error {
+	return s.db.WithContext(ctx).
+		Model(&entity.MessageFallback{}).
+		Where("id = ?", fallback.Id).
+		Update("status", status).
+		Error
+}
+
+func (s FallbackService) decodePlan(fallback entity.MessageFallback) (model.FallbackPlan, error) {
+	var plan model.FallbackPlan
+	data, err := s.aesGcm.AesDecrypt(fallback.Data)
+	if err != nil {
+		return plan, fmt.Errorf("error decrypting fallback data: %w", err)
+	}
+	if err := json.Unmarshal([]byte(data), &plan); err != nil {
+		return plan, fmt.Errorf("error decoding fallback data: %w", err)
+	}
+	if len(plan.Steps) == 0 {
+		return plan, fmt.Errorf("fallback %s has no steps", fallback.Id)
+	}
+	return plan, nil
+}
+
+// 備援訊息沿用原訊息的優先級、分類與有效期限；由排程任務建立，不另外計算發送配額
+func (s FallbackService) publish(
+	ctx context.Context,
+	message *entity.Message,
+	plan model.FallbackPlan,
+	step model.FallbackStep,
+	receivers []string,
+	policy *model.FallbackPolicy,
+) (*entity.Message, error) {
+	priority := model.Priority(message.Priority)
+	switch step.Channel {
+	case model.MessageType_SMS:
+		return s.notifyService.PublishSmsMessage(ctx, model.SendSmsRequest{
+			Sms:                   *step.Sms,
+			Receivers:             receivers,
+			Priority:              priority,
+			ValidUntil:            message.ValidUntil,
+			Timezone:              plan.Timezone,
+			Category:              message.Category,
+			ClientId:              plan.ClientId,
+			Fallback:              policy,
+			FallbackFromMessageId: message.Id,
+		})
+	case model.MessageType_MAIL:
+		return s.notifyService.PublishMailMessage(ctx, model.SendMailRequest{
+			Mail:                  *step.Mail,
+			Receivers:             receivers,
+			Priority:              priority,
+			ValidUntil:            message.ValidUntil,
+			Timezone:              plan.Timezone,
+			Category:              message.Category,
+			ClientId:              plan.ClientId,
+			Fallback:              policy,
+			FallbackFromMessageId: message.Id,
+		})
+	case model.MessageType_PUSH:
+		return s.notifyService.PublishPushMessage(ctx, model.SendPushRequest{
+			Push:                  *step.Push,
+			Receivers:             receivers,
+			Priority:              priority,
+			ValidUntil:            message.ValidUntil,
+			Timezone:              plan.Timezone,
+			Category:              message.Category,
+			ClientId:              plan.ClientId,
+			Fallback:              policy,
+			FallbackFromMessageId: message.Id,
+		})
+	case model.MessageType_LINE:
+		return s.notifyService.PublishLineMessage(ctx, model.SendLineRequest{
+			Line:                  *step.Line,
+			Receivers:             receivers,
+			Priority:              priority,
+			ValidUntil:            message.ValidUntil,
+			Timezone:              plan.Timezone,
+			Category:              message.Category,
+			ClientId:              plan.ClientId,
+			Fallback:              policy,
+			FallbackFromMessageId: message.Id,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported fallback channel: %s", step.Channel)
+	}
+}
+
+// 簡訊以送達回報確認結果，其他通道交由發送商後即視為送達
+func getFallbackOutcome(channel entity.MessageType, status entity.TargetStatus) fallbackOutcome {
+	switch status {
+	case entity.TargetStatus_SUCCESS:
+		return fallbackOutcome_DELIVERED
+	case entity.TargetStatus_SENT:
+		if channel == entity.MessageType_SMS {
+			return fallbackOutcome_WAITING
+		}
+		return fallbackOutcome_DELIVERED
+	case entity.TargetStatus_FAILED, entity.TargetStatus_EXPIRED, entity.TargetStatus_SUPPRESSED:
+		return fallbackOutcome_UNDELIVERED
+	case entity.TargetStatus_CANCELED, entity.TargetStatus_DROPPED, entity.TargetStatus_DEFERRED:
+		return fallbackOutcome_EXCLUDED
+	default:
+		return fallbackOutcome_WAITING
+	}
+}
+
+// 依原訊息收件者的順序找出未送達者在下一個通道的收件者，後續通道的收件者同樣依此順序對應
+func getFallbackReceivers(plan model.FallbackPlan, undelivered map[string]bool) (model.FallbackStep, []string, *model.FallbackPolicy) {
+	step := plan.Steps[0]
+	remaining := make([]model.FallbackStep, len(plan.Steps)-1)
+	for i := range remaining {
+		remaining[i] = plan.Steps[i+1]
+		remaining[i].Receivers = nil
+	}
+
+	var receivers []string
+	for i, receiver := range plan.Receivers {
+		if !undelivered[receiver] || i >= len(step.Receivers) || step.Receivers[i] == "" {
+			continue
+		}
+		receivers = append(receivers, step.Receivers[i])
+		for j := range remaining {
+			remaining[j].Receivers = append(remaining[j].Receivers, plan.Steps[j+1].Receivers[i])
+		}
+	}
+
+	if len(remaining) == 0 {
+		return step, receivers, nil
+	}
+	return step, receivers, &model.FallbackPolicy{Steps: remaining}
+}
+
+// 備援通道的收件者依序對應原請求的收件者，延後發送的接收者另建立訊息時依其原本的順序取出對應的收件者
+func getDeferredFallbackPolicy(policy *model.FallbackPolicy, indexes []int) *model.FallbackPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	steps := make([]model.FallbackStep, len(policy.Steps))
+	for i, step := range policy.Steps {
+		steps[i] = step
+		steps[i].Receivers = make([]string, len(indexes))
+		for j, index := range indexes {
+			if index < len(step.Receivers) {
+				steps[i].Receivers[j] = step.Receivers[index]
+			}
+		}
+	}
+	return &model.FallbackPolicy{Steps: steps}
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	model "notify-service/internal/models"
+)
+
+// 延後發送的接收者另建立訊息後，備援通道的收件者仍須對應到同一個接收者
+func TestGetDeferredFallbackPolicy(t *testing.T) {
+	policy := &model.FallbackPolicy{Steps: []model.FallbackStep{
+		{Channel: model.MessageType_SMS, Receivers: []string{"+886900000001", "+886900000002", "", "+886900000004"}},
+		{Channel: model.MessageType_MAIL, Receivers: []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"}},
+	}}
+
+	deferred := getDeferredFallbackPolicy(policy, []int{1, 2, 3})
+	plan := model.FallbackPlan{
+		Receivers: []string{"token-b", "token-c", "token-d"},
+		Steps:     deferred.Steps,
+	}
+
+	step, receivers, remaining := getFallbackReceivers(plan, map[string]bool{"token-b": true, "token-c": true, "token-d": true})
+	if step.Channel != model.MessageType_SMS {
+		t.Fatalf("channel = %s, want %s", step.Channel, model.MessageType_SMS)
+	}
+	if want := []string{"+886900000002", "+886900000004"}; !reflect.DeepEqual(receivers, want) {
+		t.Fatalf("receivers = %v, want %v", receivers, want)
+	}
+	if want := []string{"b@example.com", "d@example.com"}; remaining == nil || !reflect.DeepEqual(remaining.Steps[0].Receivers, want) {
+		t.Fatalf("remaining = %+v, want receivers %v", remaining, want)
+	}
+
+	// 原備援設定不受影響
+	if got := policy.Steps[0].Receivers[0]; got != "+886900000001" {
+		t.Fatalf("original policy changed: %s", got)
+	}
+	if getDeferredFallbackPolicy(nil, []int{0}) != nil {
+		t.Fatal("expected nil policy")
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	shared "notify-service/internal"
+	entity "notify-service/internal/entities"
+	util "notify-service/internal/utils"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 不計入發送頻率的接收者狀態
+var frequencyCapExcludedStatuses = []entity.TargetStatus{
+	entity.TargetStatus_FAILED,
+	entity.TargetStatus_CANCELED,
+	entity.TargetStatus_EXPIRED,
+	entity.TargetStatus_SUPPRESSED,
+	entity.TargetStatus_DROPPED,
+	entity.TargetStatus_DEFERRED,
+}
+
+type FrequencyCapService struct {
+	BaseService
+	db     *gorm.DB
+	config *shared.Config
+}
+
+func NewFrequencyCapService(
+	db *gorm.DB,
+	config *shared.Config,
+) *FrequencyCapService {
+	return &FrequencyCapService{
+		db:     db,
+		config: config,
+	}
+}
+
+// FrequencyCapRequest 計算發送頻率上限所需的發送資訊
+type FrequencyCapRequest struct {
+	Channel        entity.MessageType
+	Category       string
+	Priority       entity.MessagePriority
+	ReceiverHashes []string
+	SendAt         time.Time
+}
+
+// FrequencyCapDecision 超過發送頻率上限的接收者處理方式
+type FrequencyCapDecision struct {
+	Action     entity.FrequencyCapAction
+	Reason     string
+	DeferUntil time.Time
+}
+
+// Evaluate 依接收者雜湊值回傳超過發送頻率上限的處理方式，未超過上限的接收者不在結果中。
+// 同時超過多筆規則時以不發送優先，延後時取最晚的時間
+func (s FrequencyCapService) Evaluate(ctx context.Context, in FrequencyCapRequest) (map[string]FrequencyCapDecision, error) {
+	decisions := make(map[string]FrequencyCapDecision)
+
+	// 高優先級（OTP、交易通知）不受發送頻率上限限制
+	if in.Priority == entity.MessagePriority_HIGH || len(in.ReceiverHashes) == 0 {
+		return decisions, nil
+	}
+
+	rules, err := s.getRules(ctx, in.Channel, in.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.MaxCount <= 0 || rule.WindowSeconds <= 0 {
+			continue
+		}
+
+		window := time.Duration(rule.WindowSeconds) * time.Second
+		sentAts, err := s.getRecentSentAts(ctx, rule, in.Channel, in.ReceiverHashes, in.SendAt.Add(-window), in.SendAt)
+		if err != nil {
+			return nil, err
+		}
+
+		for receiverHash, times := range sentAts {
+			if len(times) < rule.MaxCount {
+				continue
+			}
+
+			decision := FrequencyCapDecision{
+				Action: rule.Action,
+				Reason: fmt.Sprintf("frequency cap %s exceeded: %d/%d in %ds", rule.Name, len(times), rule.MaxCount, rule.WindowSeconds),
+			}
+			if rule.Action == entity.FrequencyCapAction_DEFER {
+				// 待窗口內最早的幾則移出後，數量才會低於上限
+				decision.DeferUntil = times[len(times)-rule.MaxCount].Add(window)
+			}
+
+			decisions[receiverHash] = mergeFrequencyCapDecision(decisions[receiverHash], decision)
+		}
+	}
+	return decisions, nil
+}
+
+func mergeFrequencyCapDecision(current FrequencyCapDecision, next FrequencyCapDecision) FrequencyCapDecision {
+	switch {
+	case current.Action == "":
+		return next
+	case current.Action == entity.FrequencyCapAction_DROP:
+		return current
+	case next.Action == entity.FrequencyCapAction_DROP || next.DeferUntil.After(current.DeferUntil):
+		return next
+	default:
+		return current
+	}
+}
+
+func (s FrequencyCapService) getRules(
+	ctx context.Context,
+	channel entity.MessageType,
+	category string,
+) ([]*entity.FrequencyCapRule, error) {
+	var rules []*entity.FrequencyCapRule
+	err := s.db.WithContext(ctx).
+		Where("enabled = ?", true).
+		Where("channel IS NULL OR channel = ?", channel).
+		Where("category IS NULL OR category = ?", category).
+		Find(&rules).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// 依接收者雜湊值取得窗口內同通道的發送時間，依時間遞增排序。
+// 發送時間為預約時間（未預約時為建立時間），已預約且發送時間落在窗口內的訊息一併計入
+func (s FrequencyCapService) getRecentSentAts(
+	ctx context.Context,
+	rule *entity.FrequencyCapRule,
+	channel entity.MessageType,
+	receiverHashes []string,
+	startAt time.Time,
+	endAt time.Time,
+) (map[string][]time.Time, error) {
+	// 預約訊息最早於 ScheduleLimitDays 天前建立，勿擾時段延後最長 1 天，加上建立時間範圍避免全表搜尋
+	createdAfter := startAt.Add(-time.Duration(s.config.ScheduleLimitDays+1) * 24 * time.Hour)
+
+	sentAts := make(map[string][]time.Time)
+	for _, chunk := range util.ChunkArray(receiverHashes, dbBatchSize) {
+		var rows []struct {
+			ReceiverHash string
+			SentAt       time.Time
+		}
+
+		query := s.db.WithContext(ctx).
+			Table("notify.targets AS t").
+			Select("t.receiver_hash, COALESCE(m.scheduled_at, m.created_at) AS sent_at").
+			Joins("JOIN notify.messages AS m ON m.id = t.message_id").
+			Where("m.type = ?", channel).
+			Where("t.receiver_hash IN ?", chunk).
+			Where("COALESCE(m.scheduled_at, m.created_at) > ? AND COALESCE(m.scheduled_at, m.created_at) <= ?", startAt, endAt).
+			Where("t.status NOT IN ?", frequencyCapExcludedStatuses).
+			Where("t.deleted_at IS NULL").
+			// 訊息與接收者同時建立，發送時間不早於建立時間
+			Where("t.created_at > ? AND t.created_at <= ?", createdAfter, endAt).
+			Where("m.created_at > ? AND m.created_at <= ?", createdAfter, endAt)
+
+		if rule.Category != nil {
+			query = query.Where("m.category = ?", *rule.Category)
+		}
+
+		if err := query.Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			sentAts[row.ReceiverHash] = append(sentAts[row.ReceiverHash], row.SentAt)
+		}
+	}
+	for _, times := range sentAts {
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	}
+	return sentAts, nil
+}
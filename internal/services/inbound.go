@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InboundService struct {
+	BaseService
+	db                 *gorm.DB
+	config             *shared.Config
+	snowflake          *snowflake.Node
+	aesGcm             *component.AesGcm
+	suppressionService *SuppressionService
+
+	// 依語系設定的退訂關鍵字與確認簡訊內容
+	optOutKeywords      map[string][]string
+	optOutConfirmations map[string]string
+}
+
+func NewInboundService(
+	db *gorm.DB,
+	config *shared.Config,
+	snowflake *snowflake.Node,
+	aesGcm *component.AesGcm,
+	suppressionService *SuppressionService,
+) (*InboundService, error) {
+	optOutKeywords := make(map[string][]string)
+	if config.SmsOptOutKeywords != "" {
+		if err := json.Unmarshal([]byte(config.SmsOptOutKeywords), &optOutKeywords); err != nil {
+			return nil, fmt.Errorf("invalid sms opt-out keywords: %w", err)
+		}
+	}
+
+	optOutConfirmations := make(map[string]string)
+	if config.SmsOptOutConfirmationTemplates != "" {
+		if err := json.Unmarshal([]byte(config.SmsOptOutConfirmationTemplates), &optOutConfirmations); err != nil {
+			return nil, fmt.Errorf("invalid sms opt-out confirmation templates: %w", err)
+		}
+	}
+
+	return &InboundService{
+		db:                  db,
+		config:              config,
+		snowflake:           snowflake,
+		aesGcm:              aesGcm,
+		suppressionService:  suppressionService,
+		optOutKeywords:      optOutKeywords,
+		optOutConfirmations: optOutConfirmations,
+	}, nil
+}
+
+// InboundSms 發送商回呼的上行簡訊
+type InboundSms struct {
+	Provider          entity.Provider
+	ProviderMessageId string
+	Sender            string
+	Recipient         string
+	Body              string
+	ReceivedAt        time.Time
+}
+
+// HandleInboundSms 儲存上行簡訊，符合退訂關鍵字時將回覆者加入抑制名單。
+// 發送商重送同一則上行簡訊時回傳 false，呼叫端不應重複發送確認簡訊
+func (s InboundService) HandleInboundSms(ctx context.Context, in InboundSms) (*entity.InboundMessage, bool, error) {
+	senderEncrypted, err := s.aesGcm.AesEncrypt(in.Sender)
+	if err != nil {
+		return nil, false, err
+	}
+
+	keyword, language := s.matchOptOutKeyword(in.Body)
+	inbound := &entity.InboundMessage{
+		Id:                 s.snowflake.Generate().String(),
+		Provider:           in.Provider,
+		ProviderMessageId:  in.ProviderMessageId,
+		Sender:             senderEncrypted,
+		SenderHash:         util.Md5(in.Sender),
+		Recipient:          in.Recipient,
+		Body:               in.Body,
+		Keyword:            keyword,
+		Language:           language,
+		Action:             entity.InboundAction_NONE,
+		ConfirmationStatus: entity.ConfirmationStatus_SKIPPED,
+		ReceivedAt:         in.ReceivedAt,
+		CreatedAt:          time.Now(),
+	}
+	if keyword != "" {
+		inbound.Action = entity.InboundAction_OPT_OUT
+	}
+
+	result := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(inbound)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		log.WithContext(ctx).Infof("inbound sms %s from %s already handled", in.ProviderMessageId, in.Provider)
+		return inbound, false, nil
+	}
+
+	if inbound.Action == entity.InboundAction_OPT_OUT {
+		_, err := s.suppressionService.SuppressReceiver(
+			ctx,
+			entity.MessageType_SMS,
+			in.Sender,
+			entity.SuppressionReason_OPT_OUT,
+			string(in.Provider),
+			nil,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return inbound, true, nil
+}
+
+// GetOptOutConfirmation 回傳退訂確認簡訊內容，未啟用或該語系未設定時回傳空字串
+func (s InboundService) GetOptOutConfirmation(inbound *entity.InboundMessage) string {
+	if !s.config.SmsOptOutConfirmationEnabled || inbound.Action != entity.InboundAction_OPT_OUT {
+		return ""
+	}
+	return s.optOutConfirmations[inbound.Language]
+}
+
+func (s InboundService) UpdateConfirmationStatus(ctx context.Context, id string, status entity.ConfirmationStatus) error {
+	return s.db.WithContext(ctx).
+		Model(&entity.InboundMessage{}).
+		Where("id = ?", id).
+		Update("confirmation_status", status).
+		Error
+}
+
+func (s InboundService) ListInboundMessagesWithPaging(
+	ctx context.Context,
+	in model.ListInboundMessagesWithPagingRequest,
+) ([]*entity.InboundMessage, int64, error) {
+	var inbounds []*entity.InboundMessage
+
+	query := s.db.WithContext(ctx).
+		Model(&entity.InboundMessage{}).
+		Where("received_at BETWEEN ? AND ?", in.StartAt, in.EndAt)
+
+	if in.Sender != "" {
+		query = query.Where("sender_hash = ?", util.Md5(in.Sender))
+	}
+
+	var total int64
+	query.Count(&total)
+
+	// 排序欄位已於請求驗證限制在允許的欄位內
+	if in.SortField != "" && in.SortOrder != "" {
+		query = query.Order(fmt.Sprintf("%s %s", in.SortField, in.SortOrder))
+	}
+
+	if in.Page.Index > 0 && in.Page.Size > 0 {
+		query = query.Offset(int((in.Page.Index - 1) * in.Page.Size)).Limit(int(in.Page.Size))
+	}
+	err := query.Find(&inbounds).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return inbounds, total, nil
+}
+
+// 整則簡訊為關鍵字，或以關鍵字開頭後接空白時視為符合，英文不分大小寫
+func (s InboundService) matchOptOutKeyword(body string) (string, string) {
+	normalized := strings.ToUpper(strings.TrimSpace(body))
+	for language, keywords := range s.optOutKeywords {
+		for _, keyword := range keywords {
+			keyword = strings.ToUpper(strings.TrimSpace(keyword))
+			if keyword == "" {
+				continue
+			}
+			if normalized == keyword || strings.HasPrefix(normalized, keyword+" ") {
+				return keyword, language
+			}
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	liner "notify-service/internal/liner"
+	model "notify-service/internal/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type LineService struct {
+	config   *shared.Config
+	provider liner.LineProvider
+	aesGcm   *component.AesGcm
+}
+
+func NewLineService(
+	config *shared.Config,
+	provider liner.LineProvider,
+	aesGcm *component.AesGcm,
+) *LineService {
+	return &LineService{
+		config:   config,
+		provider: provider,
+		aesGcm:   aesGcm,
+	}
+}
+
+func (s *LineService) SendLine(ctx context.Context, targets []entity.Target, message entity.Message) liner.LineBatchResponse {
+	requestReceivers := make([]liner.LineReceiver, 0, len(targets))
+	for _, target := range targets {
+		userId, err := s.aesGcm.AesDecrypt(target.Receiver)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to decrypt receiver with target id %s", target.Id)
+			continue
+		}
+		requestReceivers = append(requestReceivers, liner.LineReceiver{
+			TargetId: target.Id,
+			UserId:   userId,
+		})
+	}
+
+	// 未設定 LINE 發送商時所有使用者皆視為發送失敗
+	if s.provider == nil {
+		return newFailedLineBatchResponse(message.Id, requestReceivers, "LINE_PROVIDER_NOT_CONFIGURED")
+	}
+
+	var content model.LineContent
+	if err := json.Unmarshal([]byte(message.Data), &content); err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("invalid line content with message id %s", message.Id)
+		return newFailedLineBatchResponse(message.Id, requestReceivers, "INVALID_LINE_CONTENT")
+	}
+
+	request := liner.LineBatchRequest{
+		Receivers: requestReceivers,
+		Message: liner.LineMessage{
+			MessageId:    message.Id,
+			Text:         content.Text,
+			AltText:      content.AltText,
+			FlexContents: content.FlexContents,
+		},
+	}
+	return s.provider.SendBatchLine(ctx, request)
+}
+
+func newFailedLineBatchResponse(messageId string, receivers []liner.LineReceiver, reason string) liner.LineBatchResponse {
+	providerResponse, _ := json.Marshal(map[string]interface{}{
+		"StatusMessage": reason,
+	})
+
+	lineResponses := make([]liner.LineResponse, 0, len(receivers))
+	for _, receiver := range receivers {
+		lineResponses = append(lineResponses, liner.LineResponse{
+			Status:           string(liner.LineStatus_FAILED),
+			TraceId:          receiver.TargetId,
+			ProviderResponse: string(providerResponse),
+		})
+	}
+	return liner.LineBatchResponse{
+		Status:       string(liner.LineStatus_FAILED),
+		MessageId:    messageId,
+		LineResponse: lineResponses,
+	}
+}
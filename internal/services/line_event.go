@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	entity "notify-service/internal/entities"
+	util "notify-service/internal/utils"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// LineEventService 處理 LINE webhook 回報的送達與加入、封鎖好友事件
+type LineEventService struct {
+	db                 *gorm.DB
+	snowflake          *snowflake.Node
+	suppressionService *SuppressionService
+}
+
+func NewLineEventService(
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+	suppressionService *SuppressionService,
+) *LineEventService {
+	return &LineEventService{
+		db:                 db,
+		snowflake:          snowflake,
+		suppressionService: suppressionService,
+	}
+}
+
+// HandleDelivery 依送達事件的 delivery.data 找出對應的接收者並標記為 success；multicast 的接收者共用相同請求 ID，一併更新
+func (s LineEventService) HandleDelivery(ctx context.Context, providerTraceId string, data json.RawMessage) error {
+	var targets []entity.Target
+	err := s.db.WithContext(ctx).
+		Where("provider = ?", entity.Provider_LINE).
+		Where("provider_trace_id = ?", providerTraceId).
+		Where("status = ?", entity.TargetStatus_SENT).
+		Find(&targets).
+		Error
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		queueIds := make(map[string]bool)
+		for _, target := range targets {
+			// 僅更新仍為已發送的目標，避免重複回報覆蓋其他來源已寫入的狀態
+			result := tx.Model(&entity.Target{}).
+				Where("id = ?", target.Id).
+				Where("status = ?", entity.TargetStatus_SENT).
+				Update("status", entity.TargetStatus_SUCCESS)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 || queueIds[target.QueueId] {
+				continue
+			}
+			queueIds[target.QueueId] = true
+
+			event := entity.Event{
+				Id:              s.snowflake.Generate().String(),
+				Provider:        string(entity.Provider_LINE),
+				Status:          entity.EventStatus_DELIVERED,
+				ProviderTraceId: providerTraceId,
+				QueueId:         target.QueueId,
+				Data:            data,
+				CreatedAt:       time.Now(),
+			}
+			if err := tx.Create(&event).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// HandleUnfollow 使用者封鎖官方帳號後無法再收到訊息，加入抑制名單
+func (s LineEventService) HandleUnfollow(ctx context.Context, userId string) error {
+	_, err := s.suppressionService.SuppressReceiver(
+		ctx,
+		entity.MessageType_LINE,
+		userId,
+		entity.SuppressionReason_OPT_OUT,
+		string(entity.Provider_LINE),
+		nil,
+	)
+	return err
+}
+
+// HandleFollow 使用者重新加入好友時移除退訂造成的抑制，其他原因加入的抑制維持不變
+func (s LineEventService) HandleFollow(ctx context.Context, userId string) error {
+	return s.db.WithContext(ctx).
+		Where("channel = ?", entity.MessageType_LINE).
+		Where("receiver_hash = ?", util.Md5(userId)).
+		Where("reason = ?", entity.SuppressionReason_OPT_OUT).
+		Delete(&entity.Suppression{}).
+		Error
+}
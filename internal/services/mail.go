@@ -37,7 +37,21 @@ func (s *MailService) SendEmail(ctx context.Context, targets []entity.Target, me
 			continue
 		}
 		requestReceviers[i] = mailer.MailReceiver{
-			Email: recevier,
+			TargetId: target.Id,
+			Email:    recevier,
+		}
+
+		variables, err := decodeTargetVariables(s.aesGcm, target)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to decode variables with target id %s", target.Id)
+			continue
+		}
+		if len(variables) > 0 {
+			substitutions := make(map[string]string, len(variables))
+			for key, value := range variables {
+				substitutions["{"+key+"}"] = value
+			}
+			requestReceviers[i].Substitutions = substitutions
 		}
 	}
 
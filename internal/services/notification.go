@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	errorpb "proto/pkg/notify/v1/error"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// NotificationService 多通道通知，各通道以原有的發送流程建立訊息，並以通知 ID 彙整查詢與取消
+type NotificationService struct {
+	BaseService
+	db            *gorm.DB
+	snowflake     *snowflake.Node
+	notifyService *NotifyService
+}
+
+func NewNotificationService(
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+	notifyService *NotifyService,
+) *NotificationService {
+	return &NotificationService{
+		db:            db,
+		snowflake:     snowflake,
+		notifyService: notifyService,
+	}
+}
+
+// NotificationDetail 通知與各通道訊息的明細及彙整狀態
+type NotificationDetail struct {
+	Notification   *entity.Notification
+	Status         entity.NotificationStatus
+	TargetCounts   map[entity.TargetStatus]int64
+	Messages       []*MessageDetail
+	FailedChannels []entity.MessageType
+}
+
+// NotificationChannelResult 通知各通道的建立結果，建立失敗時 Message 為空
+type NotificationChannelResult struct {
+	Channel entity.MessageType
+	Message *entity.Message
+	Err     error
+}
+
+// PublishNotification 建立通知後依序發送簡訊、郵件、推播與 LINE 訊息，回傳各通道的建立結果供呼叫端退還發送失敗通道的配額；
+// 所有通道皆失敗時刪除通知並回傳第一個錯誤
+func (s NotificationService) PublishNotification(ctx context.Context, in model.SendNotificationRequest) (*entity.Notification, []NotificationChannelResult, error) {
+	notification := &entity.Notification{
+		Id:          s.snowflake.Generate().String(),
+		Category:    in.Category,
+		ScheduledAt: in.ScheduledAt,
+		CreatedAt:   time.Now(),
+	}
+
+	err := s.db.WithContext(ctx).Create(notification).Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("create notification failed with db create query: %v", err))
+		return nil, nil, s.ServerError("create notification failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	type publisher struct {
+		channel entity.MessageType
+		publish func() (*entity.Message, error)
+	}
+	publishers := make([]publisher, 0, 4)
+	if in.Sms != nil {
+		publishers = append(publishers, publisher{entity.MessageType_SMS, func() (*entity.Message, error) {
+			return s.notifyService.PublishSmsMessage(ctx, model.SendSmsRequest{
+				Sms:            *in.Sms,
+				Receivers:      in.SmsReceivers,
+				ScheduledAt:    in.ScheduledAt,
+				Priority:       in.Priority,
+				ValidUntil:     in.ValidUntil,
+				Timezone:       in.Timezone,
+				Category:       in.Category,
+				ClientId:       in.ClientId,
+				NotificationId: notification.Id,
+			})
+		}})
+	}
+	if in.Mail != nil {
+		publishers = append(publishers, publisher{entity.MessageType_MAIL, func() (*entity.Message, error) {
+			return s.notifyService.PublishMailMessage(ctx, model.SendMailRequest{
+				Mail:           *in.Mail,
+				Receivers:      in.MailReceivers,
+				ScheduledAt:    in.ScheduledAt,
+				Priority:       in.Priority,
+				ValidUntil:     in.ValidUntil,
+				Timezone:       in.Timezone,
+				Category:       in.Category,
+				ClientId:       in.ClientId,
+				NotificationId: notification.Id,
+			})
+		}})
+	}
+	if in.Push != nil {
+		publishers = append(publishers, publisher{entity.MessageType_PUSH, func() (*entity.Message, error) {
+			return s.notifyService.PublishPushMessage(ctx, model.SendPushRequest{
+				Push:           *in.Push,
+				Receivers:      in.DeviceTokens,
+				ScheduledAt:    in.ScheduledAt,
+				Priority:       in.Priority,
+				ValidUntil:     in.ValidUntil,
+				Timezone:       in.Timezone,
+				Category:       in.Category,
+				ClientId:       in.ClientId,
+				NotificationId: notification.Id,
+			})
+		}})
+	}
+	if in.Line != nil {
+		publishers = append(publishers, publisher{entity.MessageType_LINE, func() (*entity.Message, error) {
+			return s.notifyService.PublishLineMessage(ctx, model.SendLineRequest{
+				Line:           *in.Line,
+				Receivers:      in.LineUserIds,
+				ScheduledAt:    in.ScheduledAt,
+				Priority:       in.Priority,
+				ValidUntil:     in.ValidUntil,
+				Timezone:       in.Timezone,
+				Category:       in.Category,
+				ClientId:       in.ClientId,
+				NotificationId: notification.Id,
+			})
+		}})
+	}
+
+	// 已有通道訊息建立時不中斷，避免呼叫端重送造成重複發送，失敗的通道記錄於通知並計入彙整狀態
+	results := make([]NotificationChannelResult, 0, len(publishers))
+	var failedChannels []string
+	var firstErr error
+	for _, publisher := range publishers {
+		message, err := publisher.publish()
+		results = append(results, NotificationChannelResult{Channel: publisher.channel, Message: message, Err: err})
+		if err == nil {
+			log.WithContext(ctx).Infof("notification %s published %s message %s", notification.Id, message.Type, message.Id)
+			continue
+		}
+
+		log.WithContext(ctx).Error(fmt.Sprintf("publish notification %s %s message failed: %v", notification.Id, publisher.channel, err))
+		failedChannels = append(failedChannels, string(publisher.channel))
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if len(failedChannels) == len(publishers) {
+		if err := s.db.WithContext(ctx).Delete(notification).Error; err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("delete notification %s failed with db delete query: %v", notification.Id, err))
+		}
+		return nil, nil, firstErr
+	}
+
+	if len(failedChannels) > 0 {
+		notification.FailedChannels = strings.Join(failedChannels, ",")
+		err := s.db.WithContext(ctx).
+			Model(notification).
+			Update("failed_channels", notification.FailedChannels).
+			Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("update notification %s failed channels failed with db update query: %v", notification.Id, err))
+		}
+	}
+	return notification, results, nil
+}
+
+// CancelNotification 取消通知中所有尚未發送的通道訊息，任一通道取消失敗時回傳第一個錯誤，可重新呼叫取消其餘通道
+func (s NotificationService) CancelNotification(ctx context.Context, in model.CancelNotificationRequest) error {
+	notification, err := s.getNotification(ctx, in.NotificationId)
+	if err != nil {
+		return err
+	}
+
+	messages, err := s.getNotificationMessages(ctx, notification, false)
+	if err != nil {
+		return err
+	}
+
+	canceled := 0
+	var cancelErr error
+	for _, message := range messages {
+		// 僅預約中或交由發送商預約的訊息可取消
+		if message.Status != entity.MessageStatus_SCHEDULED &&
+			!(message.ProviderScheduled && message.Status == entity.MessageStatus_SENT) {
+			continue
+		}
+
+		if err := s.notifyService.CancelScheduledByMessageId(ctx, message.Id); err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("cancel notification %s message %s failed: %v", notification.Id, message.Id, err))
+			if cancelErr == nil {
+				cancelErr = err
+			}
+			continue
+		}
+		canceled++
+	}
+
+	if cancelErr != nil {
+		return cancelErr
+	}
+	if canceled == 0 {
+		return s.ServerError("notification has no scheduled message", errorpb.ErrorReasonCode_ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL)
+	}
+	return nil
+}
+
+// GetNotification 查詢通知，包含已取消的通道訊息
+func (s NotificationService) GetNotification(ctx context.Context, in model.GetNotificationRequest) (*NotificationDetail, error) {
+	notification, err := s.getNotification(ctx, in.NotificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.getNotificationMessages(ctx, notification, true)
+	if err != nil {
+		return nil, err
+	}
+
+	failedChannels := getNotificationFailedChannels(notification)
+	startAt, endAt := getNotificationTimeRange(notification)
+	detail := &NotificationDetail{
+		Notification:   notification,
+		Status:         getNotificationStatus(messages, len(failedChannels)),
+		TargetCounts:   make(map[entity.TargetStatus]int64),
+		Messages:       make([]*MessageDetail, 0, len(messages)),
+		FailedChannels: failedChannels,
+	}
+	for _, message := range messages {
+		messageDetail, err := s.notifyService.getMessageDetail(ctx, message, startAt, endAt)
+		if err != nil {
+			return nil, err
+		}
+		for status, count := range messageDetail.TargetCounts {
+			detail.TargetCounts[status] += count
+		}
+		detail.Messages = append(detail.Messages, messageDetail)
+	}
+	return detail, nil
+}
+
+func (s NotificationService) getNotification(ctx context.Context, notificationId string) (*entity.Notification, error) {
+	createdAt, err := util.ConvertSnowflakeToTime(notificationId)
+	if err != nil {
+		return nil, s.NotFoundError("notification not found", errorpb.ErrorReasonCode_ERR_NOTIFY_NOTIFICATION_NOT_FOUND)
+	}
+
+	// 避免全表搜尋加上時間戳範圍
+	var notification entity.Notification
+	err = s.db.WithContext(ctx).
+		Where("id = ?", notificationId).
+		Where("created_at between ? and ?", createdAt.Add(-1*time.Hour), createdAt.Add(1*time.Hour)).
+		First(&notification).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.NotFoundError("notification not found", errorpb.ErrorReasonCode_ERR_NOTIFY_NOTIFICATION_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get notification failed with db query: %v", err))
+		return nil, s.ServerError("get notification failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return &notification, nil
+}
+
+// 查詢通知的通道訊息，unscoped 為 true 時包含已取消（軟刪除）的訊息
+func (s NotificationService) getNotificationMessages(ctx context.Context, notification *entity.Notification, unscoped bool) ([]*entity.Message, error) {
+	startAt, endAt := getNotificationTimeRange(notification)
+	query := s.db.WithContext(ctx)
+	if unscoped {
+		query = query.Unscoped()
+	}
+
+	var messages []*entity.Message
+	err := query.
+		Where("notification_id = ?", notification.Id).
+		Where("created_at between ? and ?", startAt, endAt).
+		Order("created_at").
+		Find(&messages).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get notification messages failed with db query: %v", err))
+		return nil, s.ServerError("get notification messages failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return messages, nil
+}
+
+// 通道訊息與通知同時建立，以通知的建立時間加上時間戳範圍查詢
+func getNotificationTimeRange(notification *entity.Notification) (time.Time, time.Time) {
+	return notification.CreatedAt.Add(-1 * time.Hour), notification.CreatedAt.Add(1 * time.Hour)
+}
+
+// 通知建立訊息失敗的通道以逗號分隔保存
+func getNotificationFailedChannels(notification *entity.Notification) []entity.MessageType {
+	if notification.FailedChannels == "" {
+		return nil
+	}
+	channels := strings.Split(notification.FailedChannels, ",")
+	failedChannels := make([]entity.MessageType, len(channels))
+	for i, channel := range channels {
+		failedChannels[i] = entity.MessageType(channel)
+	}
+	return failedChannels
+}
+
+// 依各通道訊息的狀態彙整通知狀態：仍有訊息處理中或預約中時為處理中或預約中，
+// 皆已結束時依已發送的訊息數量判斷全部發送、部分發送或發送失敗，建立失敗的通道視為未發送
+func getNotificationStatus(messages []*entity.Message, failed int) entity.NotificationStatus {
+	var active, scheduled, sent, canceled int
+	for _, message := range messages {
+		switch message.Status {
+		case entity.MessageStatus_PENDING, entity.MessageStatus_ENQUEUED:
+			active++
+		case entity.MessageStatus_SCHEDULED:
+			active++
+			scheduled++
+		case entity.MessageStatus_SENT:
+			sent++
+		case entity.MessageStatus_CANCELED:
+			canceled++
+		}
+	}
+
+	switch {
+	case len(messages) > 0 && canceled == len(messages) && failed == 0:
+		return entity.NotificationStatus_CANCELED
+	case active > 0 && scheduled == len(messages)-canceled:
+		return entity.NotificationStatus_SCHEDULED
+	case active > 0:
+		return entity.NotificationStatus_PROCESSING
+	case sent == 0:
+		return entity.NotificationStatus_FAILED
+	case sent == len(messages)+failed:
+		return entity.NotificationStatus_SENT
+	default:
+		return entity.NotificationStatus_PARTIALLY_SENT
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	entity "notify-service/internal/entities"
+)
+
+// 建立失敗的通道視為未發送，其餘通道皆已發送時為部分發送
+func TestGetNotificationStatus(t *testing.T) {
+	sent := &entity.Message{Status: entity.MessageStatus_SENT}
+	failed := &entity.Message{Status: entity.MessageStatus_FAILED}
+	scheduled := &entity.Message{Status: entity.MessageStatus_SCHEDULED}
+	canceled := &entity.Message{Status: entity.MessageStatus_CANCELED}
+
+	tests := []struct {
+		name     string
+		messages []*entity.Message
+		failed   int
+		want     entity.NotificationStatus
+	}{
+		{"all sent", []*entity.Message{sent, sent}, 0, entity.NotificationStatus_SENT},
+		{"sent with failed channel", []*entity.Message{sent}, 1, entity.NotificationStatus_PARTIALLY_SENT},
+		{"sent with failed message", []*entity.Message{sent, failed}, 0, entity.NotificationStatus_PARTIALLY_SENT},
+		{"failed with failed channel", []*entity.Message{failed}, 1, entity.NotificationStatus_FAILED},
+		{"scheduled", []*entity.Message{scheduled, scheduled}, 0, entity.NotificationStatus_SCHEDULED},
+		{"all canceled", []*entity.Message{canceled}, 0, entity.NotificationStatus_CANCELED},
+		{"canceled with failed channel", []*entity.Message{canceled}, 1, entity.NotificationStatus_FAILED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getNotificationStatus(tt.messages, tt.failed); got != tt.want {
+				t.Fatalf("status = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	component "notify-service/internal/components"
 	entity "notify-service/internal/entities"
 	model "notify-service/internal/models"
+	smser "notify-service/internal/smser"
 	util "notify-service/internal/utils"
 	errorpb "proto/pkg/notify/v1/error"
 	"sync"
@@ -20,6 +21,12 @@ import (
 	"gorm.io/gorm"
 )
 
+// 每次呼叫發送商取消預約的訊息 ID 數量
+const smsCancelBatchSize = 100
+
+// 排程任務產生的事件來源，例如預約訊息逾期
+const SCHEDULER_EVENT_PROVIDER = "scheduler"
+
 type NotifyService struct {
 	BaseService
 	db        *gorm.DB
@@ -27,6 +34,12 @@ type NotifyService struct {
 	snowflake *snowflake.Node
 	aesGcm    *component.AesGcm
 	producer  sarama.SyncProducer
+
+	suppressionService  *SuppressionService
+	quietHoursService   *QuietHoursService
+	frequencyCapService *FrequencyCapService
+	phoneNumber         *component.PhoneNumber
+	smsCanceler         smser.SmsCanceler
 }
 
 func NewNotifyService(
@@ -35,23 +48,211 @@ func NewNotifyService(
 	snowflake *snowflake.Node,
 	aesGcm *component.AesGcm,
 	producer sarama.SyncProducer,
+	suppressionService *SuppressionService,
+	quietHoursService *QuietHoursService,
+	frequencyCapService *FrequencyCapService,
+	phoneNumber *component.PhoneNumber,
+	smsCanceler smser.SmsCanceler,
 ) *NotifyService {
 	return &NotifyService{
-		db:        db,
-		config:    config,
-		snowflake: snowflake,
-		aesGcm:    aesGcm,
-		producer:  producer,
+		db:                  db,
+		config:              config,
+		snowflake:           snowflake,
+		aesGcm:              aesGcm,
+		producer:            producer,
+		suppressionService:  suppressionService,
+		quietHoursService:   quietHoursService,
+		frequencyCapService: frequencyCapService,
+		phoneNumber:         phoneNumber,
+		smsCanceler:         smsCanceler,
 	}
 }
 
 func (s NotifyService) PublishSmsMessage(ctx context.Context, in model.SendSmsRequest) (*entity.Message, error) {
 	message := &entity.Message{
-		Id:        s.snowflake.Generate().String(),
-		Type:      entity.MessageType_SMS,
-		Data:      in.Sms.Body,
-		CreatedAt: time.Now(),
-		Status:    entity.MessageStatus_PENDING,
+		Id:                    s.snowflake.Generate().String(),
+		Type:                  entity.MessageType_SMS,
+		Data:                  in.Sms.Body,
+		CreatedAt:             time.Now(),
+		Status:                entity.MessageStatus_PENDING,
+		Priority:              entity.MessagePriority(in.Priority),
+		Category:              in.Category,
+		ValidUntil:            in.ValidUntil,
+		FallbackFromMessageId: in.FallbackFromMessageId,
+		NotificationId:        in.NotificationId,
+	}
+
+	if in.ScheduledAt != nil {
+		message.ScheduledAt = in.ScheduledAt
+		message.Status = entity.MessageStatus_SCHEDULED
+	}
+
+	return s.publishMessage(ctx, message, publishRequest{
+		clientId:         in.ClientId,
+		timezone:         in.Timezone,
+		receivers:        in.Receivers,
+		variables:        in.ReceiverVariables,
+		skipFrequencyCap: in.SkipFrequencyCap,
+		fallback:         in.Fallback,
+	})
+}
+
+// 預約時間距今超過設定時數的簡訊交由發送商預約發送，立即加入佇列而不等待排程任務；
+// 僅在所有接收者皆由支援取消預約的發送商發送時使用，國際門號改由其他發送商發送時仍由排程任務發送
+func (s NotifyService) isProviderScheduled(message *entity.Message, receivers []string) bool {
+	if s.config.SmsProviderScheduleMinHours <= 0 || s.smsCanceler == nil || message.ScheduledAt == nil {
+		return false
+	}
+	minHours := time.Duration(s.config.SmsProviderScheduleMinHours) * time.Hour
+	if time.Until(*message.ScheduledAt) < minHours {
+		return false
+	}
+
+	for _, receiver := range receivers {
+		if s.getSmsProvider(receiver) != entity.Provider(s.config.SmsProvider) {
+			return false
+		}
+	}
+	return true
+}
+
+// 依門號地區回傳實際發送的簡訊發送商，與 worker 的 RoutingSmser 規則一致
+func (s NotifyService) getSmsProvider(receiver string) entity.Provider {
+	international := s.config.SmsInternationalProvider
+	if international != "" && international != s.config.SmsProvider && !s.phoneNumber.IsDomestic(receiver) {
+		return entity.Provider(international)
+	}
+	return entity.Provider(s.config.SmsProvider)
+}
+
+func (s NotifyService) PublishMailMessage(ctx context.Context, in model.SendMailRequest) (*entity.Message, error) {
+	message := &entity.Message{
+		Id:                    s.snowflake.Generate().String(),
+		Type:                  entity.MessageType_MAIL,
+		Data:                  in.Mail.Body,
+		SenderName:            in.Mail.SenderName,
+		SenderAddress:         in.Mail.SenderAddress,
+		Subject:               in.Mail.Subject,
+		CreatedAt:             time.Now(),
+		Status:                entity.MessageStatus_PENDING,
+		Priority:              entity.MessagePriority(in.Priority),
+		Category:              in.Category,
+		ValidUntil:            in.ValidUntil,
+		FallbackFromMessageId: in.FallbackFromMessageId,
+		NotificationId:        in.NotificationId,
+	}
+
+	if in.ScheduledAt != nil {
+		message.ScheduledAt = in.ScheduledAt
+		message.Status = entity.MessageStatus_SCHEDULED
+	}
+
+	return s.publishMessage(ctx, message, publishRequest{
+		clientId:         in.ClientId,
+		timezone:         in.Timezone,
+		receivers:        in.Receivers,
+		variables:        in.ReceiverVariables,
+		skipFrequencyCap: in.SkipFrequencyCap,
+		fallback:         in.Fallback,
+	})
+}
+
+func (s NotifyService) PublishPushMessage(ctx context.Context, in model.SendPushRequest) (*entity.Message, error) {
+	content, err := json.Marshal(model.PushContent{
+		Title: in.Push.Title,
+		Body:  in.Push.Body,
+		Data:  in.Push.Data,
+	})
+	if err != nil {
+		return nil, s.ServerError("marshal push content failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	message := &entity.Message{
+		Id:                    s.snowflake.Generate().String(),
+		Type:                  entity.MessageType_PUSH,
+		Data:                  string(content),
+		CreatedAt:             time.Now(),
+		Status:                entity.MessageStatus_PENDING,
+		Priority:              entity.MessagePriority(in.Priority),
+		Category:              in.Category,
+		ValidUntil:            in.ValidUntil,
+		FallbackFromMessageId: in.FallbackFromMessageId,
+		NotificationId:        in.NotificationId,
+	}
+
+	if in.ScheduledAt != nil {
+		message.ScheduledAt = in.ScheduledAt
+		message.Status = entity.MessageStatus_SCHEDULED
+	}
+
+	return s.publishMessage(ctx, message, publishRequest{
+		clientId:         in.ClientId,
+		timezone:         in.Timezone,
+		receivers:        in.Receivers,
+		skipFrequencyCap: in.SkipFrequencyCap,
+		fallback:         in.Fallback,
+	})
+}
+
+func (s NotifyService) PublishLineMessage(ctx context.Context, in model.SendLineRequest) (*entity.Message, error) {
+	lineContent := model.LineContent{
+		Text:    in.Line.Text,
+		AltText: in.Line.AltText,
+	}
+	if in.Line.FlexContents != "" {
+		lineContent.FlexContents = json.RawMessage(in.Line.FlexContents)
+	}
+	content, err := json.Marshal(lineContent)
+	if err != nil {
+		return nil, s.ServerError("marshal line content failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	message := &entity.Message{
+		Id:                    s.snowflake.Generate().String(),
+		Type:                  entity.MessageType_LINE,
+		Data:                  string(content),
+		CreatedAt:             time.Now(),
+		Status:                entity.MessageStatus_PENDING,
+		Priority:              entity.MessagePriority(in.Priority),
+		Category:              in.Category,
+		ValidUntil:            in.ValidUntil,
+		FallbackFromMessageId: in.FallbackFromMessageId,
+		NotificationId:        in.NotificationId,
+	}
+
+	if in.ScheduledAt != nil {
+		message.ScheduledAt = in.ScheduledAt
+		message.Status = entity.MessageStatus_SCHEDULED
+	}
+
+	return s.publishMessage(ctx, message, publishRequest{
+		clientId:         in.ClientId,
+		timezone:         in.Timezone,
+		receivers:        in.Receivers,
+		skipFrequencyCap: in.SkipFrequencyCap,
+		fallback:         in.Fallback,
+	})
+}
+
+// 外部 HTTP 推送的接收者為推送網址，不適用勿擾時段與發送頻率上限
+func (s NotifyService) PublishWebhookMessage(ctx context.Context, in model.SendWebhookRequest) (*entity.Message, error) {
+	content, err := json.Marshal(model.WebhookContent{
+		Method: in.Webhook.Method,
+		Body:   in.Webhook.Body,
+	})
+	if err != nil {
+		return nil, s.ServerError("marshal webhook content failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	message := &entity.Message{
+		Id:         s.snowflake.Generate().String(),
+		Type:       entity.MessageType_WEBHOOK,
+		Data:       string(content),
+		CreatedAt:  time.Now(),
+		Status:     entity.MessageStatus_PENDING,
+		Priority:   entity.MessagePriority(in.Priority),
+		Category:   in.Category,
+		ValidUntil: in.ValidUntil,
 	}
 
 	if in.ScheduledAt != nil {
@@ -59,36 +260,47 @@ func (s NotifyService) PublishSmsMessage(ctx context.Context, in model.SendSmsRe
 		message.Status = entity.MessageStatus_SCHEDULED
 	}
 
-	queues, targets, err := s.prepareTargetsAndQueues(in.Receivers, message.Id, entity.MessageType_SMS)
+	headers, err := encodeTargetVariables(s.aesGcm, in.Webhook.Headers)
+	if err != nil {
+		return nil, s.ServerError("encrypt webhook headers failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	variables := map[string]string{in.Webhook.Url: headers}
+
+	queues, targets, _, err := s.prepareTargetsAndQueues(ctx, message, []string{in.Webhook.Url}, variables, true)
 	if err != nil {
 		log.WithContext(ctx).Error(fmt.Sprintf("prepare targets and queues failed with db query: %v", err))
 		return nil, err
 	}
 
+	// 推送網址在抑制名單中時不需發送
+	if len(queues) == 0 {
+		message.Status = entity.MessageStatus_SUPPRESSED
+	}
+
 	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(message).Create(message).Error
-		if err != nil {
+		if err := tx.Create(message).Error; err != nil {
 			return s.ServerError("create message failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 
-		err = tx.Create(targets).Error
-		if err != nil {
+		if err := tx.CreateInBatches(targets, dbBatchSize).Error; err != nil {
 			return s.ServerError("create target failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 
-		err = tx.Create(queues).Error
-		if err != nil {
+		if len(queues) == 0 {
+			return nil
+		}
+
+		if err := tx.Create(queues).Error; err != nil {
 			return s.ServerError("create queue failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
-	if message.ScheduledAt == nil {
-		err := s.HandleEnqueue(ctx, entity.MessageType_SMS, queues)
+	if message.ScheduledAt == nil && len(queues) > 0 {
+		err := s.HandleEnqueue(ctx, entity.MessageType_WEBHOOK, message.Priority, queues)
 		if err != nil {
 			return nil, s.ServerError("handle enqueue failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
@@ -97,129 +309,458 @@ func (s NotifyService) PublishSmsMessage(ctx context.Context, in model.SendSmsRe
 	return message, nil
 }
 
-func (s NotifyService) PublishMailMessage(ctx context.Context, in model.SendMailRequest) (*entity.Message, error) {
+// PublishOptOutConfirmation 發送退訂確認簡訊，接收者已在抑制名單中，不經過抑制名單與發送頻率上限檢查
+func (s NotifyService) PublishOptOutConfirmation(ctx context.Context, receiver string, content string) (*entity.Message, error) {
+	createdAt := time.Now()
 	message := &entity.Message{
-		Id:            s.snowflake.Generate().String(),
-		Type:          entity.MessageType_MAIL,
-		Data:          in.Mail.Body,
-		SenderName:    in.Mail.SenderName,
-		SenderAddress: in.Mail.SenderAddress,
-		Subject:       in.Mail.Subject,
-		CreatedAt:     time.Now(),
-		Status:        entity.MessageStatus_PENDING,
+		Id:        s.snowflake.Generate().String(),
+		Type:      entity.MessageType_SMS,
+		Data:      content,
+		CreatedAt: createdAt,
+		Status:    entity.MessageStatus_PENDING,
+		Priority:  entity.MessagePriority_HIGH,
 	}
 
-	if in.ScheduledAt != nil {
-		message.ScheduledAt = in.ScheduledAt
-		message.Status = entity.MessageStatus_SCHEDULED
+	provider, err := s.getProvider(message.Type, receiver)
+	if err != nil {
+		return nil, s.ServerError("get provider failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	receiverEncrypted, err := s.aesGcm.AesEncrypt(receiver)
+	if err != nil {
+		return nil, s.ServerError("encrypt receiver failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	queue := &entity.Queue{
+		Id:        s.snowflake.Generate().String(),
+		Status:    entity.QueueStatus_PENDING,
+		MessageId: message.Id,
+		Driver:    entity.QueueDriver_KAFKA,
+		CreatedAt: createdAt,
+	}
+	target := &entity.Target{
+		Id:           s.snowflake.Generate().String(),
+		MessageId:    message.Id,
+		Receiver:     receiverEncrypted,
+		ReceiverHash: util.Md5(receiver),
+		Status:       entity.TargetStatus_PENDING,
+		QueueId:      queue.Id,
+		Provider:     provider,
+		CreatedAt:    createdAt,
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
+			return s.ServerError("create message failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		if err := tx.Create(target).Error; err != nil {
+			return s.ServerError("create target failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		if err := tx.Create(queue).Error; err != nil {
+			return s.ServerError("create queue failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.HandleEnqueue(ctx, message.Type, message.Priority, []*entity.Queue{queue}); err != nil {
+		return nil, s.ServerError("handle enqueue failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return message, nil
+}
+
+// 建立訊息所需的接收者與發送設定，variables 為依接收者對應的加密範本變數
+type publishRequest struct {
+	clientId         string
+	timezone         string
+	receivers        []string
+	variables        map[string]string
+	skipFrequencyCap bool
+	fallback         *model.FallbackPolicy
+}
+
+// 套用勿擾時段後建立訊息、接收者、佇列與備援設定，未預約或已交由發送商預約的訊息立即加入佇列；
+// 超過發送頻率上限而延後的接收者另建立預約訊息
+func (s NotifyService) publishMessage(ctx context.Context, message *entity.Message, in publishRequest) (*entity.Message, error) {
+	if err := s.applyQuietHours(ctx, message, in.clientId, in.receivers, in.timezone); err != nil {
+		return nil, err
+	}
+	if message.Type == entity.MessageType_SMS {
+		message.ProviderScheduled = s.isProviderScheduled(message, in.receivers)
 	}
 
-	queues, targets, err := s.prepareTargetsAndQueues(in.Receivers, message.Id, entity.MessageType_MAIL)
+	queues, targets, deferred, err := s.prepareTargetsAndQueues(ctx, message, in.receivers, in.variables, in.skipFrequencyCap)
 	if err != nil {
 		log.WithContext(ctx).Error(fmt.Sprintf("prepare targets and queues failed with db query: %v", err))
 		return nil, err
 	}
-	transaction := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err = tx.Create(message).Error
-		if err != nil {
+
+	// 所有接收者皆在抑制名單中或超過發送頻率上限時不需發送
+	if len(queues) == 0 {
+		message.Status = entity.MessageStatus_SUPPRESSED
+	}
+
+	fallback, err := s.newMessageFallback(ctx, message, in.clientId, in.timezone, in.receivers, in.fallback)
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("prepare message fallback failed: %v", err))
+		return nil, s.ServerError("prepare message fallback failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
 			return s.ServerError("create message failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 
-		err = tx.Create(targets).Error
-		if err != nil {
+		if err := tx.CreateInBatches(targets, dbBatchSize).Error; err != nil {
 			return s.ServerError("create target failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 
-		err = tx.Create(queues).Error
-		if err != nil {
+		if fallback != nil {
+			if err := tx.Create(fallback).Error; err != nil {
+				return s.ServerError("create message fallback failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+		}
+
+		if len(queues) == 0 {
+			return nil
+		}
+
+		if err := tx.Create(queues).Error; err != nil {
 			return s.ServerError("create queue failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 		return nil
 	})
-
-	if transaction != nil {
-		return nil, transaction
+	if err != nil {
+		return nil, err
 	}
 
-	if message.ScheduledAt == nil {
-		err := s.HandleEnqueue(ctx, entity.MessageType_MAIL, queues)
+	if (message.ScheduledAt == nil || message.ProviderScheduled) && len(queues) > 0 {
+		err := s.HandleEnqueue(ctx, message.Type, message.Priority, queues)
 		if err != nil {
 			return nil, s.ServerError("handle enqueue failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
 		}
 	}
 
+	if deferred != nil {
+		s.publishDeferredMessage(ctx, message, deferred, in)
+	}
+
 	return message, nil
 }
 
+// 預計發送時間落在勿擾時段時，改為預約至時段結束後發送
+func (s NotifyService) applyQuietHours(
+	ctx context.Context,
+	message *entity.Message,
+	clientId string,
+	receivers []string,
+	timezone string,
+) error {
+	scheduledAt, err := s.quietHoursService.GetDeferredScheduledAt(ctx, QuietHoursRequest{
+		ClientId:    clientId,
+		Channel:     message.Type,
+		Priority:    message.Priority,
+		Receivers:   receivers,
+		Timezone:    timezone,
+		ScheduledAt: message.ScheduledAt,
+	})
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get quiet hours failed: %v", err))
+		return s.ServerError("get quiet hours failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if scheduledAt != nil {
+		log.WithContext(ctx).Infof("message %s deferred to %s by quiet hours", message.Id, scheduledAt)
+		message.ScheduledAt = scheduledAt
+		message.Status = entity.MessageStatus_SCHEDULED
+	}
+	return nil
+}
+
+// 建立訊息的第一層備援設定，自預約時間（未預約時為建立時間）起算等待期限，其餘通道保存於備援內容供後續備援訊息使用
+func (s NotifyService) newMessageFallback(
+	ctx context.Context,
+	message *entity.Message,
+	clientId string,
+	timezone string,
+	receivers []string,
+	policy *model.FallbackPolicy,
+) (*entity.MessageFallback, error) {
+	if policy == nil || len(policy.Steps) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(model.FallbackPlan{
+		ClientId:  clientId,
+		Timezone:  timezone,
+		Receivers: receivers,
+		Steps:     policy.Steps,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dataEncrypted, err := s.aesGcm.AesEncrypt(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	sendAt := message.CreatedAt
+	if message.ScheduledAt != nil {
+		sendAt = *message.ScheduledAt
+	}
+
+	step := 1
+	if message.FallbackFromMessageId != "" {
+		step, err = s.getNextFallbackStep(ctx, message.FallbackFromMessageId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &entity.MessageFallback{
+		Id:         s.snowflake.Generate().String(),
+		MessageId:  message.Id,
+		Step:       step,
+		Channel:    entity.MessageType(policy.Steps[0].Channel),
+		Data:       dataEncrypted,
+		DeadlineAt: sendAt.Add(policy.Steps[0].Deadline),
+		Status:     entity.FallbackStatus_PENDING,
+		CreatedAt:  message.CreatedAt,
+	}, nil
+}
+
+// 備援訊息的備援順序接續原訊息的備援順序
+func (s NotifyService) getNextFallbackStep(ctx context.Context, fromMessageId string) (int, error) {
+	var fallback entity.MessageFallback
+	err := s.db.WithContext(ctx).
+		Where("message_id = ?", fromMessageId).
+		Limit(1).
+		Find(&fallback).
+		Error
+	if err != nil {
+		return 0, err
+	}
+	return fallback.Step + 1, nil
+}
+
+// 超過發送頻率上限而延後的接收者，另建立預約訊息發送
+type deferredReceivers struct {
+	receivers   []string
+	indexes     []int // 延後的接收者在原請求中的順序，用於對應備援通道的收件者
+	scheduledAt time.Time
+}
+
 func (s NotifyService) prepareTargetsAndQueues(
+	ctx context.Context,
+	message *entity.Message,
 	receivers []string,
-	messageId string,
-	messageType entity.MessageType,
+	variables map[string]string,
+	skipFrequencyCap bool,
 ) (
 	[]*entity.Queue,
 	[]*entity.Target,
+	*deferredReceivers,
 	error,
 ) {
 	var queues []*entity.Queue
 	var targets []*entity.Target
+	var deferred *deferredReceivers
 
 	createdAt := time.Now()
-	provider, err := getProvider(messageType)
+	limit, err := s.getProviderBatchLimit(message.Type)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	receiverHashes := make([]string, len(receivers))
+	for i, receiver := range receivers {
+		receiverHashes[i] = util.Md5(receiver)
 	}
-	limit, err := s.getProviderBatchLimit(messageType)
+	suppressed, err := s.suppressionService.GetSuppressedHashes(ctx, message.Type, receiverHashes)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	capped := make(map[string]FrequencyCapDecision)
+	if !skipFrequencyCap {
+		sendAt := createdAt
+		if message.ScheduledAt != nil {
+			sendAt = *message.ScheduledAt
+		}
+		capped, err = s.frequencyCapService.Evaluate(ctx, FrequencyCapRequest{
+			Channel:        message.Type,
+			Category:       message.Category,
+			Priority:       message.Priority,
+			ReceiverHashes: receiverHashes,
+			SendAt:         sendAt,
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	// 抑制名單中或超過發送頻率上限的接收者僅建立紀錄，不加入佇列
+	sendable := make([]string, 0, len(receivers))
+	for i, receiver := range receivers {
+		status := entity.TargetStatus_PENDING
+		statusReason := ""
+		if suppressed[receiverHashes[i]] {
+			status = entity.TargetStatus_SUPPRESSED
+		} else if decision, ok := capped[receiverHashes[i]]; ok {
+			status, statusReason = s.applyFrequencyCapDecision(message, decision)
+			if status == entity.TargetStatus_DEFERRED {
+				if deferred == nil {
+					deferred = &deferredReceivers{}
+				}
+				deferred.receivers = append(deferred.receivers, receiver)
+				deferred.indexes = append(deferred.indexes, i)
+				// 延後的接收者合併為一則訊息，以最晚的時間發送
+				if decision.DeferUntil.After(deferred.scheduledAt) {
+					deferred.scheduledAt = decision.DeferUntil
+				}
+			}
+		}
+
+		if status == entity.TargetStatus_PENDING {
+			sendable = append(sendable, receiver)
+			continue
+		}
+
+		provider, err := s.getProvider(message.Type, receiver)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		receiverEncrypted, err := s.aesGcm.AesEncrypt(receiver)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		targets = append(targets, &entity.Target{
+			Id:           s.snowflake.Generate().String(),
+			MessageId:    message.Id,
+			Receiver:     receiverEncrypted,
+			ReceiverHash: receiverHashes[i],
+			Status:       status,
+			StatusReason: statusReason,
+			Provider:     provider,
+			Variables:    variables[receiver],
+			CreatedAt:    createdAt,
+		})
 	}
 
-	chunks := util.ChunkArray(receivers, limit)
+	chunks := util.ChunkArray(sendable, limit)
 
 	for _, chunk := range chunks {
 		queue := &entity.Queue{
 			Id:        s.snowflake.Generate().String(),
 			Status:    entity.QueueStatus_PENDING,
-			MessageId: messageId,
+			MessageId: message.Id,
 			Driver:    entity.QueueDriver_KAFKA,
 			CreatedAt: createdAt,
 		}
 		queues = append(queues, queue)
 
 		for _, receiver := range chunk {
+			provider, err := s.getProvider(message.Type, receiver)
+			if err != nil {
+				return nil, nil, nil, err
+			}
 			receiverEncrypted, err := s.aesGcm.AesEncrypt(receiver)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			receiverHash := util.Md5(receiver)
 
 			target := &entity.Target{
 				Id:           s.snowflake.Generate().String(),
-				MessageId:    messageId,
+				MessageId:    message.Id,
 				Receiver:     receiverEncrypted,
 				ReceiverHash: receiverHash,
 				Status:       entity.TargetStatus_PENDING,
 				QueueId:      queue.Id,
-				Provider:     *provider,
+				Provider:     provider,
+				Variables:    variables[receiver],
 				CreatedAt:    createdAt,
 			}
 			targets = append(targets, target)
 		}
 	}
 
-	return queues, targets, nil
+	return queues, targets, deferred, nil
 }
 
-func getProvider(messageType entity.MessageType) (*entity.Provider, error) {
+// 以原訊息內容另建立預約訊息，發送給超過頻率上限而延後的接收者；原訊息已建立，失敗時僅記錄，避免呼叫端重送造成重複發送
+func (s NotifyService) publishDeferredMessage(
+	ctx context.Context,
+	source *entity.Message,
+	deferred *deferredReceivers,
+	in publishRequest,
+) {
+	message := &entity.Message{
+		Id:                    s.snowflake.Generate().String(),
+		Type:                  source.Type,
+		Data:                  source.Data,
+		SenderName:            source.SenderName,
+		SenderAddress:         source.SenderAddress,
+		Subject:               source.Subject,
+		ScheduledAt:           &deferred.scheduledAt,
+		ValidUntil:            source.ValidUntil,
+		Status:                entity.MessageStatus_SCHEDULED,
+		Priority:              source.Priority,
+		Category:              source.Category,
+		FallbackFromMessageId: source.FallbackFromMessageId,
+		NotificationId:        source.NotificationId,
+		CreatedAt:             time.Now(),
+	}
+
+	in.receivers = deferred.receivers
+	in.skipFrequencyCap = true
+	in.fallback = getDeferredFallbackPolicy(in.fallback, deferred.indexes)
+	deferredMessage, err := s.publishMessage(ctx, message, in)
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("publish frequency capped message %s failed: %v", source.Id, err))
+		return
+	}
+	log.WithContext(ctx).Infof("message %s deferred %d receivers to message %s by frequency cap", source.Id, len(deferred.receivers), deferredMessage.Id)
+}
+
+// 延後時間超過有效期限時改為不發送
+func (s NotifyService) applyFrequencyCapDecision(
+	message *entity.Message,
+	decision FrequencyCapDecision,
+) (entity.TargetStatus, string) {
+	if decision.Action != entity.FrequencyCapAction_DEFER {
+		return entity.TargetStatus_DROPPED, decision.Reason
+	}
+	if message.ValidUntil != nil && !decision.DeferUntil.Before(*message.ValidUntil) {
+		return entity.TargetStatus_DROPPED, fmt.Sprintf("%s, cannot defer beyond valid_until", decision.Reason)
+	}
+	return entity.TargetStatus_DEFERRED, fmt.Sprintf("%s, deferred until %s", decision.Reason, decision.DeferUntil.Format(time.RFC3339))
+}
+
+// 依設定的發送商建立發送紀錄，簡訊依門號地區區分國內與國際發送商，供送達回報依發送商比對
+func (s NotifyService) getProvider(messageType entity.MessageType, receiver string) (entity.Provider, error) {
 	switch messageType {
 	case entity.MessageType_SMS:
-		provider := entity.Provider_MITAKE
-		return &provider, nil
+		if s.config.SmsProvider == "" {
+			return entity.Provider_MITAKE, nil
+		}
+		return s.getSmsProvider(receiver), nil
 	case entity.MessageType_MAIL:
-		provider := entity.Provider_SENDGRID
-		return &provider, nil
+		if s.config.MailProvider == "" {
+			return entity.Provider_SENDGRID, nil
+		}
+		return entity.Provider(s.config.MailProvider), nil
+	case entity.MessageType_WEBHOOK:
+		return entity.Provider_WEBHOOK, nil
+	case entity.MessageType_PUSH:
+		// 實際使用的推播發送商於發送時寫回
+		return entity.Provider_FCM, nil
+	case entity.MessageType_LINE:
+		return entity.Provider_LINE, nil
 	default:
-		return nil, fmt.Errorf("unsupported message type: %v", messageType)
+		return "", fmt.Errorf("unsupported message type: %v", messageType)
 	}
 }
 
@@ -229,6 +770,13 @@ func (s NotifyService) getProviderBatchLimit(messageType entity.MessageType) (in
 		return s.config.SmsProviderBatchLimit, nil
 	case entity.MessageType_MAIL:
 		return s.config.MailProviderBatchLimit, nil
+	case entity.MessageType_WEBHOOK:
+		// 每則推送各自送出一次請求
+		return 1, nil
+	case entity.MessageType_PUSH:
+		return s.config.PushProviderBatchLimit, nil
+	case entity.MessageType_LINE:
+		return s.config.LineProviderBatchLimit, nil
 	default:
 		return 0, fmt.Errorf("unsupported message type: %v", messageType)
 	}
@@ -262,6 +810,10 @@ func (s NotifyService) CancelScheduledByMessageId(ctx context.Context, messageId
 		return s.ServerError("message is already enqueued", errorpb.ErrorReasonCode_ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL)
 	}
 
+	if message.ProviderScheduled && message.Status == entity.MessageStatus_SENT {
+		return s.cancelProviderScheduled(ctx, &message, startAt, endAt)
+	}
+
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		err := tx.Model(entity.Message{}).
 			Where("id = ?", messageId).
@@ -296,10 +848,174 @@ func (s NotifyService) CancelScheduledByMessageId(ctx context.Context, messageId
 			log.WithContext(ctx).Error(fmt.Printf("update target status failed with message id %s error: %v", message.Id, err))
 			return err
 		}
-		return nil
+
+		return s.cancelMessageFallback(tx, message.Id)
 	})
 }
 
+// cancelProviderScheduled 呼叫發送商取消已預約的簡訊，僅將發送商確認取消的接收者標記為已取消
+func (s NotifyService) cancelProviderScheduled(ctx context.Context, message *entity.Message, startAt, endAt time.Time) error {
+	if message.ScheduledAt == nil || !time.Now().Before(*message.ScheduledAt) {
+		return s.ServerError("message is already sent", errorpb.ErrorReasonCode_ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL)
+	}
+
+	var targets []entity.Target
+	err := s.db.WithContext(ctx).
+		Where("message_id = ?", message.Id).
+		Where("created_at between ? and ?", startAt, endAt).
+		Where("status = ?", entity.TargetStatus_SENT).
+		Where("provider = ?", entity.Provider(s.config.SmsProvider)).
+		Where("provider_trace_id <> ''").
+		Find(&targets).
+		Error
+	if err != nil {
+		return s.ServerError("cancel scheduled message failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	providerTraceIds := make([]string, 0, len(targets))
+	for _, target := range targets {
+		providerTraceIds = append(providerTraceIds, target.ProviderTraceId)
+	}
+
+	canceledTraceIds := make([]string, 0, len(providerTraceIds))
+	for start := 0; start < len(providerTraceIds); start += smsCancelBatchSize {
+		end := min(start+smsCancelBatchSize, len(providerTraceIds))
+		results, err := s.smsCanceler.CancelSms(ctx, providerTraceIds[start:end])
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("cancel provider scheduled message %s failed: %v", message.Id, err))
+			continue
+		}
+		for _, result := range results {
+			if result.Canceled {
+				canceledTraceIds = append(canceledTraceIds, result.ProviderTraceId)
+			}
+		}
+	}
+
+	if len(canceledTraceIds) == 0 {
+		return s.ServerError("cancel scheduled message failed with provider", errorpb.ErrorReasonCode_ERR_NOTIFY_PROVIDER_CANCEL_FAILED)
+	}
+	if len(canceledTraceIds) < len(providerTraceIds) {
+		log.WithContext(ctx).Warnf("message %s canceled %d of %d receivers with provider", message.Id, len(canceledTraceIds), len(providerTraceIds))
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(entity.Message{}).
+			Where("id = ?", message.Id).
+			Updates(map[string]interface{}{
+				"status": string(entity.MessageStatus_CANCELED),
+			}).Error
+		if err != nil {
+			return s.ServerError("cancel scheduled message failed with db update query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		err = tx.Model(entity.Target{}).
+			Where("message_id = ?", message.Id).
+			Where("created_at between ? and ?", startAt, endAt).
+			Where("provider_trace_id IN ?", canceledTraceIds).
+			Updates(map[string]interface{}{
+				"status": string(entity.TargetStatus_CANCELED),
+			}).Error
+		if err != nil {
+			log.WithContext(ctx).Error(fmt.Sprintf("update target status failed with message id %s error: %v", message.Id, err))
+			return s.ServerError("cancel scheduled message failed with db update query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		return s.cancelMessageFallback(tx, message.Id)
+	})
+}
+
+// 取消訊息時一併取消尚未觸發的備援
+func (s NotifyService) cancelMessageFallback(tx *gorm.DB, messageId string) error {
+	err := tx.Model(entity.MessageFallback{}).
+		Where("message_id = ?", messageId).
+		Where("status = ?", entity.FallbackStatus_PENDING).
+		Update("status", entity.FallbackStatus_CANCELED).
+		Error
+	if err != nil {
+		return s.ServerError("cancel message fallback failed with db update query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return nil
+}
+
+// MessageDetail 訊息與各發送狀態的接收者數量及備援設定
+type MessageDetail struct {
+	Message      *entity.Message
+	TargetCounts map[entity.TargetStatus]int64
+	Fallback     *entity.MessageFallback
+}
+
+// GetMessage 查詢訊息，已取消的訊息一併查詢
+func (s NotifyService) GetMessage(ctx context.Context, in model.GetMessageRequest) (*MessageDetail, error) {
+	createdAt, err := util.ConvertSnowflakeToTime(in.MessageId)
+	if err != nil {
+		return nil, s.ServerError("message not found", errorpb.ErrorReasonCode_ERR_NOTIFY_MESSAGE_NOT_FOUND)
+	}
+
+	// 避免全表搜尋加上時間戳範圍
+	startAt := createdAt.Add(-1 * time.Hour)
+	endAt := createdAt.Add(1 * time.Hour)
+	var message entity.Message
+	err = s.db.WithContext(ctx).
+		Unscoped().
+		Where("id = ?", in.MessageId).
+		Where("created_at between ? and ?", startAt, endAt).
+		First(&message).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.ServerError("message not found", errorpb.ErrorReasonCode_ERR_NOTIFY_MESSAGE_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get message failed with db query: %v", err))
+		return nil, s.ServerError("get message failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return s.getMessageDetail(ctx, &message, startAt, endAt)
+}
+
+// 查詢訊息各發送狀態的接收者數量及備援設定，startAt 與 endAt 為接收者的建立時間範圍
+func (s NotifyService) getMessageDetail(ctx context.Context, message *entity.Message, startAt, endAt time.Time) (*MessageDetail, error) {
+	var rows []struct {
+		Status entity.TargetStatus
+		Count  int64
+	}
+	err := s.db.WithContext(ctx).
+		Model(&entity.Target{}).
+		Unscoped().
+		Select("status, count(*) AS count").
+		Where("message_id = ?", message.Id).
+		Where("created_at between ? and ?", startAt, endAt).
+		Group("status").
+		Scan(&rows).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("count message targets failed with db query: %v", err))
+		return nil, s.ServerError("get message failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	detail := &MessageDetail{
+		Message:      message,
+		TargetCounts: make(map[entity.TargetStatus]int64, len(rows)),
+	}
+	for _, row := range rows {
+		detail.TargetCounts[row.Status] = row.Count
+	}
+
+	var fallbacks []*entity.MessageFallback
+	err = s.db.WithContext(ctx).
+		Where("message_id = ?", message.Id).
+		Limit(1).
+		Find(&fallbacks).
+		Error
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get message fallback failed with db query: %v", err))
+		return nil, s.ServerError("get message failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	if len(fallbacks) > 0 {
+		detail.Fallback = fallbacks[0]
+	}
+	return detail, nil
+}
+
 func (s NotifyService) ListStatusWithPaging(ctx context.Context, in model.ListStatusWithPagingRequest) ([]*entity.Target, int64, error) {
 	var targets []*entity.Target
 
@@ -341,6 +1057,7 @@ func (s NotifyService) ListStatusWithPaging(ctx context.Context, in model.ListSt
 func (s *NotifyService) HandleEnqueue(
 	ctx context.Context,
 	messageType entity.MessageType,
+	priority entity.MessagePriority,
 	queues []*entity.Queue,
 ) error {
 	queueIDs, messageIDs := extractBatchData(queues)
@@ -370,7 +1087,7 @@ func (s *NotifyService) HandleEnqueue(
 				wg.Done()
 			}()
 
-			msg, err := s.createKafkaMessage(messageType, q)
+			msg, err := s.createKafkaMessage(messageType, priority, q)
 			if err != nil {
 				log.WithContext(ctx).Errorf("Failed to create kafka message: %v", err)
 				return
@@ -425,6 +1142,93 @@ func (s *NotifyService) HandleEnqueue(
 	return nil
 }
 
+// HandleExpire 將逾期未發送的訊息及其尚未發送的佇列、接收者標記為逾期，並為每個佇列記錄逾期事件；
+// 已在抑制名單中或超過發送頻率上限的接收者維持原狀態
+func (s *NotifyService) HandleExpire(ctx context.Context, messageId string) error {
+	createdAt, err := util.ConvertSnowflakeToTime(messageId)
+	if err != nil {
+		return fmt.Errorf("error convert snowflake to time: %w", err)
+	}
+
+	// 避免全表搜尋加上時間戳範圍
+	startAt := createdAt.Add(-1 * time.Hour)
+	endAt := createdAt.Add(1 * time.Hour)
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var message entity.Message
+		if err := tx.
+			Where("id = ?", messageId).
+			Where("created_at between ? and ?", startAt, endAt).
+			First(&message).
+			Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entity.Message{}).
+			Where("id = ?", messageId).
+			Where("created_at between ? and ?", startAt, endAt).
+			Update("status", entity.MessageStatus_EXPIRED).
+			Error; err != nil {
+			return err
+		}
+
+		var queues []*entity.Queue
+		if err := tx.
+			Where("message_id = ?", messageId).
+			Where("created_at between ? and ?", startAt, endAt).
+			Where("status = ?", entity.QueueStatus_PENDING).
+			Find(&queues).
+			Error; err != nil {
+			return err
+		}
+		if len(queues) == 0 {
+			return nil
+		}
+
+		queueIds := make([]string, len(queues))
+		for i, queue := range queues {
+			queueIds[i] = queue.Id
+		}
+
+		if err := tx.Model(&entity.Queue{}).
+			Where("id IN ?", queueIds).
+			Where("created_at between ? and ?", startAt, endAt).
+			Update("status", entity.QueueStatus_EXPIRED).
+			Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entity.Target{}).
+			Where("queue_id IN ?", queueIds).
+			Where("created_at between ? and ?", startAt, endAt).
+			Where("status = ?", entity.TargetStatus_PENDING).
+			Update("status", entity.TargetStatus_EXPIRED).
+			Error; err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(map[string]interface{}{
+			"valid_until": message.ValidUntil,
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		events := make([]*entity.Event, len(queues))
+		for i, queue := range queues {
+			events[i] = &entity.Event{
+				Id:        s.snowflake.Generate().String(),
+				Provider:  SCHEDULER_EVENT_PROVIDER,
+				Status:    entity.EventStatus_EXPIRED,
+				QueueId:   queue.Id,
+				Data:      data,
+				CreatedAt: now,
+			}
+		}
+		return tx.CreateInBatches(events, dbBatchSize).Error
+	})
+}
+
 func (s *NotifyService) batchUpdateDatabase(
 	ctx context.Context,
 	queueIDs []string,
@@ -478,6 +1282,7 @@ func (s *NotifyService) batchUpdateDatabase(
 
 func (s *NotifyService) createKafkaMessage(
 	messageType entity.MessageType,
+	priority entity.MessagePriority,
 	queue *entity.Queue,
 ) (*sarama.ProducerMessage, error) {
 	jsonData, err := json.Marshal(queue)
@@ -487,7 +1292,7 @@ func (s *NotifyService) createKafkaMessage(
 
 	driverTraceId := s.snowflake.Generate().String()
 	msg := &sarama.ProducerMessage{
-		Topic: getKafkaTopicByMessageType(messageType),
+		Topic: getKafkaTopicByMessageType(messageType, priority),
 		Key:   sarama.StringEncoder(driverTraceId),
 		Value: sarama.ByteEncoder(jsonData),
 	}
@@ -522,12 +1327,47 @@ func withRetry(ctx context.Context, maxRetries int, fn func() error) error {
 	return err
 }
 
-func getKafkaTopicByMessageType(messageType entity.MessageType) string {
+func getKafkaTopicByMessageType(messageType entity.MessageType, priority entity.MessagePriority) string {
 	switch messageType {
 	case entity.MessageType_SMS:
-		return shared.KafkaTopicSms
+		switch priority {
+		case entity.MessagePriority_HIGH:
+			return shared.KafkaTopicSmsHigh
+		case entity.MessagePriority_BULK:
+			return shared.KafkaTopicSmsBulk
+		default:
+			return shared.KafkaTopicSms
+		}
 	case entity.MessageType_MAIL:
-		return shared.KafkaTopicMail
+		switch priority {
+		case entity.MessagePriority_HIGH:
+			return shared.KafkaTopicMailHigh
+		case entity.MessagePriority_BULK:
+			return shared.KafkaTopicMailBulk
+		default:
+			return shared.KafkaTopicMail
+		}
+	case entity.MessageType_PUSH:
+		switch priority {
+		case entity.MessagePriority_HIGH:
+			return shared.KafkaTopicPushHigh
+		case entity.MessagePriority_BULK:
+			return shared.KafkaTopicPushBulk
+		default:
+			return shared.KafkaTopicPush
+		}
+	case entity.MessageType_LINE:
+		switch priority {
+		case entity.MessagePriority_HIGH:
+			return shared.KafkaTopicLineHigh
+		case entity.MessagePriority_BULK:
+			return shared.KafkaTopicLineBulk
+		default:
+			return shared.KafkaTopicLine
+		}
+	case entity.MessageType_WEBHOOK:
+		// 推送量少，不依優先級分流
+		return shared.KafkaTopicWebhook
 	default:
 		return ""
 	}
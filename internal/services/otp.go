@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	errorpb "proto/pkg/notify/v1/error"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/jackc/pgx/v5/pgconn"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	OTP_EVENT_PROVIDER = "otp"
+
+	pgUniqueViolation = "23505" // PostgreSQL unique_violation 錯誤代碼
+)
+
+type OtpVerifyOutcome string
+
+const (
+	OtpVerifyOutcome_VERIFIED          OtpVerifyOutcome = "verified"          // 驗證成功
+	OtpVerifyOutcome_MISMATCH          OtpVerifyOutcome = "mismatch"          // 驗證碼錯誤
+	OtpVerifyOutcome_EXPIRED           OtpVerifyOutcome = "expired"           // 已過期
+	OtpVerifyOutcome_NOT_FOUND         OtpVerifyOutcome = "not_found"         // 查無驗證碼
+	OtpVerifyOutcome_ATTEMPTS_EXCEEDED OtpVerifyOutcome = "attempts_exceeded" // 超過驗證次數
+)
+
+type OtpService struct {
+	BaseService
+	db            *gorm.DB
+	config        *shared.Config
+	snowflake     *snowflake.Node
+	aesGcm        *component.AesGcm
+	notifyService *NotifyService
+}
+
+func NewOtpService(
+	db *gorm.DB,
+	config *shared.Config,
+	snowflake *snowflake.Node,
+	aesGcm *component.AesGcm,
+	notifyService *NotifyService,
+) *OtpService {
+	return &OtpService{
+		db:            db,
+		config:        config,
+		snowflake:     snowflake,
+		aesGcm:        aesGcm,
+		notifyService: notifyService,
+	}
+}
+
+// RequestOtp 產生驗證碼並透過既有的簡訊/郵件流程發送
+func (s OtpService) RequestOtp(ctx context.Context, in model.RequestOtpRequest) (*entity.Otp, error) {
+	ttl := in.Ttl
+	if ttl == 0 {
+		ttl = time.Duration(s.config.OtpDefaultTtlSeconds) * time.Second
+	}
+	if ttl > time.Duration(s.config.OtpMaxTtlSeconds)*time.Second {
+		return nil, s.ValueError(
+			fmt.Sprintf("ttl must be within %d seconds", s.config.OtpMaxTtlSeconds),
+			errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_INVALID_TTL,
+		)
+	}
+
+	now := time.Now()
+	receiverHash := util.Md5(in.Receiver)
+	cooldown := time.Duration(s.config.OtpResendCooldownSeconds) * time.Second
+
+	code, err := generateOtpCode(s.config.OtpCodeLength)
+	if err != nil {
+		return nil, s.ServerError("generate otp code failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	otp := &entity.Otp{
+		Id:           s.snowflake.Generate().String(),
+		ReceiverHash: receiverHash,
+		Channel:      entity.MessageType(in.Channel),
+		Purpose:      in.Purpose,
+		MaxAttempts:  s.config.OtpMaxAttempts,
+		ExpiresAt:    now.Add(ttl),
+		CreatedAt:    now,
+	}
+	otp.CodeHash = s.hashCode(otp.Id, code)
+
+	// 同一收件者與用途僅保留最新一組驗證碼；同時送出的請求由唯一索引擋下，視為重送過於頻繁
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var latest entity.Otp
+		err := tx.Unscoped().
+			Where("receiver_hash = ?", receiverHash).
+			Where("purpose = ?", in.Purpose).
+			Where("created_at > ?", now.Add(-cooldown)).
+			Order("created_at DESC").
+			First(&latest).
+			Error
+		if err == nil {
+			return s.ResourceExhaustedError(
+				"otp resend is too soon",
+				errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_RESEND_TOO_SOON,
+				latest.CreatedAt.Add(cooldown).Sub(now),
+			)
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.WithContext(ctx).Error(fmt.Sprintf("get latest otp failed with db query: %v", err))
+			return s.ServerError("get latest otp failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		err = tx.Where("receiver_hash = ?", receiverHash).
+			Where("purpose = ?", in.Purpose).
+			Where("verified_at IS NULL").
+			Delete(&entity.Otp{}).
+			Error
+		if err != nil {
+			return s.ServerError("invalidate otp failed with db delete query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+
+		err = tx.Create(otp).Error
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return s.ResourceExhaustedError("otp resend is too soon", errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_RESEND_TOO_SOON, cooldown)
+		}
+		if err != nil {
+			return s.ServerError("create otp failed with db create query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 發送失敗或收件者在抑制名單中時刪除驗證碼，避免冷卻時間內無法重新申請
+	message, err := s.publishOtp(ctx, in, code, ttl, otp.ExpiresAt)
+	if err == nil && message.Status == entity.MessageStatus_SUPPRESSED {
+		err = s.FailedPreconditionError("otp receiver is suppressed", errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_RECEIVER_SUPPRESSED)
+	}
+	if err != nil {
+		if err := s.db.WithContext(ctx).Delete(otp).Error; err != nil {
+			log.WithContext(ctx).WithError(err).Errorf("failed to delete otp %s after publish failed", otp.Id)
+		}
+		return nil, err
+	}
+
+	otp.MessageId = message.Id
+	err = s.db.WithContext(ctx).
+		Model(otp).
+		Update("message_id", message.Id).
+		Error
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("failed to update message id of otp %s", otp.Id)
+	}
+
+	return otp, nil
+}
+
+func (s OtpService) publishOtp(
+	ctx context.Context,
+	in model.RequestOtpRequest,
+	code string,
+	ttl time.Duration,
+	expiresAt time.Time,
+) (*entity.Message, error) {
+	// 訊息內容保留 {code} 變數，驗證碼僅以加密的接收者變數保存，發送時才替換
+	replacer := strings.NewReplacer(
+		"{minutes}", strconv.Itoa(int(ttl.Minutes())),
+	)
+	variables, err := encodeTargetVariables(s.aesGcm, map[string]string{"code": code})
+	if err != nil {
+		return nil, s.ServerError("encrypt otp code failed", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	receiverVariables := map[string]string{in.Receiver: variables}
+
+	// 以驗證碼到期時間作為訊息有效期限，到期後不再發送
+	switch in.Channel {
+	case model.MessageType_SMS:
+		return s.notifyService.PublishSmsMessage(ctx, model.SendSmsRequest{
+			Sms: model.Sms{
+				Body: replacer.Replace(s.config.OtpSmsTemplate),
+			},
+			Receivers:         []string{in.Receiver},
+			Priority:          model.Priority_HIGH,
+			ValidUntil:        &expiresAt,
+			ReceiverVariables: receiverVariables,
+		})
+	case model.MessageType_MAIL:
+		return s.notifyService.PublishMailMessage(ctx, model.SendMailRequest{
+			Mail: model.Mail{
+				SenderName:    shared.DEFAULT_MAIL_SENDER_NAME,
+				SenderAddress: shared.DEFAULT_MAIL_SENDER_ADDRESS,
+				Subject:       s.config.OtpMailSubject,
+				Body:          replacer.Replace(s.config.OtpMailTemplate),
+			},
+			Receivers:         []string{in.Receiver},
+			Priority:          model.Priority_HIGH,
+			ValidUntil:        &expiresAt,
+			ReceiverVariables: receiverVariables,
+		})
+	default:
+		return nil, s.ValueError(fmt.Sprintf("unsupported otp channel: %s", in.Channel), errorpb.ErrorReasonCode_ERR_COMMON_INVALID_ARGUMENT)
+	}
+}
+
+// VerifyOtp 驗證驗證碼，回傳是否成功與剩餘可嘗試次數，每次結果皆記錄為事件
+func (s OtpService) VerifyOtp(ctx context.Context, in model.VerifyOtpRequest) (bool, int, error) {
+	now := time.Now()
+	receiverHash := util.Md5(in.Receiver)
+
+	var otp entity.Otp
+	err := s.db.WithContext(ctx).
+		Where("receiver_hash = ?", receiverHash).
+		Where("purpose = ?", in.Purpose).
+		Where("verified_at IS NULL").
+		Where("created_at > ?", now.Add(-time.Duration(s.config.OtpMaxTtlSeconds)*time.Second)).
+		Order("created_at DESC").
+		First(&otp).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s.createVerifyEvent(ctx, &entity.Otp{Purpose: in.Purpose}, OtpVerifyOutcome_NOT_FOUND)
+		return false, 0, s.NotFoundError("otp not found", errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_NOT_FOUND)
+	}
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get otp failed with db query: %v", err))
+		return false, 0, s.ServerError("get otp failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if !now.Before(otp.ExpiresAt) {
+		s.createVerifyEvent(ctx, &otp, OtpVerifyOutcome_EXPIRED)
+		return false, 0, s.ValueError("otp is expired", errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_EXPIRED)
+	}
+
+	// 原子性累加驗證次數，避免並行請求繞過次數限制
+	result := s.db.WithContext(ctx).
+		Model(&entity.Otp{}).
+		Where("id = ?", otp.Id).
+		Where("attempts < max_attempts").
+		Where("verified_at IS NULL").
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("update otp attempts failed with db query: %v", result.Error))
+		return false, 0, s.ServerError("update otp attempts failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	if result.RowsAffected == 0 {
+		s.createVerifyEvent(ctx, &otp, OtpVerifyOutcome_ATTEMPTS_EXCEEDED)
+		return false, 0, s.ResourceExhaustedError("otp verify attempts exceeded", errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_ATTEMPTS_EXCEEDED, 0)
+	}
+	otp.Attempts++
+	remaining := otp.MaxAttempts - otp.Attempts
+
+	if !hmac.Equal([]byte(s.hashCode(otp.Id, in.Code)), []byte(otp.CodeHash)) {
+		s.createVerifyEvent(ctx, &otp, OtpVerifyOutcome_MISMATCH)
+		return false, remaining, nil
+	}
+
+	// 驗證碼僅能使用一次
+	result = s.db.WithContext(ctx).
+		Model(&entity.Otp{}).
+		Where("id = ?", otp.Id).
+		Where("verified_at IS NULL").
+		Update("verified_at", now)
+	if result.Error != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("update otp verified_at failed with db query: %v", result.Error))
+		return false, 0, s.ServerError("update otp verified_at failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	if result.RowsAffected == 0 {
+		s.createVerifyEvent(ctx, &otp, OtpVerifyOutcome_NOT_FOUND)
+		return false, 0, s.NotFoundError("otp not found", errorpb.ErrorReasonCode_ERR_NOTIFY_OTP_NOT_FOUND)
+	}
+
+	s.createVerifyEvent(ctx, &otp, OtpVerifyOutcome_VERIFIED)
+	return true, remaining, nil
+}
+
+func (s OtpService) createVerifyEvent(ctx context.Context, otp *entity.Otp, outcome OtpVerifyOutcome) {
+	status := entity.EventStatus_OTP_REJECTED
+	if outcome == OtpVerifyOutcome_VERIFIED {
+		status = entity.EventStatus_OTP_VERIFIED
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"otp_id":     otp.Id,
+		"message_id": otp.MessageId,
+		"purpose":    otp.Purpose,
+		"channel":    otp.Channel,
+		"outcome":    outcome,
+		"attempts":   otp.Attempts,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to marshal otp event data")
+		return
+	}
+
+	event := entity.Event{
+		Id:              s.snowflake.Generate().String(),
+		Provider:        OTP_EVENT_PROVIDER,
+		Status:          status,
+		ProviderTraceId: otp.Id,
+		Data:            data,
+		CreatedAt:       time.Now(),
+	}
+	if err := s.db.WithContext(ctx).Create(&event).Error; err != nil {
+		log.WithContext(ctx).WithError(err).Error("Error creating otp event")
+	}
+}
+
+// 以 otp id 作為鹽值，避免相同驗證碼產生相同雜湊
+func (s OtpService) hashCode(otpId string, code string) string {
+	return util.HmacSha256(s.config.AESKey, otpId+":"+code)
+}
+
+func generateOtpCode(length int) (string, error) {
+	var code strings.Builder
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		code.WriteString(n.String())
+	}
+	return code.String(), nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	pusher "notify-service/internal/pusher"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type PushService struct {
+	config   *shared.Config
+	provider pusher.PushProvider
+	aesGcm   *component.AesGcm
+}
+
+func NewPushService(
+	config *shared.Config,
+	provider pusher.PushProvider,
+	aesGcm *component.AesGcm,
+) *PushService {
+	return &PushService{
+		config:   config,
+		provider: provider,
+		aesGcm:   aesGcm,
+	}
+}
+
+func (s *PushService) SendPush(ctx context.Context, targets []entity.Target, message entity.Message) pusher.PushBatchResponse {
+	requestReceivers := make([]pusher.PushReceiver, 0, len(targets))
+	for _, target := range targets {
+		token, err := s.aesGcm.AesDecrypt(target.Receiver)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to decrypt receiver with target id %s", target.Id)
+			continue
+		}
+		requestReceivers = append(requestReceivers, pusher.PushReceiver{
+			TargetId: target.Id,
+			Token:    token,
+		})
+	}
+
+	// 未設定推播發送商時所有裝置皆視為發送失敗
+	if s.provider == nil {
+		return newFailedPushBatchResponse(message.Id, requestReceivers, "PUSH_PROVIDER_NOT_CONFIGURED")
+	}
+
+	var content model.PushContent
+	if err := json.Unmarshal([]byte(message.Data), &content); err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("invalid push content with message id %s", message.Id)
+		return newFailedPushBatchResponse(message.Id, requestReceivers, "INVALID_PUSH_CONTENT")
+	}
+
+	request := pusher.PushBatchRequest{
+		Receivers: requestReceivers,
+		Message: pusher.PushMessage{
+			MessageId:    message.Id,
+			Title:        content.Title,
+			Body:         content.Body,
+			Data:         content.Data,
+			HighPriority: message.Priority == entity.MessagePriority_HIGH,
+			ValidUntil:   message.ValidUntil,
+		},
+	}
+	return s.provider.SendBatchPush(ctx, request)
+}
+
+func newFailedPushBatchResponse(messageId string, receivers []pusher.PushReceiver, reason string) pusher.PushBatchResponse {
+	providerResponse, _ := json.Marshal(map[string]interface{}{
+		"StatusMessage": reason,
+	})
+
+	pushResponses := make([]pusher.PushResponse, 0, len(receivers))
+	for _, receiver := range receivers {
+		pushResponses = append(pushResponses, pusher.PushResponse{
+			Status:           string(pusher.PushStatus_FAILED),
+			TraceId:          receiver.TargetId,
+			ProviderResponse: string(providerResponse),
+		})
+	}
+	return pusher.PushBatchResponse{
+		Status:       string(pusher.PushStatus_FAILED),
+		MessageId:    messageId,
+		PushResponse: pushResponses,
+	}
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	shared "notify-service/internal"
+	entity "notify-service/internal/entities"
+	util "notify-service/internal/utils"
+	"time"
+	// 執行環境映像未安裝 tzdata，內嵌時區資料供收件者時區計算
+	_ "time/tzdata"
+
+	"gorm.io/gorm"
+)
+
+// 勿擾時段跨多個時區時，最多調整的次數
+const quietHoursMaxAdjustments = 8
+
+type QuietHoursService struct {
+	BaseService
+	db              *gorm.DB
+	config          *shared.Config
+	defaultLocation *time.Location
+}
+
+func NewQuietHoursService(
+	db *gorm.DB,
+	config *shared.Config,
+) (*QuietHoursService, error) {
+	defaultLocation, err := time.LoadLocation(config.QuietHoursDefaultTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quiet hours default timezone: %w", err)
+	}
+
+	return &QuietHoursService{
+		db:              db,
+		config:          config,
+		defaultLocation: defaultLocation,
+	}, nil
+}
+
+// QuietHoursRequest 計算勿擾時段所需的發送資訊
+type QuietHoursRequest struct {
+	ClientId    string
+	Channel     entity.MessageType
+	Priority    entity.MessagePriority
+	Receivers   []string
+	Timezone    string
+	ScheduledAt *time.Time
+}
+
+// GetDeferredScheduledAt 預計發送時間落在任一收件者的勿擾時段時，回傳延後至時段結束的發送時間，不需延後時回傳 nil
+func (s QuietHoursService) GetDeferredScheduledAt(ctx context.Context, in QuietHoursRequest) (*time.Time, error) {
+	// 高優先級（OTP、交易通知）不受勿擾時段限制
+	if in.Priority == entity.MessagePriority_HIGH {
+		return nil, nil
+	}
+
+	policy, err := s.getPolicy(ctx, in.ClientId, in.Channel, in.Priority)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, nil
+	}
+
+	window, err := parseQuietHoursWindow(policy.StartTime, policy.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := s.getReceiverLocations(in)
+	if err != nil {
+		return nil, err
+	}
+
+	sendAt := time.Now()
+	if in.ScheduledAt != nil {
+		sendAt = *in.ScheduledAt
+	}
+
+	// 延後後的時間可能落入其他時區的勿擾時段，重複調整直到所有時區皆不在時段內
+	deferred := false
+	for i := 0; i < quietHoursMaxAdjustments; i++ {
+		adjusted := false
+		for _, location := range locations {
+			if end, ok := window.getEnd(sendAt.In(location)); ok {
+				sendAt = end
+				adjusted = true
+				deferred = true
+			}
+		}
+		if !adjusted {
+			break
+		}
+	}
+
+	if !deferred {
+		return nil, nil
+	}
+	return &sendAt, nil
+}
+
+// 取得條件最明確的勿擾時段設定，呼叫端 > 通道 > 優先級
+func (s QuietHoursService) getPolicy(
+	ctx context.Context,
+	clientId string,
+	channel entity.MessageType,
+	priority entity.MessagePriority,
+) (*entity.QuietHoursPolicy, error) {
+	var policy entity.QuietHoursPolicy
+	err := s.db.WithContext(ctx).
+		Where("enabled = ?", true).
+		Where("client_id IS NULL OR client_id = ?", clientId).
+		Where("channel IS NULL OR channel = ?", channel).
+		Where("priority IS NULL OR priority = ?", priority).
+		Order("client_id IS NULL, channel IS NULL, priority IS NULL").
+		Limit(1).
+		Find(&policy).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if policy.Id == "" {
+		return nil, nil
+	}
+	return &policy, nil
+}
+
+// 時區優先使用請求指定值，其次依手機號碼前綴推算，最後使用預設時區
+func (s QuietHoursService) getReceiverLocations(in QuietHoursRequest) ([]*time.Location, error) {
+	if in.Timezone != "" {
+		location, err := time.LoadLocation(in.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		return []*time.Location{location}, nil
+	}
+
+	// 上傳批量發送時尚未取得收件者，使用預設時區
+	if len(in.Receivers) == 0 {
+		return []*time.Location{s.defaultLocation}, nil
+	}
+
+	seen := make(map[string]bool)
+	locations := make([]*time.Location, 0, 1)
+	for _, receiver := range in.Receivers {
+		timezone := ""
+		if in.Channel == entity.MessageType_SMS {
+			timezone = util.GetTimezoneByPhone(receiver)
+		}
+		if seen[timezone] {
+			continue
+		}
+		seen[timezone] = true
+
+		location := s.defaultLocation
+		if timezone != "" {
+			loaded, err := time.LoadLocation(timezone)
+			if err != nil {
+				return nil, err
+			}
+			location = loaded
+		}
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
+
+// 以當日分鐘數表示的勿擾時段，start 大於 end 時表示跨日
+type quietHoursWindow struct {
+	start int
+	end   int
+}
+
+func parseQuietHoursWindow(startTime string, endTime string) (*quietHoursWindow, error) {
+	start, err := time.Parse("15:04", startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quiet hours start time %q: %w", startTime, err)
+	}
+	end, err := time.Parse("15:04", endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quiet hours end time %q: %w", endTime, err)
+	}
+	return &quietHoursWindow{
+		start: start.Hour()*60 + start.Minute(),
+		end:   end.Hour()*60 + end.Minute(),
+	}, nil
+}
+
+// getEnd 當 t 落在勿擾時段內時回傳時段結束時間
+func (w quietHoursWindow) getEnd(t time.Time) (time.Time, bool) {
+	if w.start == w.end {
+		return time.Time{}, false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	endOfToday := time.Date(t.Year(), t.Month(), t.Day(), w.end/60, w.end%60, 0, 0, t.Location())
+
+	if w.start < w.end {
+		if minute >= w.start && minute < w.end {
+			return endOfToday, true
+		}
+		return time.Time{}, false
+	}
+
+	// 跨日時段，例如 22:00 ~ 08:00
+	if minute >= w.start {
+		return endOfToday.AddDate(0, 0, 1), true
+	}
+	if minute < w.end {
+		return endOfToday, true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	errorpb "proto/pkg/notify/v1/error"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type QuotaService struct {
+	BaseService
+	db          *gorm.DB
+	config      *shared.Config
+	rateLimiter *component.RateLimiter
+}
+
+func NewQuotaService(
+	db *gorm.DB,
+	config *shared.Config,
+	rateLimiter *component.RateLimiter,
+) *QuotaService {
+	return &QuotaService{
+		db:          db,
+		config:      config,
+		rateLimiter: rateLimiter,
+	}
+}
+
+// 配額與速率設定，數值為 0 表示不限制
+type quotaPolicy struct {
+	DailyLimit    int64
+	MonthlyLimit  int64
+	RatePerSecond float64
+	RateBurst     int
+}
+
+// 各配額期間的上限
+func (p quotaPolicy) limits() map[entity.QuotaPeriod]int64 {
+	return map[entity.QuotaPeriod]int64{
+		entity.QuotaPeriod_DAILY:   p.DailyLimit,
+		entity.QuotaPeriod_MONTHLY: p.MonthlyLimit,
+	}
+}
+
+// Consume 扣除配額並檢查速率限制，超過時回傳 ResourceExhaustedError；配額不足時不佔用速率令牌，超過速率限制時退還配額
+func (s QuotaService) Consume(ctx context.Context, clientId string, channel entity.MessageType, count int) error {
+	policy, err := s.getPolicy(ctx, clientId, channel)
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("get quota policy failed with db query: %v", err))
+		return s.ServerError("get quota policy failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+
+	if err := s.consumeQuota(ctx, clientId, channel, count, policy); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s:%s", clientId, channel)
+	if ok, retryAfter := s.rateLimiter.Reserve(key, count, policy.RatePerSecond, policy.RateBurst); !ok {
+		s.Refund(ctx, clientId, channel, count)
+		return s.ResourceExhaustedError(
+			fmt.Sprintf("%s send rate limit exceeded", channel),
+			errorpb.ErrorReasonCode_ERR_NOTIFY_RATE_LIMITED,
+			retryAfter,
+		)
+	}
+	return nil
+}
+
+// Refund 退還已扣除的配額，用於扣除配額後發送失敗的請求；退還失敗時僅記錄，不影響原請求的錯誤
+func (s QuotaService) Refund(ctx context.Context, clientId string, channel entity.MessageType, count int) {
+	if count <= 0 {
+		return
+	}
+	if err := s.refundQuota(ctx, clientId, channel, count); err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("refund %d %s quota for client %s failed: %v", count, channel, clientId, err))
+	}
+}
+
+func (s QuotaService) refundQuota(ctx context.Context, clientId string, channel entity.MessageType, count int) error {
+
+	policy, err := s.getPolicy(ctx, clientId, channel)
+	if err != nil {
+		return fmt.Errorf("get quota policy failed: %w", err)
+	}
+	limits := policy.limits()
+
+	// 僅退還有設定上限而實際扣除的期間
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range getQuotaPeriods(time.Now()) {
+			if limits[p.period] <= 0 {
+				continue
+			}
+			err := tx.Model(&entity.QuotaUsage{}).
+				Where("client_id = ?", clientId).
+				Where("channel = ?", channel).
+				Where("period = ?", p.period).
+				Where("period_start = ?", p.start).
+				Updates(map[string]interface{}{
+					"used":       gorm.Expr("GREATEST(used - ?, 0)", count),
+					"updated_at": time.Now(),
+				}).Error
+			if err != nil {
+				return fmt.Errorf("refund %s quota failed: %w", p.period, err)
+			}
+		}
+		return nil
+	})
+}
+
+// 配額計算期間
+type quotaPeriod struct {
+	period entity.QuotaPeriod
+	start  time.Time
+	end    time.Time
+}
+
+func getQuotaPeriods(now time.Time) []quotaPeriod {
+	return []quotaPeriod{
+		{
+			period: entity.QuotaPeriod_DAILY,
+			start:  time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()),
+			end:    time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location()),
+		},
+		{
+			period: entity.QuotaPeriod_MONTHLY,
+			start:  time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()),
+			end:    time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location()),
+		},
+	}
+}
+
+func (s QuotaService) getPolicy(ctx context.Context, clientId string, channel entity.MessageType) (*quotaPolicy, error) {
+	policy := s.getDefaultPolicy(channel)
+
+	var quota entity.ClientQuota
+	err := s.db.WithContext(ctx).
+		Where("client_id = ?", clientId).
+		Where("channel = ?", channel).
+		First(&quota).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return policy, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if quota.DailyLimit != nil {
+		policy.DailyLimit = *quota.DailyLimit
+	}
+	if quota.MonthlyLimit != nil {
+		policy.MonthlyLimit = *quota.MonthlyLimit
+	}
+	if quota.RatePerSecond != nil {
+		policy.RatePerSecond = *quota.RatePerSecond
+	}
+	if quota.RateBurst != nil {
+		policy.RateBurst = *quota.RateBurst
+	}
+	return policy, nil
+}
+
+func (s QuotaService) getDefaultPolicy(channel entity.MessageType) *quotaPolicy {
+	switch channel {
+	case entity.MessageType_SMS:
+		return &quotaPolicy{
+			DailyLimit:    s.config.SmsDailyQuota,
+			MonthlyLimit:  s.config.SmsMonthlyQuota,
+			RatePerSecond: s.config.SmsRatePerSecond,
+			RateBurst:     s.config.SmsRateBurst,
+		}
+	case entity.MessageType_MAIL:
+		return &quotaPolicy{
+			DailyLimit:    s.config.MailDailyQuota,
+			MonthlyLimit:  s.config.MailMonthlyQuota,
+			RatePerSecond: s.config.MailRatePerSecond,
+			RateBurst:     s.config.MailRateBurst,
+		}
+	case entity.MessageType_PUSH:
+		return &quotaPolicy{
+			DailyLimit:    s.config.PushDailyQuota,
+			MonthlyLimit:  s.config.PushMonthlyQuota,
+			RatePerSecond: s.config.PushRatePerSecond,
+			RateBurst:     s.config.PushRateBurst,
+		}
+	case entity.MessageType_LINE:
+		return &quotaPolicy{
+			DailyLimit:    s.config.LineDailyQuota,
+			MonthlyLimit:  s.config.LineMonthlyQuota,
+			RatePerSecond: s.config.LineRatePerSecond,
+			RateBurst:     s.config.LineRateBurst,
+		}
+	case entity.MessageType_WEBHOOK:
+		return &quotaPolicy{
+			DailyLimit:    s.config.WebhookDailyQuota,
+			MonthlyLimit:  s.config.WebhookMonthlyQuota,
+			RatePerSecond: s.config.WebhookRatePerSecond,
+			RateBurst:     s.config.WebhookRateBurst,
+		}
+	default:
+		return &quotaPolicy{}
+	}
+}
+
+func (s QuotaService) consumeQuota(
+	ctx context.Context,
+	clientId string,
+	channel entity.MessageType,
+	count int,
+	policy *quotaPolicy,
+) error {
+	now := time.Now()
+	limits := policy.limits()
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range getQuotaPeriods(now) {
+			limit := limits[p.period]
+			if limit <= 0 {
+				continue
+			}
+
+			exceeded := s.ResourceExhaustedError(
+				fmt.Sprintf("%s %s quota exceeded", channel, p.period),
+				errorpb.ErrorReasonCode_ERR_NOTIFY_QUOTA_EXCEEDED,
+				p.end.Sub(now),
+			)
+			if int64(count) > limit {
+				return exceeded
+			}
+
+			// 僅在累計後未超過上限時才更新，確保多個 server 實例同時扣除時不會超賣
+			var usage entity.QuotaUsage
+			result := tx.Raw(`
+				INSERT INTO notify.quota_usages AS u (client_id, channel, period, period_start, used, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?)
+				ON CONFLICT (client_id, channel, period, period_start)
+				DO UPDATE SET used = u.used + EXCLUDED.used, updated_at = EXCLUDED.updated_at
+				WHERE u.used + EXCLUDED.used <= ?
+				RETURNING u.used`,
+				clientId, channel, p.period, p.start, count, now, now, limit,
+			).Scan(&usage)
+			if result.Error != nil {
+				log.WithContext(ctx).Error(fmt.Sprintf("consume quota failed with db query: %v", result.Error))
+				return s.ServerError("consume quota failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+			}
+			if result.RowsAffected == 0 {
+				return exceeded
+			}
+		}
+		return nil
+	})
+}
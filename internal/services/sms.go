@@ -6,6 +6,7 @@ import (
 	component "notify-service/internal/components"
 	entity "notify-service/internal/entities"
 	smser "notify-service/internal/smser"
+	util "notify-service/internal/utils"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -40,6 +41,15 @@ func (s *SmsService) SendSms(ctx context.Context, targets []entity.Target, messa
 			TargetId: target.Id,
 			Receiver: recevier,
 		}
+
+		variables, err := decodeTargetVariables(s.aesGcm, target)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to decode variables with target id %s", target.Id)
+			continue
+		}
+		if len(variables) > 0 {
+			requestReceviers[i].Message = util.RenderTemplate(message.Data, variables)
+		}
 	}
 
 	request := smser.SmsBatchRequest{
@@ -49,5 +59,9 @@ func (s *SmsService) SendSms(ctx context.Context, targets []entity.Target, messa
 			Message:   message.Data,
 		},
 	}
+	if message.ProviderScheduled {
+		request.Message.ScheduledAt = message.ScheduledAt
+		request.Message.ValidUntil = message.ValidUntil
+	}
 	return s.provider.SendBatchSms(ctx, request)
 }
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	entity "notify-service/internal/entities"
+	smser "notify-service/internal/smser"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// SmsDeliveryService 將發送商回報的送達狀態寫回 targets 與 events，供狀態查詢任務與狀態回呼共用
+type SmsDeliveryService struct {
+	db                 *gorm.DB
+	snowflake          *snowflake.Node
+	suppressionService *SuppressionService
+}
+
+func NewSmsDeliveryService(
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+	suppressionService *SuppressionService,
+) *SmsDeliveryService {
+	return &SmsDeliveryService{
+		db:                 db,
+		snowflake:          snowflake,
+		suppressionService: suppressionService,
+	}
+}
+
+// HandleDeliveryStatus 依發送商的訊息 ID 找出對應的接收者後更新送達狀態，找不到時略過
+func (s SmsDeliveryService) HandleDeliveryStatus(ctx context.Context, provider string, status smser.SmsStatus) error {
+	if !status.Final {
+		return nil
+	}
+
+	var target entity.Target
+	err := s.db.WithContext(ctx).
+		Where("provider = ?", provider).
+		Where("provider_trace_id = ?", status.ProviderTraceId).
+		Order("created_at DESC").
+		Limit(1).
+		Find(&target).
+		Error
+	if err != nil {
+		return err
+	}
+	if target.Id == "" {
+		return nil
+	}
+	return s.UpdateDeliveryStatus(ctx, target, status)
+}
+
+// UpdateDeliveryStatus 送達時標記為 success，失敗時標記為 failed 並將無效門號加入抑制名單；狀態仍會變動時不處理
+func (s SmsDeliveryService) UpdateDeliveryStatus(ctx context.Context, target entity.Target, status smser.SmsStatus) error {
+	if !status.Final {
+		return nil
+	}
+
+	targetStatus, eventStatus := entity.TargetStatus_FAILED, entity.EventStatus_FAILED
+	switch {
+	case status.Delivered:
+		targetStatus, eventStatus = entity.TargetStatus_SUCCESS, entity.EventStatus_DELIVERED
+	case status.InvalidReceiver:
+		eventStatus = entity.EventStatus_INVALID_NUMBER
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 僅更新仍為已發送的目標，避免重複回報或覆蓋其他來源已寫入的狀態
+		result := tx.Model(&entity.Target{}).
+			Where("id = ?", target.Id).
+			Where("status = ?", entity.TargetStatus_SENT).
+			Update("status", targetStatus)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		event := entity.Event{
+			Id:              s.snowflake.Generate().String(),
+			Provider:        string(target.Provider),
+			Status:          eventStatus,
+			ProviderTraceId: target.ProviderTraceId,
+			QueueId:         target.QueueId,
+			Data:            json.RawMessage(status.ProviderResponse),
+			CreatedAt:       time.Now(),
+		}
+		return tx.Create(&event).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	if status.InvalidReceiver {
+		_, err := s.suppressionService.SuppressTarget(
+			ctx,
+			entity.MessageType_SMS,
+			target,
+			entity.SuppressionReason_INVALID_NUMBER,
+			string(target.Provider),
+		)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+				"target_id": target.Id,
+			}).Error("Failed to suppress invalid receiver")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	util "notify-service/internal/utils"
+)
+
+// 未列出的地區使用此鍵對應的價格
+const smsPriceDefaultRegion = "*"
+
+type SmsEstimateService struct {
+	BaseService
+	config      *shared.Config
+	phoneNumber *component.PhoneNumber
+
+	// 依門號地區設定的每段價格
+	prices map[string]float64
+}
+
+func NewSmsEstimateService(
+	config *shared.Config,
+	phoneNumber *component.PhoneNumber,
+) (*SmsEstimateService, error) {
+	prices := make(map[string]float64)
+	if config.SmsPriceTable != "" {
+		if err := json.Unmarshal([]byte(config.SmsPriceTable), &prices); err != nil {
+			return nil, fmt.Errorf("invalid sms price table: %w", err)
+		}
+	}
+
+	return &SmsEstimateService{
+		config:      config,
+		phoneNumber: phoneNumber,
+		prices:      prices,
+	}, nil
+}
+
+// SmsEstimate 簡訊編碼、分段數與預估費用
+type SmsEstimate struct {
+	util.SmsAnalysis
+	Receivers     []SmsReceiverEstimate
+	EstimatedCost float64
+}
+
+type SmsReceiverEstimate struct {
+	Receiver string
+	Region   string
+	Segments int
+	Cost     float64
+}
+
+// Estimate 依簡訊內容計算分段數，並依各收件者門號地區的每段價格估算費用
+func (s SmsEstimateService) Estimate(body string, receivers []string) *SmsEstimate {
+	estimate := &SmsEstimate{
+		SmsAnalysis: util.AnalyzeSms(body),
+		Receivers:   make([]SmsReceiverEstimate, 0, len(receivers)),
+	}
+
+	for _, receiver := range receivers {
+		region := s.phoneNumber.GetRegion(receiver)
+		cost := float64(estimate.Segments) * s.getPrice(region)
+		estimate.Receivers = append(estimate.Receivers, SmsReceiverEstimate{
+			Receiver: receiver,
+			Region:   region,
+			Segments: estimate.Segments,
+			Cost:     cost,
+		})
+		estimate.EstimatedCost += cost
+	}
+	return estimate
+}
+
+func (s SmsEstimateService) getPrice(region string) float64 {
+	if price, ok := s.prices[region]; ok {
+		return price
+	}
+	return s.prices[smsPriceDefaultRegion]
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+	util "notify-service/internal/utils"
+	errorpb "proto/pkg/notify/v1/error"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SuppressionService struct {
+	BaseService
+	db        *gorm.DB
+	snowflake *snowflake.Node
+	aesGcm    *component.AesGcm
+}
+
+func NewSuppressionService(
+	db *gorm.DB,
+	snowflake *snowflake.Node,
+	aesGcm *component.AesGcm,
+) *SuppressionService {
+	return &SuppressionService{
+		db:        db,
+		snowflake: snowflake,
+		aesGcm:    aesGcm,
+	}
+}
+
+// GetSuppressedHashes 回傳在抑制名單中且尚未到期的接收者雜湊值
+func (s SuppressionService) GetSuppressedHashes(
+	ctx context.Context,
+	channel entity.MessageType,
+	receiverHashes []string,
+) (map[string]bool, error) {
+	suppressed := make(map[string]bool)
+	if len(receiverHashes) == 0 {
+		return suppressed, nil
+	}
+
+	for _, chunk := range util.ChunkArray(receiverHashes, dbBatchSize) {
+		var hashes []string
+		err := s.db.WithContext(ctx).
+			Model(&entity.Suppression{}).
+			Where("channel = ?", channel).
+			Where("receiver_hash IN ?", chunk).
+			Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+			Pluck("receiver_hash", &hashes).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hash := range hashes {
+			suppressed[hash] = true
+		}
+	}
+	return suppressed, nil
+}
+
+// SuppressReceiver 將明文接收者加入抑制名單
+func (s SuppressionService) SuppressReceiver(
+	ctx context.Context,
+	channel entity.MessageType,
+	receiver string,
+	reason entity.SuppressionReason,
+	source string,
+	expiresAt *time.Time,
+) (*entity.Suppression, error) {
+	receiverEncrypted, err := s.aesGcm.AesEncrypt(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.upsert(ctx, &entity.Suppression{
+		Id:           s.snowflake.Generate().String(),
+		ReceiverHash: util.Md5(receiver),
+		Receiver:     receiverEncrypted,
+		Channel:      channel,
+		Reason:       reason,
+		Source:       source,
+		ExpiresAt:    expiresAt,
+	})
+}
+
+// SuppressTarget 將發送紀錄的接收者加入抑制名單，沿用已加密的接收者
+func (s SuppressionService) SuppressTarget(
+	ctx context.Context,
+	channel entity.MessageType,
+	target entity.Target,
+	reason entity.SuppressionReason,
+	source string,
+) (*entity.Suppression, error) {
+	return s.upsert(ctx, &entity.Suppression{
+		Id:           s.snowflake.Generate().String(),
+		ReceiverHash: target.ReceiverHash,
+		Receiver:     target.Receiver,
+		Channel:      channel,
+		Reason:       reason,
+		Source:       source,
+	})
+}
+
+// ProviderEvent 發送商回呼的退信、檢舉等事件
+type ProviderEvent struct {
+	Channel         entity.MessageType
+	Receiver        string
+	Provider        string
+	ProviderTraceId string
+	Status          entity.EventStatus
+	Reason          entity.SuppressionReason
+	Data            json.RawMessage
+}
+
+// IngestProviderEvent 記錄發送商事件並將接收者加入抑制名單，找不到對應的發送紀錄時略過，避免偽造的事件將任意接收者加入抑制名單
+func (s SuppressionService) IngestProviderEvent(ctx context.Context, in ProviderEvent) error {
+	var target entity.Target
+	err := s.db.WithContext(ctx).
+		Where("receiver_hash = ?", util.Md5(in.Receiver)).
+		Where("provider_trace_id = ?", in.ProviderTraceId).
+		Order("created_at DESC").
+		Limit(1).
+		Find(&target).
+		Error
+	if err != nil {
+		return err
+	}
+
+	if target.Id == "" {
+		log.WithContext(ctx).WithFields(log.Fields{
+			"provider":          in.Provider,
+			"provider_trace_id": in.ProviderTraceId,
+		}).Warn("No target matches provider event, skip suppression")
+		return nil
+	}
+
+	event := entity.Event{
+		Id:              s.snowflake.Generate().String(),
+		Provider:        in.Provider,
+		Status:          in.Status,
+		ProviderTraceId: in.ProviderTraceId,
+		QueueId:         target.QueueId,
+		Data:            in.Data,
+		CreatedAt:       time.Now(),
+	}
+	if err := s.db.WithContext(ctx).Create(&event).Error; err != nil {
+		return err
+	}
+
+	_, err = s.SuppressTarget(ctx, in.Channel, target, in.Reason, in.Provider)
+	return err
+}
+
+func (s SuppressionService) AddSuppression(ctx context.Context, in model.AddSuppressionRequest) (*entity.Suppression, error) {
+	suppression, err := s.SuppressReceiver(
+		ctx,
+		entity.MessageType(in.Channel),
+		in.Receiver,
+		entity.SuppressionReason(in.Reason),
+		entity.SuppressionSource_ADMIN,
+		in.ExpiresAt,
+	)
+	if err != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("add suppression failed: %v", err))
+		return nil, s.ServerError("add suppression failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	return suppression, nil
+}
+
+func (s SuppressionService) RemoveSuppression(ctx context.Context, in model.RemoveSuppressionRequest) error {
+	result := s.db.WithContext(ctx).
+		Where("channel = ?", in.Channel).
+		Where("receiver_hash = ?", util.Md5(in.Receiver)).
+		Delete(&entity.Suppression{})
+	if result.Error != nil {
+		log.WithContext(ctx).Error(fmt.Sprintf("remove suppression failed with db query: %v", result.Error))
+		return s.ServerError("remove suppression failed with db query", errorpb.ErrorReasonCode_ERR_COMMON_INTERNAL)
+	}
+	if result.RowsAffected == 0 {
+		return s.NotFoundError("suppression not found", errorpb.ErrorReasonCode_ERR_NOTIFY_SUPPRESSION_NOT_FOUND)
+	}
+	return nil
+}
+
+func (s SuppressionService) ListSuppressionsWithPaging(ctx context.Context, in model.ListSuppressionsRequest) ([]*entity.Suppression, int64, error) {
+	var suppressions []*entity.Suppression
+
+	query := s.db.WithContext(ctx).
+		Model(&entity.Suppression{}).
+		Where("channel = ?", in.Channel)
+
+	if in.Receiver != "" {
+		query = query.Where("receiver_hash = ?", util.Md5(in.Receiver))
+	}
+
+	if in.Reason != "" {
+		query = query.Where("reason = ?", in.Reason)
+	}
+
+	var total int64
+	query.Count(&total)
+
+	// 排序欄位已於請求驗證限制在允許的欄位內
+	if in.SortField != "" && in.SortOrder != "" {
+		query = query.Order(fmt.Sprintf("%s %s", in.SortField, in.SortOrder))
+	}
+
+	if in.Page.Index > 0 && in.Page.Size > 0 {
+		query = query.Offset(int((in.Page.Index - 1) * in.Page.Size)).Limit(int(in.Page.Size))
+	}
+	err := query.Find(&suppressions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return suppressions, total, nil
+}
+
+// 同一接收者與通道僅保留一筆，重複加入時更新原因與到期時間，並還原已移除的紀錄
+func (s SuppressionService) upsert(ctx context.Context, suppression *entity.Suppression) (*entity.Suppression, error) {
+	now := time.Now()
+	var result entity.Suppression
+	err := s.db.WithContext(ctx).Raw(`
+		INSERT INTO notify.suppressions AS s (id, receiver_hash, receiver, channel, reason, source, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (receiver_hash, channel)
+		DO UPDATE SET reason = EXCLUDED.reason, source = EXCLUDED.source, expires_at = EXCLUDED.expires_at,
+			updated_at = EXCLUDED.updated_at, deleted_at = NULL
+		RETURNING s.*`,
+		suppression.Id, suppression.ReceiverHash, suppression.Receiver, suppression.Channel,
+		suppression.Reason, suppression.Source, suppression.ExpiresAt, now, now,
+	).Scan(&result).Error
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
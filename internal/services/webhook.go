@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	model "notify-service/internal/models"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	WebhookHeader_MESSAGE_ID = "X-Notify-Message-Id"
+	WebhookHeader_TIMESTAMP  = "X-Notify-Timestamp"
+	WebhookHeader_SIGNATURE  = "X-Notify-Signature"
+
+	// 未設定逾時秒數時的請求逾時
+	defaultWebhookTimeout = 10 * time.Second
+	// 事件中保存的回應內容長度上限
+	webhookResponseBodyLimit = 1024
+)
+
+// 除私有、迴路與鏈路本地位址外，其餘不應由外部推送連線的保留網段
+var blockedWebhookNetworks = mustParseCidrs(
+	"0.0.0.0/8",     // 本網路
+	"100.64.0.0/10", // 電信級 NAT
+	"192.0.0.0/24",  // IETF 協定保留
+	"198.18.0.0/15", // 網路效能測試
+)
+
+// WebhookResponse 推送結果，請求未送出時 StatusCode 為 0 並帶有錯誤訊息
+type WebhookResponse struct {
+	StatusCode int    `json:"status_code"`
+	Body       string `json:"body,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// 回應狀態碼為 2xx 時視為推送成功
+func (r WebhookResponse) IsSuccess() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+type WebhookService struct {
+	config *shared.Config
+	resty  *resty.Client
+	aesGcm *component.AesGcm
+}
+
+func NewWebhookService(
+	config *shared.Config,
+	aesGcm *component.AesGcm,
+) (*WebhookService, error) {
+	allowed, err := parseWebhookAllowedCidrs(config.WebhookAllowedCidrs)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookService{
+		config: config,
+		resty:  newWebhookRestyClient(allowed),
+		aesGcm: aesGcm,
+	}, nil
+}
+
+// Send 依訊息內容送出 HTTP 請求，設定簽章密鑰時以 HMAC-SHA256 簽署「時間戳記.請求內容」，
+// 簽章標頭會覆寫同名的自訂標頭
+func (s *WebhookService) Send(ctx context.Context, target entity.Target, message entity.Message) WebhookResponse {
+	url, err := s.aesGcm.AesDecrypt(target.Receiver)
+	if err != nil {
+		return WebhookResponse{Error: "decrypt webhook url failed"}
+	}
+
+	headers, err := decodeTargetVariables(s.aesGcm, target)
+	if err != nil {
+		return WebhookResponse{Error: "decode webhook headers failed"}
+	}
+
+	var content model.WebhookContent
+	if err := json.Unmarshal([]byte(message.Data), &content); err != nil {
+		return WebhookResponse{Error: "invalid webhook content"}
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request := s.resty.R().
+		SetHeaders(headers).
+		SetHeader(WebhookHeader_MESSAGE_ID, message.Id).
+		SetHeader(WebhookHeader_TIMESTAMP, timestamp)
+	if s.config.WebhookSigningSecret != "" {
+		request.SetHeader(WebhookHeader_SIGNATURE, "sha256="+signWebhook(s.config.WebhookSigningSecret, timestamp, content.Body))
+	}
+	if content.Body != "" {
+		request.SetBody(content.Body)
+	}
+
+	timeout := defaultWebhookTimeout
+	if s.config.WebhookTimeoutSeconds > 0 {
+		timeout = time.Duration(s.config.WebhookTimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	startAt := time.Now()
+	resp, err := request.SetContext(ctx).Execute(content.Method, url)
+	duration := time.Since(startAt).Milliseconds()
+	if err != nil {
+		return WebhookResponse{Error: err.Error(), DurationMs: duration}
+	}
+
+	body := resp.String()
+	if len(body) > webhookResponseBodyLimit {
+		body = body[:webhookResponseBodyLimit]
+	}
+	return WebhookResponse{
+		StatusCode: resp.StatusCode(),
+		Body:       body,
+		DurationMs: duration,
+	}
+}
+
+func signWebhook(secret string, timestamp string, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// 推送網址由呼叫端指定，於連線時檢查解析後的 IP，拒絕私有、迴路與鏈路本地等內部位址，
+// 重新導向與 DNS 重新綁定皆會再次經過檢查；不使用代理伺服器以免繞過檢查
+func newWebhookRestyClient(allowed []*net.IPNet) *resty.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isAllowedWebhookIp(ip, allowed) {
+				return fmt.Errorf("webhook address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return resty.NewWithClient(&http.Client{Transport: otelhttp.NewTransport(transport)})
+}
+
+// WEBHOOK_ALLOWED_CIDRS 中的網段優先允許，供推送至內部服務使用
+func isAllowedWebhookIp(ip net.IP, allowed []*net.IPNet) bool {
+	for _, network := range allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range blockedWebhookNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// 以逗號分隔的 CIDR 網段，例如 10.0.1.0/24,fd00::/8
+func parseWebhookAllowedCidrs(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(value, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook allowed cidr %q: %w", cidr, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func mustParseCidrs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsAllowedWebhookIp(t *testing.T) {
+	allowed, err := parseWebhookAllowedCidrs("10.0.1.0/24, fd00:1::/32")
+	if err != nil {
+		t.Fatalf("parse allowed cidrs: %v", err)
+	}
+
+	tests := map[string]bool{
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"10.0.0.1":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fc00::1":          false,
+		"0.0.0.0":          false,
+		"100.64.0.1":       false,
+		"::ffff:127.0.0.1": false,
+		"10.0.1.5":         true,
+		"fd00:1::5":        true,
+	}
+	for address, want := range tests {
+		if got := isAllowedWebhookIp(net.ParseIP(address), allowed); got != want {
+			t.Errorf("isAllowedWebhookIp(%s) = %v, want %v", address, got, want)
+		}
+	}
+}
+
+func TestParseWebhookAllowedCidrsInvalid(t *testing.T) {
+	if _, err := parseWebhookAllowedCidrs("10.0.0.0/33"); err == nil {
+		t.Fatal("expected error for invalid cidr")
+	}
+}
+
+func TestWebhookRestyClientRejectsLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if _, err := newWebhookRestyClient(nil).R().Get(server.URL); err == nil {
+		t.Fatal("expected loopback webhook request to be rejected")
+	}
+
+	allowed, err := parseWebhookAllowedCidrs("127.0.0.0/8")
+	if err != nil {
+		t.Fatalf("parse allowed cidrs: %v", err)
+	}
+	resp, err := newWebhookRestyClient(allowed).R().Get(server.URL)
+	if err != nil {
+		t.Fatalf("allowed webhook request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", resp.StatusCode(), http.StatusNoContent)
+	}
+}
+
+// 重新導向至未允許的內部位址時同樣於連線時拒絕
+func TestWebhookRestyClientRejectsRedirectToLoopback(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.2:0")
+	if err != nil {
+		t.Skipf("listen on 127.0.0.2: %v", err)
+	}
+	internal := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		t.Error("internal server should not be reached")
+	}))
+	internal.Listener.Close()
+	internal.Listener = listener
+	internal.Start()
+	defer internal.Close()
+
+	redirect := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		http.Redirect(rw, r, internal.URL, http.StatusFound)
+	}))
+	defer redirect.Close()
+
+	allowed, err := parseWebhookAllowedCidrs("127.0.0.1/32")
+	if err != nil {
+		t.Fatalf("parse allowed cidrs: %v", err)
+	}
+	if _, err := newWebhookRestyClient(allowed).R().Get(redirect.URL); err == nil {
+		t.Fatal("expected redirect to loopback to be rejected")
+	}
+}
@@ -6,35 +6,142 @@ const (
 )
 
 const (
-	KafkaTopicSms      = "notify-sms"
-	KafkaTopicMail     = "notify-mail"
-	KafkaGroupIdNotify = "notify"
+	DEFAULT_MAIL_SENDER_ADDRESS = "notify@notify.com"
+	DEFAULT_MAIL_SENDER_NAME    = "Notify"
+)
+
+const (
+	KafkaTopicSms          = "notify-sms"
+	KafkaTopicSmsHigh      = "notify-sms-high"
+	KafkaTopicSmsBulk      = "notify-sms-bulk"
+	KafkaTopicMail         = "notify-mail"
+	KafkaTopicMailHigh     = "notify-mail-high"
+	KafkaTopicMailBulk     = "notify-mail-bulk"
+	KafkaTopicPush         = "notify-push"
+	KafkaTopicPushHigh     = "notify-push-high"
+	KafkaTopicPushBulk     = "notify-push-bulk"
+	KafkaTopicLine         = "notify-line"
+	KafkaTopicLineHigh     = "notify-line-high"
+	KafkaTopicLineBulk     = "notify-line-bulk"
+	KafkaTopicWebhook      = "notify-webhook"
+	KafkaGroupIdNotify     = "notify"
+	KafkaGroupIdNotifyHigh = "notify-high"
+	KafkaGroupIdNotifyBulk = "notify-bulk"
 )
 
 type Config struct {
-	GrpcPort                      int
-	PostgresHost                  string
-	PostgresPort                  int
-	PostgresUser                  string
-	PostgresPassword              string
-	PostgresDb                    string
-	PostgresSchema                string
-	DbMaxIdleConns                int
-	DbMaxOpenConns                int
-	OtlpEndpoint                  string
-	OtlpServiceName               string
-	LogFormat                     string
-	ScheduleLimitDays             int
-	AESKey                        string
-	SmsProvider                   string
-	SmsProviderBatchLimit         int
-	MailProvider                  string
-	MailProviderBatchLimit        int
-	KafkaBrokers                  string
-	KafkaVersion                  string
-	KafkaConsumerGroupInstanceNum int
-	JobName                       string
-	SendgridToken                 string
-	MitakeUserName                string
-	MitakePassword                string
+	GrpcPort                          int
+	PostgresHost                      string
+	PostgresPort                      int
+	PostgresUser                      string
+	PostgresPassword                  string
+	PostgresDb                        string
+	PostgresSchema                    string
+	DbMaxIdleConns                    int
+	DbMaxOpenConns                    int
+	OtlpEndpoint                      string
+	OtlpServiceName                   string
+	LogFormat                         string
+	ScheduleLimitDays                 int
+	AESKey                            string
+	SmsProvider                       string
+	SmsProviderBatchLimit             int
+	MailProvider                      string
+	MailProviderBatchLimit            int
+	KafkaBrokers                      string
+	KafkaVersion                      string
+	KafkaConsumerGroupInstanceNum     int
+	KafkaHighConsumerGroupInstanceNum int
+	KafkaBulkConsumerGroupInstanceNum int
+	JobName                           string
+	SendgridToken                     string
+	MitakeUserName                    string
+	MitakePassword                    string
+	MitakeApiBaseUrl                  string
+	SmsDailyQuota                     int64
+	SmsMonthlyQuota                   int64
+	SmsRatePerSecond                  float64
+	SmsRateBurst                      int
+	MailDailyQuota                    int64
+	MailMonthlyQuota                  int64
+	MailRatePerSecond                 float64
+	MailRateBurst                     int
+	PushDailyQuota                    int64
+	PushMonthlyQuota                  int64
+	PushRatePerSecond                 float64
+	PushRateBurst                     int
+	LineDailyQuota                    int64
+	LineMonthlyQuota                  int64
+	LineRatePerSecond                 float64
+	LineRateBurst                     int
+	WebhookDailyQuota                 int64
+	WebhookMonthlyQuota               int64
+	WebhookRatePerSecond              float64
+	WebhookRateBurst                  int
+	SmsProviderRatePerSecond          float64
+	SmsProviderRateBurst              int
+	MailProviderRatePerSecond         float64
+	MailProviderRateBurst             int
+	OtpCodeLength                     int
+	OtpDefaultTtlSeconds              int
+	OtpMaxTtlSeconds                  int
+	OtpMaxAttempts                    int
+	OtpResendCooldownSeconds          int
+	OtpSmsTemplate                    string
+	OtpMailSubject                    string
+	OtpMailTemplate                   string
+	HttpPort                          int
+	SendgridWebhookVerificationKey    string
+	SmsOptOutKeywords                 string
+	SmsOptOutConfirmationEnabled      bool
+	SmsOptOutConfirmationTemplates    string
+	MitakeMoToken                     string
+	ClientApiKeys                     string
+	QuietHoursDefaultTimezone         string
+	BulkSendBatchSize                 int
+	ErrorLocale                       string
+	PhoneDefaultRegion                string
+	SmsInternationalProvider          string
+	SmsMaxSegments                    int
+	SmsPriceTable                     string
+	SmsPriceCurrency                  string
+	SmsStatusPollBatchSize            int
+	SmsStatusPollHours                int
+	SmsProviderScheduleMinHours       int
+	TwilioAccountSid                  string
+	TwilioAuthToken                   string
+	TwilioFromNumber                  string
+	TwilioMessagingServiceSid         string
+	TwilioStatusCallbackUrl           string
+	TwilioApiBaseUrl                  string
+	TwilioConcurrency                 int
+	Every8dUserId                     string
+	Every8dPassword                   string
+	Every8dApiBaseUrl                 string
+	SesRegion                         string
+	SesAccessKeyId                    string
+	SesSecretAccessKey                string
+	SesApiBaseUrl                     string
+	MailgunDomain                     string
+	MailgunApiKey                     string
+	MailgunApiBaseUrl                 string
+	WebhookSigningSecret              string
+	WebhookTimeoutSeconds             int
+	WebhookAllowedCidrs               string
+	PushProvider                      string
+	PushIosProvider                   string
+	PushProviderBatchLimit            int
+	PushConcurrency                   int
+	PushTlsCaFile                     string
+	FcmCredentialsFile                string
+	FcmApiBaseUrl                     string
+	ApnsKeyFile                       string
+	ApnsKeyId                         string
+	ApnsTeamId                        string
+	ApnsTopic                         string
+	ApnsApiBaseUrl                    string
+	LineProviderBatchLimit            int
+	LineChannelAccessToken            string
+	LineChannelSecret                 string
+	LineApiBaseUrl                    string
 }
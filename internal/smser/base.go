@@ -1,11 +1,22 @@
 package smser
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type SmsProvider interface {
 	SendBatchSms(ctx context.Context, request SmsBatchRequest) SmsBatchResponse
 }
 
+// SmsSendStatus 各發送商共用的發送結果，SmsBatchResponse 與 SmsResponse 的 Status 皆使用此值
+type SmsSendStatus string
+
+const (
+	SmsSendStatus_SENT   SmsSendStatus = "sent"   // 已發送
+	SmsSendStatus_FAILED SmsSendStatus = "failed" // 失敗
+)
+
 type MitakeStatus string
 
 const (
@@ -16,11 +27,14 @@ const (
 type SmsReceiver struct {
 	TargetId string `json:"target_id"`
 	Receiver string `json:"receiver"`
+	Message  string `json:"message"` // 個人化後的內容，空值時使用 SmsMessage.Message
 }
 
 type SmsMessage struct {
-	MessageId string `json:"target_id"`
-	Message   string `json:"message"`
+	MessageId   string     `json:"target_id"`
+	Message     string     `json:"message"`
+	ScheduledAt *time.Time `json:"scheduled_at"` // 交由發送商預約發送的時間，空值時立即發送
+	ValidUntil  *time.Time `json:"valid_until"`  // 交由發送商預約發送時的有效期限
 }
 
 type SmsBatchRequest struct {
@@ -31,10 +45,38 @@ type SmsBatchRequest struct {
 type SmsResponse struct {
 	Status           string `json:"status"`
 	TraceId          string `json:"trace_id"`
+	ProviderTraceId  string `json:"provider_trace_id"` // 發送商的訊息 ID，用於查詢送達狀態
 	ProviderResponse string `json:"provider_response"`
+	InvalidReceiver  bool   `json:"invalid_receiver"` // 發送商回報門號無效，需加入抑制名單
+	Provider         string `json:"provider"`         // 依門號地區分流時實際使用的發送商，空值時為預設發送商
 }
 type SmsBatchResponse struct {
 	Status      string        `json:"status"`
 	MessageId   string        `json:"message_id"`
 	SmsResponse []SmsResponse `json:"sms_response"`
 }
+
+// SmsStatusQuerier 可查詢發送後送達狀態的發送商，發送商不支援時不需實作
+type SmsStatusQuerier interface {
+	QuerySmsStatus(ctx context.Context, providerTraceIds []string) ([]SmsStatus, error)
+}
+
+type SmsStatus struct {
+	ProviderTraceId  string `json:"provider_trace_id"`
+	Status           string `json:"status"`            // sent 或 failed
+	Delivered        bool   `json:"delivered"`         // 已送達手機
+	Final            bool   `json:"final"`             // 狀態不會再變動，不需再查詢
+	InvalidReceiver  bool   `json:"invalid_receiver"`  // 發送商回報門號無效，需加入抑制名單
+	ProviderResponse string `json:"provider_response"` // 發送商回傳的原始狀態
+}
+
+// SmsCanceler 可取消已交由發送商預約發送之簡訊的發送商，發送商不支援時不需實作
+type SmsCanceler interface {
+	CancelSms(ctx context.Context, providerTraceIds []string) ([]SmsCancelResult, error)
+}
+
+type SmsCancelResult struct {
+	ProviderTraceId  string `json:"provider_trace_id"`
+	Canceled         bool   `json:"canceled"`
+	ProviderResponse string `json:"provider_response"`
+}
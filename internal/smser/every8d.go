@@ -0,0 +1,255 @@
+package smser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+
+	shared "notify-service/internal"
+)
+
+const (
+	Every8dAPIDomain = "https://api.e8d.tw"
+	Every8dTokenPath = "/API21/HTTP/ConnectionHandler.ashx"
+	Every8dSendPath  = "/API21/HTTP/SendSMS.ashx"
+
+	// 取得 token 的 HandlerType 與 VerifyType
+	every8dHandlerType_TOKEN = 3
+	every8dVerifyType_TOKEN  = 1
+
+	// token 快取時間，逾時或 API 回傳 401 時重新取得
+	every8dTokenTTL = 12 * time.Hour
+
+	every8dTimeLayout = "20060102150405"
+)
+
+var every8dTimeLocation = time.FixedZone("Asia/Taipei", 8*60*60)
+
+// Every8dSmser 使用 EVERY8D API 2.1 發送，以 Bearer token 驗證，token 於程序內快取並在逾時或失效時重新取得
+type Every8dSmser struct {
+	config *shared.Config
+	resty  *resty.Client
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewEvery8dSmser(config *shared.Config, resty *resty.Client) *Every8dSmser {
+	return &Every8dSmser{config: config, resty: resty}
+}
+
+type every8dTokenRequest struct {
+	HandlerType int    `json:"HandlerType"`
+	VerifyType  int    `json:"VerifyType"`
+	UID         string `json:"UID"`
+	PWD         string `json:"PWD"`
+}
+
+type every8dTokenResponse struct {
+	Result bool   `json:"Result"`
+	Status string `json:"Status"`
+	Msg    string `json:"Msg"`
+}
+
+// Every8dResponse 發送 API 的回應，格式為 CREDIT,SENDED,COST,UNSEND,BATCH_ID
+type Every8dResponse struct {
+	Credit  string
+	Sended  string
+	Cost    string
+	Unsend  string
+	BatchId string
+}
+
+// EVERY8D 一次請求的收件者共用同一份內容，個人化內容不同的收件者依內容分批送出
+func (s *Every8dSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest) SmsBatchResponse {
+	var contents []string
+	receiversByContent := make(map[string][]SmsReceiver)
+	for _, receiver := range request.Receivers {
+		content := request.Message.Message
+		if receiver.Message != "" {
+			content = receiver.Message
+		}
+		if _, ok := receiversByContent[content]; !ok {
+			contents = append(contents, content)
+		}
+		receiversByContent[content] = append(receiversByContent[content], receiver)
+	}
+
+	response := SmsBatchResponse{
+		Status:    string(SmsSendStatus_FAILED),
+		MessageId: request.Message.MessageId,
+	}
+	for _, content := range contents {
+		smsResponses, ok := s.sendSms(ctx, request.Message, content, receiversByContent[content])
+		if ok {
+			response.Status = string(SmsSendStatus_SENT)
+		}
+		response.SmsResponse = append(response.SmsResponse, smsResponses...)
+	}
+	return response
+}
+
+func (s *Every8dSmser) sendSms(ctx context.Context, message SmsMessage, content string, receivers []SmsReceiver) ([]SmsResponse, bool) {
+	destinations := make([]string, 0, len(receivers))
+	for _, receiver := range receivers {
+		destinations = append(destinations, formatTaiwanPhone(receiver.Receiver))
+	}
+
+	formData := map[string]string{
+		"SB":   "",
+		"MSG":  content,
+		"DEST": strings.Join(destinations, ","),
+		"ST":   formatEvery8dTime(message.ScheduledAt),
+	}
+
+	resp, err := s.post(ctx, Every8dSendPath, formData)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Every8d request failed")
+		return newEvery8dFailedResponses(receivers, "REQUEST_FAILED"), false
+	}
+
+	result, ok := parseEvery8dResponse(resp.String())
+	if !ok {
+		log.WithContext(ctx).WithFields(log.Fields{
+			"body": resp.String(),
+		}).Error("Every8d rejected message")
+		return newEvery8dFailedResponses(receivers, strings.TrimSpace(resp.String())), false
+	}
+
+	// 批次 ID 由同一請求的收件者共用，記錄於發送商回應；收件者以各自的 target id 作為追蹤 ID
+	providerResponse, err := json.Marshal(map[string]interface{}{
+		"BatchId": result.BatchId,
+		"Credit":  result.Credit,
+		"Sended":  result.Sended,
+		"Cost":    result.Cost,
+		"Unsend":  result.Unsend,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to marshal provider response")
+	}
+
+	smsResponses := make([]SmsResponse, 0, len(receivers))
+	for _, receiver := range receivers {
+		smsResponses = append(smsResponses, SmsResponse{
+			Status:           string(SmsSendStatus_SENT),
+			TraceId:          receiver.TargetId,
+			ProviderResponse: string(providerResponse),
+		})
+	}
+	return smsResponses, true
+}
+
+// token 失效時 API 回傳 401，重新取得 token 後重試一次
+func (s *Every8dSmser) post(ctx context.Context, path string, formData map[string]string) (*resty.Response, error) {
+	for attempt := 0; ; attempt++ {
+		token, err := s.getToken(ctx, attempt > 0)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.resty.R().
+			SetContext(ctx).
+			SetAuthToken(token).
+			SetFormData(formData).
+			Post(s.getApiDomain() + path)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() == http.StatusUnauthorized && attempt == 0 {
+			continue
+		}
+		if resp.IsError() {
+			return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode())
+		}
+		return resp, nil
+	}
+}
+
+func (s *Every8dSmser) getToken(ctx context.Context, refresh bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !refresh && s.token != "" && time.Now().Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	var result every8dTokenResponse
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetBody(every8dTokenRequest{
+			HandlerType: every8dHandlerType_TOKEN,
+			VerifyType:  every8dVerifyType_TOKEN,
+			UID:         s.config.Every8dUserId,
+			PWD:         s.config.Every8dPassword,
+		}).
+		Post(s.getApiDomain() + Every8dTokenPath)
+	if err != nil {
+		return "", fmt.Errorf("get every8d token failed: %w", err)
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("get every8d token failed with status code %d", resp.StatusCode())
+	}
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return "", fmt.Errorf("invalid every8d token response: %w", err)
+	}
+	if !result.Result || result.Msg == "" {
+		return "", fmt.Errorf("get every8d token failed with status %s", result.Status)
+	}
+
+	s.token = result.Msg
+	s.expiresAt = time.Now().Add(every8dTokenTTL)
+	return s.token, nil
+}
+
+func (s *Every8dSmser) getApiDomain() string {
+	if s.config.Every8dApiBaseUrl != "" {
+		return strings.TrimRight(s.config.Every8dApiBaseUrl, "/")
+	}
+	return Every8dAPIDomain
+}
+
+// 回應第一個欄位 (剩餘點數) 為負數時代表發送失敗，內容為錯誤代碼與訊息
+func parseEvery8dResponse(content string) (Every8dResponse, bool) {
+	fields := strings.Split(strings.TrimSpace(content), ",")
+	if len(fields) < 5 || strings.HasPrefix(fields[0], "-") {
+		return Every8dResponse{}, false
+	}
+	return Every8dResponse{
+		Credit:  fields[0],
+		Sended:  fields[1],
+		Cost:    fields[2],
+		Unsend:  fields[3],
+		BatchId: fields[4],
+	}, true
+}
+
+func newEvery8dFailedResponses(receivers []SmsReceiver, reason string) []SmsResponse {
+	providerResponse, _ := json.Marshal(map[string]interface{}{
+		"StatusMessage": reason,
+	})
+
+	smsResponses := make([]SmsResponse, 0, len(receivers))
+	for _, receiver := range receivers {
+		smsResponses = append(smsResponses, SmsResponse{
+			Status:           string(SmsSendStatus_FAILED),
+			TraceId:          receiver.TargetId,
+			ProviderResponse: string(providerResponse),
+		})
+	}
+	return smsResponses
+}
+
+func formatEvery8dTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.In(every8dTimeLocation).Format(every8dTimeLayout)
+}
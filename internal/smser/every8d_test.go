@@ -0,0 +1,147 @@
+package smser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+
+	shared "notify-service/internal"
+)
+
+func newTestEvery8dSmser(t *testing.T, handler http.HandlerFunc) *Every8dSmser {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewEvery8dSmser(&shared.Config{
+		Every8dUserId:     "user",
+		Every8dPassword:   "password",
+		Every8dApiBaseUrl: server.URL,
+	}, resty.New())
+}
+
+// token 失效時重新取得 token 後重試，收件者以各自的 target id 追蹤，批次 ID 記錄於發送商回應
+func TestEvery8dSmserRefreshesTokenOnUnauthorized(t *testing.T) {
+	tokens := 0
+	sends := 0
+	smser := newTestEvery8dSmser(t, func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case Every8dTokenPath:
+			var request every8dTokenRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Fatalf("decode token request: %v", err)
+			}
+			if request.UID != "user" || request.PWD != "password" {
+				t.Errorf("token request = %+v", request)
+			}
+			tokens++
+			token := "expired"
+			if tokens > 1 {
+				token = "fresh"
+			}
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"Result":true,"Status":"0","Msg":"` + token + `"}`))
+		case Every8dSendPath:
+			sends++
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if err := r.ParseForm(); err != nil {
+				t.Fatalf("parse form: %v", err)
+			}
+			if got := r.PostForm.Get("DEST"); got != "0912345678,0987654321" {
+				t.Errorf("DEST = %q", got)
+			}
+			rw.Write([]byte("98.0,2,2,0,220478cc-8506-49b2-93b7-2505f651c12e"))
+		default:
+			t.Errorf("path = %s", r.URL.Path)
+		}
+	})
+
+	response := smser.SendBatchSms(context.Background(), SmsBatchRequest{
+		Receivers: []SmsReceiver{
+			{TargetId: "target-1", Receiver: "+886912345678"},
+			{TargetId: "target-2", Receiver: "+886987654321"},
+		},
+		Message: SmsMessage{MessageId: "message-1", Message: "hello"},
+	})
+
+	if tokens != 2 || sends != 2 {
+		t.Fatalf("tokens = %d, sends = %d, want 2 and 2", tokens, sends)
+	}
+	if response.Status != string(MitakeStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, MitakeStatus_SENT)
+	}
+	if len(response.SmsResponse) != 2 {
+		t.Fatalf("responses = %d, want 2", len(response.SmsResponse))
+	}
+	for i, row := range response.SmsResponse {
+		if row.ProviderTraceId != "" || row.Status != string(MitakeStatus_SENT) {
+			t.Errorf("response %d = %+v", i, row)
+		}
+		var providerResponse map[string]interface{}
+		if err := json.Unmarshal([]byte(row.ProviderResponse), &providerResponse); err != nil {
+			t.Fatalf("decode provider response: %v", err)
+		}
+		if providerResponse["BatchId"] != "220478cc-8506-49b2-93b7-2505f651c12e" {
+			t.Errorf("provider response %d = %v", i, providerResponse)
+		}
+	}
+	if response.SmsResponse[0].TraceId != "target-1" || response.SmsResponse[1].TraceId != "target-2" {
+		t.Errorf("trace ids = %s, %s", response.SmsResponse[0].TraceId, response.SmsResponse[1].TraceId)
+	}
+}
+
+func TestEvery8dSmserRejected(t *testing.T) {
+	smser := newTestEvery8dSmser(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == Every8dTokenPath {
+			rw.Write([]byte(`{"Result":true,"Status":"0","Msg":"token"}`))
+			return
+		}
+		rw.Write([]byte("-27,Insufficient credit"))
+	})
+
+	response := smser.SendBatchSms(context.Background(), SmsBatchRequest{
+		Receivers: []SmsReceiver{{TargetId: "target-1", Receiver: "+886912345678"}},
+		Message:   SmsMessage{MessageId: "message-1", Message: "hello"},
+	})
+
+	if response.Status != string(MitakeStatus_FAILED) {
+		t.Fatalf("status = %s, want %s", response.Status, MitakeStatus_FAILED)
+	}
+	if len(response.SmsResponse) != 1 || response.SmsResponse[0].Status != string(MitakeStatus_FAILED) {
+		t.Fatalf("responses = %+v", response.SmsResponse)
+	}
+}
+
+func TestParseEvery8dResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    Every8dResponse
+		ok      bool
+	}{
+		{
+			name:    "sent",
+			content: "98.0,2,2,0,220478cc-8506-49b2-93b7-2505f651c12e\r\n",
+			want:    Every8dResponse{Credit: "98.0", Sended: "2", Cost: "2", Unsend: "0", BatchId: "220478cc-8506-49b2-93b7-2505f651c12e"},
+			ok:      true,
+		},
+		{name: "error code", content: "-27,Insufficient credit"},
+		{name: "too few fields", content: "98.0,2,2"},
+		{name: "empty", content: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseEvery8dResponse(tt.content)
+			if ok != tt.ok || got != tt.want {
+				t.Fatalf("parseEvery8dResponse(%q) = %+v, %v, want %+v, %v", tt.content, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
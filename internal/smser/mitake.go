@@ -5,20 +5,30 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-ini/ini"
 	"github.com/go-resty/resty/v2"
 	log "github.com/sirupsen/logrus"
 
 	shared "notify-service/internal"
+	util "notify-service/internal/utils"
 )
 
 const (
 	CallbackURLForMitake = ""
 	MitakeAPIDomain      = "https://smsapi.mitake.com.tw"
 	SendBatchPath        = "/api/mtk/SmBulkSend"
+	SendLongBatchPath    = "/api/mtk/SmLongSend" // 內容超過單則長度時使用長簡訊介面，避免被截斷
+	QueryStatusPath      = "/api/mtk/SmQuery"
+	CancelPath           = "/api/mtk/SmCancel"
+
+	mitakeTimeLayout = "20060102150405"
 )
 
+// 三竹的預約與有效時間皆以台灣時間解析
+var mitakeTimeLocation = time.FixedZone("Asia/Taipei", 8*60*60)
+
 type MitakeSmser struct {
 	config *shared.Config
 	resty  *resty.Client
@@ -38,10 +48,13 @@ func (s *MitakeSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest)
 
 	var payload strings.Builder
 	for _, receiver := range request.Receivers {
-		payload.WriteString(formatPayloadString(receiver, request.Message.Message))
+		payload.WriteString(formatPayloadString(receiver, request.Message))
 	}
 
-	url := MitakeAPIDomain + SendBatchPath
+	url := s.getApiDomain() + SendBatchPath
+	if isLongSms(request) {
+		url = s.getApiDomain() + SendLongBatchPath
+	}
 
 	resp, err := s.resty.R().
 		SetContext(ctx).
@@ -70,6 +83,137 @@ func (s *MitakeSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest)
 	return newResponse(request, smsResponses)
 }
 
+// 任一收件者的內容超過一則簡訊即改用長簡訊介面
+func isLongSms(request SmsBatchRequest) bool {
+	if util.AnalyzeSms(request.Message.Message).Segments > 1 {
+		return true
+	}
+	for _, receiver := range request.Receivers {
+		if receiver.Message != "" && util.AnalyzeSms(receiver.Message).Segments > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// QuerySmsStatus 以三竹訊息 ID 查詢送達狀態，回應每行為 msgid、statuscode 與 statustime，以 tab 分隔
+func (s *MitakeSmser) QuerySmsStatus(ctx context.Context, providerTraceIds []string) ([]SmsStatus, error) {
+	if len(providerTraceIds) == 0 {
+		return nil, nil
+	}
+
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"username": s.config.MitakeUserName,
+			"password": s.config.MitakePassword,
+			"msgid":    strings.Join(providerTraceIds, ","),
+		}).
+		Get(s.getApiDomain() + QueryStatusPath)
+	if err != nil {
+		return nil, fmt.Errorf("query mitake status failed: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("query mitake status failed with status code %d", resp.StatusCode())
+	}
+
+	return parseMitakeStatusResponse(resp.String()), nil
+}
+
+func parseMitakeStatusResponse(content string) []SmsStatus {
+	var statuses []SmsStatus
+	for _, line := range strings.Split(content, "\n") {
+		// 僅去除行尾，msgid 空白時欄位不可前移
+		fields := strings.Split(strings.TrimRight(line, "\r "), "\t")
+		if len(fields) < 2 || fields[0] == "" {
+			continue
+		}
+
+		providerTraceId, statusCode := fields[0], fields[1]
+		statusTime := ""
+		if len(fields) > 2 {
+			statusTime = fields[2]
+		}
+
+		providerResponse, err := json.Marshal(map[string]interface{}{
+			"ProviderTraceId": providerTraceId,
+			"StatusCode":      statusCode,
+			"StatusMessage":   MitakeCodeReason[statusCode],
+			"StatusTime":      statusTime,
+		})
+		if err != nil {
+			log.Error("Failed to marshal provider response", err.Error())
+			continue
+		}
+
+		status := MitakeCodeStatus[statusCode]
+		delivered := MitakeDeliveredCodes[statusCode]
+		statuses = append(statuses, SmsStatus{
+			ProviderTraceId:  providerTraceId,
+			Status:           string(status),
+			Delivered:        delivered,
+			Final:            delivered || status == MitakeStatus_FAILED,
+			InvalidReceiver:  MitakeInvalidNumberCodes[statusCode],
+			ProviderResponse: string(providerResponse),
+		})
+	}
+	return statuses
+}
+
+// CancelSms 取消已預約的簡訊，回應每行為 msgid=statuscode，狀態碼 9 表示預約已取消
+func (s *MitakeSmser) CancelSms(ctx context.Context, providerTraceIds []string) ([]SmsCancelResult, error) {
+	if len(providerTraceIds) == 0 {
+		return nil, nil
+	}
+
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"username": s.config.MitakeUserName,
+			"password": s.config.MitakePassword,
+			"msgid":    strings.Join(providerTraceIds, ","),
+		}).
+		Get(s.getApiDomain() + CancelPath)
+	if err != nil {
+		return nil, fmt.Errorf("cancel mitake sms failed: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("cancel mitake sms failed with status code %d", resp.StatusCode())
+	}
+
+	var results []SmsCancelResult
+	for _, line := range strings.Split(resp.String(), "\n") {
+		providerTraceId, statusCode, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok || providerTraceId == "" {
+			continue
+		}
+
+		providerResponse, err := json.Marshal(map[string]interface{}{
+			"ProviderTraceId": providerTraceId,
+			"StatusCode":      statusCode,
+			"StatusMessage":   MitakeCodeReason[statusCode],
+		})
+		if err != nil {
+			log.Error("Failed to marshal provider response", err.Error())
+			continue
+		}
+		results = append(results, SmsCancelResult{
+			ProviderTraceId:  providerTraceId,
+			Canceled:         statusCode == mitakeCanceledCode,
+			ProviderResponse: string(providerResponse),
+		})
+	}
+	return results, nil
+}
+
+// 可設定 API 網域以指向測試用的替代服務
+func (s *MitakeSmser) getApiDomain() string {
+	if s.config.MitakeApiBaseUrl != "" {
+		return strings.TrimRight(s.config.MitakeApiBaseUrl, "/")
+	}
+	return MitakeAPIDomain
+}
+
 func newFailedResponse(ctx context.Context, request SmsBatchRequest, errorCode string) SmsBatchResponse {
 	log.WithContext(ctx).Errorf("failed to send sms, error: %s", errorCode)
 	return SmsBatchResponse{
@@ -94,19 +238,39 @@ func newResponse(request SmsBatchRequest, smsResponses []SmsResponse) SmsBatchRe
 	}
 }
 
-func formatPayloadString(receiver SmsReceiver, message string) string {
+// 欄位依序為 ClientID、門號、預約時間 (dlvtime)、有效時間 (vldtime)、收訊人姓名、回呼網址與內容
+func formatPayloadString(receiver SmsReceiver, message SmsMessage) string {
+	content := message.Message
+	if receiver.Message != "" {
+		content = receiver.Message
+	}
 	return fmt.Sprintf(
 		"%s$$%s$$%s$$%s$$%s$$%s$$%s\r\n",
 		receiver.TargetId,
-		receiver.Receiver,
-		"",
-		"",
+		formatTaiwanPhone(receiver.Receiver),
+		formatMitakeTime(message.ScheduledAt),
+		formatMitakeTime(message.ValidUntil),
 		"",
 		CallbackURLForMitake,
-		message,
+		content,
 	)
 }
 
+func formatMitakeTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.In(mitakeTimeLocation).Format(mitakeTimeLayout)
+}
+
+// 國內發送商的門號須為 09 開頭，將 E.164 格式的台灣門號轉為國內格式
+func formatTaiwanPhone(phone string) string {
+	if strings.HasPrefix(phone, "+886") {
+		return "0" + strings.TrimPrefix(phone, "+886")
+	}
+	return phone
+}
+
 type MitakeResponse struct {
 	ProviderTraceId string `json:"provider_trace_id"`
 	StatusCode      string `json:"status_code"`
@@ -149,7 +313,9 @@ func convertToSmsResponse(providerResponses map[string]MitakeResponse) []SmsResp
 		smsResponse = append(smsResponse, SmsResponse{
 			Status:           string(mistakeStatus),
 			TraceId:          targetId,
+			ProviderTraceId:  response.ProviderTraceId,
 			ProviderResponse: string(providerResponseJson),
+			InvalidReceiver:  MitakeInvalidNumberCodes[response.StatusCode],
 		})
 	}
 	return smsResponse
@@ -185,6 +351,19 @@ var MitakeCodeReason = map[string]string{
 	"9": "預約已取消",
 }
 
+// 代表門號無效的狀態碼，接收者會被加入抑制名單
+var MitakeInvalidNumberCodes = map[string]bool{
+	"v": true,
+	"6": true,
+}
+
+const mitakeCanceledCode = "9"
+
+// 代表已送達手機的狀態碼，0-3 仍可能變動，需持續查詢
+var MitakeDeliveredCodes = map[string]bool{
+	"4": true,
+}
+
 var MitakeCodeStatus = map[string]MitakeStatus{
 	"*": MitakeStatus_FAILED,
 	"a": MitakeStatus_FAILED,
@@ -0,0 +1,183 @@
+package smser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+
+	shared "notify-service/internal"
+)
+
+func newTestMitakeSmser(t *testing.T, handler http.HandlerFunc) *MitakeSmser {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewMitakeSmser(&shared.Config{
+		MitakeUserName:   "user",
+		MitakePassword:   "password",
+		MitakeApiBaseUrl: server.URL,
+	}, resty.New())
+}
+
+func TestParseMitakeStatusResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []SmsStatus
+	}{
+		{
+			name:    "delivered and pending",
+			content: "1010079522\t4\t20170101010010\r\n1010079523\t1\t20170101010005\r\n",
+			want: []SmsStatus{
+				{ProviderTraceId: "1010079522", Status: string(MitakeStatus_SENT), Delivered: true, Final: true},
+				{ProviderTraceId: "1010079523", Status: string(MitakeStatus_SENT)},
+			},
+		},
+		{
+			// 預約已取消的簡訊不會再送達，視為最終的失敗狀態
+			name:    "canceled reservation",
+			content: "1010079524\t9\t20170101010010\n",
+			want: []SmsStatus{
+				{ProviderTraceId: "1010079524", Status: string(MitakeStatus_FAILED), Final: true},
+			},
+		},
+		{
+			name:    "invalid receiver",
+			content: "1010079525\t6\t20170101010010",
+			want: []SmsStatus{
+				{ProviderTraceId: "1010079525", Status: string(MitakeStatus_FAILED), Final: true, InvalidReceiver: true},
+			},
+		},
+		{
+			name:    "missing status time",
+			content: "1010079526\t0\n",
+			want: []SmsStatus{
+				{ProviderTraceId: "1010079526", Status: string(MitakeStatus_SENT)},
+			},
+		},
+		{
+			// 未知的狀態碼不視為最終狀態，待下次查詢
+			name:    "unknown status code",
+			content: "1010079527\tx\t20170101010010\n",
+			want: []SmsStatus{
+				{ProviderTraceId: "1010079527"},
+			},
+		},
+		{
+			name:    "malformed lines",
+			content: "\r\n1010079528\r\n\t4\t20170101010010\r\nstatuscode=e\r\n1010079529\t8\t20170101010010\r\n",
+			want: []SmsStatus{
+				{ProviderTraceId: "1010079529", Status: string(MitakeStatus_FAILED), Final: true},
+			},
+		},
+		{
+			name:    "empty",
+			content: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := parseMitakeStatusResponse(tt.content)
+			if len(statuses) != len(tt.want) {
+				t.Fatalf("statuses = %+v, want %+v", statuses, tt.want)
+			}
+			for i, status := range statuses {
+				var providerResponse map[string]string
+				if err := json.Unmarshal([]byte(status.ProviderResponse), &providerResponse); err != nil {
+					t.Fatalf("provider response %q: %v", status.ProviderResponse, err)
+				}
+				if providerResponse["ProviderTraceId"] != status.ProviderTraceId {
+					t.Errorf("provider response = %s", status.ProviderResponse)
+				}
+
+				status.ProviderResponse = ""
+				if status != tt.want[i] {
+					t.Errorf("status %d = %+v, want %+v", i, status, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMitakeSmserCancelSms(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       []SmsCancelResult
+		wantErr    bool
+	}{
+		{
+			name: "canceled and already sent",
+			body: "1010079522=9\r\n1010079523=4\r\n",
+			want: []SmsCancelResult{
+				{ProviderTraceId: "1010079522", Canceled: true},
+				{ProviderTraceId: "1010079523"},
+			},
+		},
+		{
+			name: "malformed lines",
+			body: "\r\ngarbage\r\n=9\r\n1010079522=9\r\n1010079523=\r\n",
+			want: []SmsCancelResult{
+				{ProviderTraceId: "1010079522", Canceled: true},
+				{ProviderTraceId: "1010079523"},
+			},
+		},
+		{
+			name:       "unexpected status code",
+			statusCode: http.StatusInternalServerError,
+			body:       "*",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			smser := newTestMitakeSmser(t, func(rw http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != CancelPath {
+					t.Errorf("path = %s, want %s", r.URL.Path, CancelPath)
+				}
+				query := r.URL.Query()
+				if query.Get("username") != "user" || query.Get("password") != "password" {
+					t.Errorf("query = %s", r.URL.RawQuery)
+				}
+				if got := query.Get("msgid"); got != "1010079522,1010079523" {
+					t.Errorf("msgid = %q", got)
+				}
+				if tt.statusCode != 0 {
+					rw.WriteHeader(tt.statusCode)
+				}
+				rw.Write([]byte(tt.body))
+			})
+
+			results, err := smser.CancelSms(context.Background(), []string{"1010079522", "1010079523"})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("results = %+v, want error", results)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cancel sms: %v", err)
+			}
+			if len(results) != len(tt.want) {
+				t.Fatalf("results = %+v, want %+v", results, tt.want)
+			}
+			for i, result := range results {
+				if result.ProviderResponse == "" {
+					t.Errorf("result %d has no provider response", i)
+				}
+				result.ProviderResponse = ""
+				if result != tt.want[i] {
+					t.Errorf("result %d = %+v, want %+v", i, result, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package smser
+
+import (
+	"context"
+
+	component "notify-service/internal/components"
+)
+
+// RateLimitedSmser 在呼叫發送商前取得共用的發送額度，超過速率時等待而非直接失敗
+type RateLimitedSmser struct {
+	provider      SmsProvider
+	limiter       *component.DbRateLimiter
+	key           string
+	ratePerSecond float64
+	burst         int
+}
+
+func NewRateLimitedSmser(
+	provider SmsProvider,
+	limiter *component.DbRateLimiter,
+	key string,
+	ratePerSecond float64,
+	burst int,
+) SmsProvider {
+	if ratePerSecond <= 0 {
+		return provider
+	}
+	return &RateLimitedSmser{
+		provider:      provider,
+		limiter:       limiter,
+		key:           key,
+		ratePerSecond: ratePerSecond,
+		burst:         burst,
+	}
+}
+
+func (s *RateLimitedSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest) SmsBatchResponse {
+	// 發送商以收件者數計算速率，每位收件者取一個令牌
+	if err := s.limiter.Wait(ctx, "sms:"+s.key, len(request.Receivers), s.ratePerSecond, s.burst); err != nil {
+		return newFailedResponse(ctx, request, "RATE_LIMIT_WAIT_FAILED: "+err.Error())
+	}
+	return s.provider.SendBatchSms(ctx, request)
+}
@@ -0,0 +1,91 @@
+package smser
+
+import (
+	"context"
+
+	component "notify-service/internal/components"
+)
+
+// RoutingSmser 依收件者門號地區選擇發送商，預設地區的門號使用國內發送商，其餘使用支援國際門號的發送商
+type RoutingSmser struct {
+	domestic          SmsProvider
+	domesticName      string
+	international     SmsProvider
+	internationalName string
+	phoneNumber       *component.PhoneNumber
+}
+
+func NewRoutingSmser(
+	domestic SmsProvider,
+	domesticName string,
+	international SmsProvider,
+	internationalName string,
+	phoneNumber *component.PhoneNumber,
+) SmsProvider {
+	return &RoutingSmser{
+		domestic:          domestic,
+		domesticName:      domesticName,
+		international:     international,
+		internationalName: internationalName,
+		phoneNumber:       phoneNumber,
+	}
+}
+
+func (s *RoutingSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest) SmsBatchResponse {
+	var domesticReceivers, internationalReceivers []SmsReceiver
+	for _, receiver := range request.Receivers {
+		if s.phoneNumber.IsDomestic(receiver.Receiver) {
+			domesticReceivers = append(domesticReceivers, receiver)
+		} else {
+			internationalReceivers = append(internationalReceivers, receiver)
+		}
+	}
+
+	response := SmsBatchResponse{
+		Status:    string(SmsSendStatus_FAILED),
+		MessageId: request.Message.MessageId,
+	}
+	s.mergeResponse(ctx, &response, s.domestic, s.domesticName, request.Message, domesticReceivers)
+	s.mergeResponse(ctx, &response, s.international, s.internationalName, request.Message, internationalReceivers)
+	return response
+}
+
+// 任一發送商發送成功即視為成功，保留發送商回報的各收件者結果並補上實際使用的發送商，
+// 發送商未回報的收件者以失敗狀態個別回報
+func (s *RoutingSmser) mergeResponse(
+	ctx context.Context,
+	response *SmsBatchResponse,
+	provider SmsProvider,
+	providerName string,
+	message SmsMessage,
+	receivers []SmsReceiver,
+) {
+	if len(receivers) == 0 {
+		return
+	}
+
+	result := provider.SendBatchSms(ctx, SmsBatchRequest{
+		Receivers: receivers,
+		Message:   message,
+	})
+	if result.Status == string(SmsSendStatus_SENT) {
+		response.Status = result.Status
+	}
+
+	reported := make(map[string]bool, len(result.SmsResponse))
+	for _, row := range result.SmsResponse {
+		row.Provider = providerName
+		response.SmsResponse = append(response.SmsResponse, row)
+		reported[row.TraceId] = true
+	}
+	for _, receiver := range receivers {
+		if reported[receiver.TargetId] {
+			continue
+		}
+		response.SmsResponse = append(response.SmsResponse, SmsResponse{
+			Status:   string(SmsSendStatus_FAILED),
+			TraceId:  receiver.TargetId,
+			Provider: providerName,
+		})
+	}
+}
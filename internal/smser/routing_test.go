@@ -0,0 +1,60 @@
+package smser
+
+import (
+	"context"
+	"testing"
+
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+)
+
+type stubSmser struct {
+	response SmsBatchResponse
+}
+
+func (s stubSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest) SmsBatchResponse {
+	return s.response
+}
+
+// 國際發送商整批失敗時保留其回報的收件者結果，未回報的收件者以失敗狀態補上
+func TestRoutingSmserKeepsProviderRows(t *testing.T) {
+	domestic := stubSmser{response: SmsBatchResponse{
+		Status: string(SmsSendStatus_SENT),
+		SmsResponse: []SmsResponse{
+			{Status: string(SmsSendStatus_SENT), TraceId: "target-1", ProviderTraceId: "M1"},
+		},
+	}}
+	international := stubSmser{response: SmsBatchResponse{
+		Status: string(SmsSendStatus_FAILED),
+		SmsResponse: []SmsResponse{
+			{Status: string(SmsSendStatus_FAILED), TraceId: "target-2", ProviderResponse: `{"code":21211}`, InvalidReceiver: true},
+		},
+	}}
+	smser := NewRoutingSmser(domestic, "mitake", international, "twilio", component.NewPhoneNumber(&shared.Config{PhoneDefaultRegion: "TW"}))
+
+	response := smser.SendBatchSms(context.Background(), SmsBatchRequest{
+		Receivers: []SmsReceiver{
+			{TargetId: "target-1", Receiver: "+886912345678"},
+			{TargetId: "target-2", Receiver: "+14155550100"},
+			{TargetId: "target-3", Receiver: "+14155550101"},
+		},
+		Message: SmsMessage{MessageId: "message-1", Message: "hello"},
+	})
+
+	if response.Status != string(SmsSendStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, SmsSendStatus_SENT)
+	}
+	want := []SmsResponse{
+		{Status: string(SmsSendStatus_SENT), TraceId: "target-1", ProviderTraceId: "M1", Provider: "mitake"},
+		{Status: string(SmsSendStatus_FAILED), TraceId: "target-2", ProviderResponse: `{"code":21211}`, InvalidReceiver: true, Provider: "twilio"},
+		{Status: string(SmsSendStatus_FAILED), TraceId: "target-3", Provider: "twilio"},
+	}
+	if len(response.SmsResponse) != len(want) {
+		t.Fatalf("responses = %+v", response.SmsResponse)
+	}
+	for i, row := range response.SmsResponse {
+		if row != want[i] {
+			t.Errorf("response %d = %+v, want %+v", i, row, want[i])
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package smser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+
+	shared "notify-service/internal"
+)
+
+const (
+	TwilioAPIDomain   = "https://api.twilio.com"
+	TwilioMessagePath = "/2010-04-01/Accounts/{accountSid}/Messages.json"
+
+	// 未設定併發數時同時送出的請求數
+	defaultTwilioConcurrency = 10
+)
+
+// Twilio 訊息狀態，參考 https://www.twilio.com/docs/messaging/api/message-resource#message-status-values
+var TwilioMessageStatus = map[string]SmsSendStatus{
+	"accepted":    SmsSendStatus_SENT,
+	"scheduled":   SmsSendStatus_SENT,
+	"queued":      SmsSendStatus_SENT,
+	"sending":     SmsSendStatus_SENT,
+	"sent":        SmsSendStatus_SENT,
+	"delivered":   SmsSendStatus_SENT,
+	"failed":      SmsSendStatus_FAILED,
+	"undelivered": SmsSendStatus_FAILED,
+	"canceled":    SmsSendStatus_FAILED,
+}
+
+// 代表門號無效的錯誤碼，接收者會被加入抑制名單
+var TwilioInvalidNumberCodes = map[string]bool{
+	"21211": true, // 無效的門號
+	"21614": true, // 非手機門號
+	"30005": true, // 門號不存在
+	"30006": true, // 市話或無法接收簡訊的門號
+}
+
+// TwilioSmser 使用 Twilio Messages API 發送，API 一次僅能發送給一位收件者，以有限的併發數逐筆送出
+type TwilioSmser struct {
+	config *shared.Config
+	resty  *resty.Client
+}
+
+func NewTwilioSmser(config *shared.Config, resty *resty.Client) *TwilioSmser {
+	return &TwilioSmser{config: config, resty: resty}
+}
+
+type twilioMessageResponse struct {
+	Sid          string `json:"sid"`
+	Status       string `json:"status"`
+	ErrorCode    *int   `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+
+	// 請求失敗時回傳的錯誤內容
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (s *TwilioSmser) SendBatchSms(ctx context.Context, request SmsBatchRequest) SmsBatchResponse {
+	concurrency := s.config.TwilioConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultTwilioConcurrency
+	}
+
+	smsResponses := make([]SmsResponse, len(request.Receivers))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, receiver := range request.Receivers {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, receiver SmsReceiver) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			smsResponses[i] = s.sendSms(ctx, request.Message, receiver)
+		}(i, receiver)
+	}
+	wg.Wait()
+
+	// 任一收件者發送成功即視為成功，失敗的收件者以失敗狀態個別回報
+	status := string(SmsSendStatus_FAILED)
+	for _, smsResponse := range smsResponses {
+		if smsResponse.Status == string(SmsSendStatus_SENT) {
+			status = smsResponse.Status
+			break
+		}
+	}
+	return SmsBatchResponse{
+		Status:      status,
+		MessageId:   request.Message.MessageId,
+		SmsResponse: smsResponses,
+	}
+}
+
+func (s *TwilioSmser) sendSms(ctx context.Context, message SmsMessage, receiver SmsReceiver) SmsResponse {
+	content := message.Message
+	if receiver.Message != "" {
+		content = receiver.Message
+	}
+
+	formData := map[string]string{
+		"To":   receiver.Receiver,
+		"Body": content,
+	}
+	if s.config.TwilioMessagingServiceSid != "" {
+		formData["MessagingServiceSid"] = s.config.TwilioMessagingServiceSid
+	} else {
+		formData["From"] = s.config.TwilioFromNumber
+	}
+	if s.config.TwilioStatusCallbackUrl != "" {
+		formData["StatusCallback"] = s.config.TwilioStatusCallbackUrl
+	}
+
+	var result twilioMessageResponse
+	resp, err := s.resty.R().
+		SetContext(ctx).
+		SetBasicAuth(s.config.TwilioAccountSid, s.config.TwilioAuthToken).
+		SetPathParam("accountSid", s.config.TwilioAccountSid).
+		SetFormData(formData).
+		Post(s.getApiDomain() + TwilioMessagePath)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"target_id": receiver.TargetId,
+		}).Error("Twilio request failed")
+		return newTwilioFailedResponse(receiver, "REQUEST_FAILED", "")
+	}
+
+	// 成功與失敗的回應皆為 JSON，失敗時錯誤碼位於 code 欄位
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"target_id": receiver.TargetId,
+		}).Error("Invalid twilio response")
+		return newTwilioFailedResponse(receiver, "RESPONSE_PARSE_ERROR", resp.String())
+	}
+
+	if resp.IsError() {
+		errorCode := fmt.Sprint(result.Code)
+		log.WithContext(ctx).WithFields(log.Fields{
+			"target_id":   receiver.TargetId,
+			"status_code": resp.StatusCode(),
+			"error_code":  errorCode,
+		}).Error("Twilio rejected message")
+		return newTwilioFailedResponse(receiver, errorCode, result.Message)
+	}
+
+	errorCode := ""
+	if result.ErrorCode != nil {
+		errorCode = fmt.Sprint(*result.ErrorCode)
+	}
+	providerResponse, err := json.Marshal(map[string]interface{}{
+		"ProviderTraceId": result.Sid,
+		"Status":          result.Status,
+		"ErrorCode":       errorCode,
+		"ErrorMessage":    result.ErrorMessage,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to marshal provider response")
+	}
+
+	status, ok := TwilioMessageStatus[result.Status]
+	if !ok {
+		status = SmsSendStatus_SENT
+	}
+	return SmsResponse{
+		Status:           string(status),
+		TraceId:          receiver.TargetId,
+		ProviderTraceId:  result.Sid,
+		ProviderResponse: string(providerResponse),
+		InvalidReceiver:  TwilioInvalidNumberCodes[errorCode],
+	}
+}
+
+// 可設定 API 網域以指向測試用的替代服務
+func (s *TwilioSmser) getApiDomain() string {
+	if s.config.TwilioApiBaseUrl != "" {
+		return strings.TrimRight(s.config.TwilioApiBaseUrl, "/")
+	}
+	return TwilioAPIDomain
+}
+
+func newTwilioFailedResponse(receiver SmsReceiver, errorCode string, errorMessage string) SmsResponse {
+	providerResponse, _ := json.Marshal(map[string]interface{}{
+		"ErrorCode":    errorCode,
+		"ErrorMessage": errorMessage,
+	})
+	return SmsResponse{
+		Status:           string(SmsSendStatus_FAILED),
+		TraceId:          receiver.TargetId,
+		ProviderResponse: string(providerResponse),
+		InvalidReceiver:  TwilioInvalidNumberCodes[errorCode],
+	}
+}
+
+// ParseTwilioStatusCallback 將 Twilio 狀態回呼的表單內容 (MessageSid、MessageStatus、ErrorCode) 轉為送達狀態
+func ParseTwilioStatusCallback(form url.Values) SmsStatus {
+	messageStatus := form.Get("MessageStatus")
+	errorCode := form.Get("ErrorCode")
+
+	providerResponse, err := json.Marshal(map[string]interface{}{
+		"ProviderTraceId": form.Get("MessageSid"),
+		"Status":          messageStatus,
+		"ErrorCode":       errorCode,
+		"To":              form.Get("To"),
+	})
+	if err != nil {
+		log.Error("Failed to marshal provider response", err.Error())
+	}
+
+	status := TwilioMessageStatus[messageStatus]
+	delivered := messageStatus == "delivered"
+	return SmsStatus{
+		ProviderTraceId:  form.Get("MessageSid"),
+		Status:           string(status),
+		Delivered:        delivered,
+		Final:            delivered || status == SmsSendStatus_FAILED,
+		InvalidReceiver:  TwilioInvalidNumberCodes[errorCode],
+		ProviderResponse: string(providerResponse),
+	}
+}
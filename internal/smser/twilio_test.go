@@ -0,0 +1,81 @@
+package smser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+
+	shared "notify-service/internal"
+)
+
+func newTestTwilioSmser(t *testing.T, handler http.HandlerFunc) *TwilioSmser {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewTwilioSmser(&shared.Config{
+		TwilioAccountSid:        "AC123",
+		TwilioAuthToken:         "token",
+		TwilioFromNumber:        "+15005550006",
+		TwilioStatusCallbackUrl: "https://notify.example.com/webhooks/twilio/status",
+		TwilioApiBaseUrl:        server.URL,
+	}, resty.New())
+}
+
+func TestTwilioSmserSendBatchSms(t *testing.T) {
+	smser := newTestTwilioSmser(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2010-04-01/Accounts/AC123/Messages.json" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if user, password, ok := r.BasicAuth(); !ok || user != "AC123" || password != "token" {
+			t.Errorf("basic auth = %s:%s", user, password)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		if got := r.PostForm.Get("StatusCallback"); got != "https://notify.example.com/webhooks/twilio/status" {
+			t.Errorf("StatusCallback = %q", got)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.PostForm.Get("To") {
+		case "+886912345678":
+			if got := r.PostForm.Get("Body"); got != "hello Alice" {
+				t.Errorf("Body = %q", got)
+			}
+			rw.WriteHeader(http.StatusCreated)
+			rw.Write([]byte(`{"sid":"SM123","status":"queued","error_code":null}`))
+		default:
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"code":21211,"message":"The 'To' number is not a valid phone number."}`))
+		}
+	})
+
+	response := smser.SendBatchSms(context.Background(), SmsBatchRequest{
+		Receivers: []SmsReceiver{
+			{TargetId: "target-1", Receiver: "+886912345678", Message: "hello Alice"},
+			{TargetId: "target-2", Receiver: "+1000"},
+		},
+		Message: SmsMessage{MessageId: "message-1", Message: "hello"},
+	})
+
+	if response.Status != string(SmsSendStatus_SENT) {
+		t.Fatalf("status = %s, want %s", response.Status, SmsSendStatus_SENT)
+	}
+	if len(response.SmsResponse) != 2 {
+		t.Fatalf("responses = %d, want 2", len(response.SmsResponse))
+	}
+
+	sent := response.SmsResponse[0]
+	if sent.TraceId != "target-1" || sent.ProviderTraceId != "SM123" || sent.Status != string(SmsSendStatus_SENT) {
+		t.Errorf("sent response = %+v", sent)
+	}
+
+	failed := response.SmsResponse[1]
+	if failed.TraceId != "target-2" || failed.Status != string(SmsSendStatus_FAILED) || !failed.InvalidReceiver {
+		t.Errorf("failed response = %+v", failed)
+	}
+}
@@ -1,9 +1,14 @@
 package util
 
 import (
+	"crypto/hmac"
 	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -50,6 +55,12 @@ func Md5(data string) string {
 	return fmt.Sprintf("%x", hash)
 }
 
+func HmacSha256(key string, data string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 // ChunkArray 將切片分割為指定大小的子切片
 func ChunkArray(data []string, chunkSize int) [][]string {
 	if chunkSize <= 0 {
@@ -64,6 +75,20 @@ func ChunkArray(data []string, chunkSize int) [][]string {
 	return result
 }
 
+// Unique 去除重複值並保留原順序
+func Unique(data []string) []string {
+	seen := make(map[string]bool, len(data))
+	result := make([]string, 0, len(data))
+	for _, item := range data {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		result = append(result, item)
+	}
+	return result
+}
+
 // 輔助函數：取得兩數最小值
 func min(a, b int) int {
 	if a < b {
@@ -71,3 +96,58 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+// 依手機號碼前綴推算時區，依序比對較長的前綴，無法判斷時回傳空字串
+var phonePrefixTimezones = []struct {
+	prefix   string
+	timezone string
+}{
+	{"+886", "Asia/Taipei"},
+	{"+852", "Asia/Hong_Kong"},
+	{"+853", "Asia/Macau"},
+	{"+86", "Asia/Shanghai"},
+	{"+81", "Asia/Tokyo"},
+	{"+82", "Asia/Seoul"},
+	{"+65", "Asia/Singapore"},
+	{"+60", "Asia/Kuala_Lumpur"},
+	{"+66", "Asia/Bangkok"},
+	{"+84", "Asia/Ho_Chi_Minh"},
+	{"+44", "Europe/London"},
+	{"09", "Asia/Taipei"},
+}
+
+func GetTimezoneByPhone(phone string) string {
+	for _, p := range phonePrefixTimezones {
+		if strings.HasPrefix(phone, p.prefix) {
+			return p.timezone
+		}
+	}
+	return ""
+}
+
+// 範本中以 {變數名稱} 表示的個人化變數
+var templateVariablePattern = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// GetTemplateVariables 取得範本中使用的變數名稱，不重複
+func GetTemplateVariables(templates ...string) []string {
+	var variables []string
+	for _, template := range templates {
+		for _, match := range templateVariablePattern.FindAllStringSubmatch(template, -1) {
+			variables = append(variables, match[1])
+		}
+	}
+	return Unique(variables)
+}
+
+// RenderTemplate 將範本中的 {變數名稱} 替換為對應值，未提供的變數保留原樣
+func RenderTemplate(template string, variables map[string]string) string {
+	if len(variables) == 0 {
+		return template
+	}
+	return templateVariablePattern.ReplaceAllStringFunc(template, func(match string) string {
+		if value, ok := variables[match[1:len(match)-1]]; ok {
+			return value
+		}
+		return match
+	})
+}
@@ -0,0 +1,98 @@
+package util
+
+import (
+	"unicode/utf8"
+)
+
+type SmsEncoding string
+
+const (
+	SmsEncoding_GSM7 SmsEncoding = "gsm7" // GSM 03.38 預設字元集，每則 160 字元
+	SmsEncoding_UCS2 SmsEncoding = "ucs2" // 含中文等非 GSM 字元時使用，每則 70 字元
+)
+
+// 單則與長簡訊分段時每段可用的容量，長簡訊每段需保留 UDH 標頭
+const (
+	gsm7SingleSegmentSeptets = 160
+	gsm7MultiSegmentSeptets  = 153
+	ucs2SingleSegmentUnits   = 70
+	ucs2MultiSegmentUnits    = 67
+)
+
+// GSM 03.38 預設字元集
+var gsm7BasicCharacters = buildCharacterSet(
+	"@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+		"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà",
+)
+
+// GSM 03.38 擴充字元集，每個字元需以跳脫字元加上本身共 2 個 septet 表示
+var gsm7ExtensionCharacters = buildCharacterSet("\f^{}\\[~]|€")
+
+func buildCharacterSet(characters string) map[rune]bool {
+	set := make(map[rune]bool, utf8.RuneCountInString(characters))
+	for _, character := range characters {
+		set[character] = true
+	}
+	return set
+}
+
+// SmsAnalysis 簡訊內容的編碼與分段結果
+type SmsAnalysis struct {
+	Encoding   SmsEncoding
+	Characters int // 字元數
+	Segments   int // 分段數，空白內容為 0
+}
+
+// AnalyzeSms 判斷簡訊編碼並計算分段數，內容皆為 GSM 字元時使用 GSM-7，否則使用 UCS-2；
+// 分段時不拆開擴充字元的跳脫序列與 UTF-16 代理對
+func AnalyzeSms(body string) SmsAnalysis {
+	analysis := SmsAnalysis{
+		Encoding:   SmsEncoding_GSM7,
+		Characters: utf8.RuneCountInString(body),
+	}
+
+	for _, character := range body {
+		if !gsm7BasicCharacters[character] && !gsm7ExtensionCharacters[character] {
+			analysis.Encoding = SmsEncoding_UCS2
+			break
+		}
+	}
+
+	// 每個字元佔用的單位數：GSM-7 為 septet，UCS-2 為 UTF-16 code unit
+	units := make([]int, 0, analysis.Characters)
+	total := 0
+	for _, character := range body {
+		size := 1
+		if analysis.Encoding == SmsEncoding_GSM7 && gsm7ExtensionCharacters[character] {
+			size = 2
+		}
+		if analysis.Encoding == SmsEncoding_UCS2 && character > 0xFFFF {
+			size = 2
+		}
+		units = append(units, size)
+		total += size
+	}
+
+	singleLimit, multiLimit := gsm7SingleSegmentSeptets, gsm7MultiSegmentSeptets
+	if analysis.Encoding == SmsEncoding_UCS2 {
+		singleLimit, multiLimit = ucs2SingleSegmentUnits, ucs2MultiSegmentUnits
+	}
+
+	switch {
+	case total == 0:
+		analysis.Segments = 0
+	case total <= singleLimit:
+		analysis.Segments = 1
+	default:
+		used := 0
+		analysis.Segments = 1
+		for _, size := range units {
+			if used+size > multiLimit {
+				analysis.Segments++
+				used = 0
+			}
+			used += size
+		}
+	}
+	return analysis
+}
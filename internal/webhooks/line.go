@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	shared "notify-service/internal"
+	service "notify-service/internal/services"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	LineEventsPath = "/webhooks/line"
+
+	lineSignatureHeader = "X-Line-Signature"
+)
+
+// LINE 事件類型，僅處理送達與加入、封鎖好友事件
+const (
+	lineEvent_DELIVERY = "delivery"
+	lineEvent_FOLLOW   = "follow"
+	lineEvent_UNFOLLOW = "unfollow"
+)
+
+type LineWebhookBody struct {
+	Destination string      `json:"destination"`
+	Events      []LineEvent `json:"events"`
+}
+
+type LineEvent struct {
+	Type           string `json:"type"`
+	WebhookEventId string `json:"webhookEventId"`
+	Timestamp      int64  `json:"timestamp"`
+	Source         struct {
+		Type   string `json:"type"`
+		UserId string `json:"userId"`
+	} `json:"source"`
+	Delivery struct {
+		Data string `json:"data"`
+	} `json:"delivery"`
+}
+
+type LineWebhook struct {
+	lineEventService *service.LineEventService
+	channelSecret    string
+}
+
+func NewLineWebhook(
+	config *shared.Config,
+	lineEventService *service.LineEventService,
+) *LineWebhook {
+	return &LineWebhook{
+		lineEventService: lineEventService,
+		channelSecret:    config.LineChannelSecret,
+	}
+}
+
+func (w LineWebhook) Register(mux *http.ServeMux) {
+	if w.channelSecret == "" {
+		log.Warn("LINE_CHANNEL_SECRET is not set, line webhook is disabled")
+		return
+	}
+	mux.HandleFunc("POST "+LineEventsPath, w.handleEvents)
+}
+
+func (w LineWebhook) handleEvents(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to read line webhook body")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !w.verify(r, payload) {
+		log.WithContext(ctx).Warn("Invalid line webhook signature")
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var body LineWebhookBody
+	if err := json.Unmarshal(payload, &body); err != nil {
+		log.WithContext(ctx).WithError(err).Error("Invalid line webhook payload")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range body.Events {
+		if err := w.handleEvent(ctx, event); err != nil {
+			// 回傳錯誤讓 LINE 重送，各事件的處理皆為冪等操作
+			log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+				"webhook_event_id": event.WebhookEventId,
+				"type":             event.Type,
+			}).Error("Failed to handle line event")
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (w LineWebhook) handleEvent(ctx context.Context, event LineEvent) error {
+	switch event.Type {
+	case lineEvent_DELIVERY:
+		if event.Delivery.Data == "" {
+			return nil
+		}
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return w.lineEventService.HandleDelivery(ctx, event.Delivery.Data, data)
+	case lineEvent_FOLLOW:
+		if event.Source.UserId == "" {
+			return nil
+		}
+		return w.lineEventService.HandleFollow(ctx, event.Source.UserId)
+	case lineEvent_UNFOLLOW:
+		if event.Source.UserId == "" {
+			return nil
+		}
+		return w.lineEventService.HandleUnfollow(ctx, event.Source.UserId)
+	default:
+		return nil
+	}
+}
+
+// 簽章為以 Channel Secret 對原始內容計算 HMAC-SHA256 後的 base64 值；未設定 Channel Secret 時一律拒絕
+func (w LineWebhook) verify(r *http.Request, payload []byte) bool {
+	if w.channelSecret == "" {
+		return false
+	}
+
+	signature := r.Header.Get(lineSignatureHeader)
+	if signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(w.channelSecret))
+	mac.Write(payload)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
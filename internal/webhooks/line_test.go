@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	shared "notify-service/internal"
+)
+
+func newTestLineServer(t *testing.T, channelSecret string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	NewLineWebhook(&shared.Config{LineChannelSecret: channelSecret}, nil).Register(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func postLineEvents(t *testing.T, server *httptest.Server, payload []byte, signature string) int {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+LineEventsPath, bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if signature != "" {
+		request.Header.Set(lineSignatureHeader, signature)
+	}
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("post line events: %v", err)
+	}
+	defer response.Body.Close()
+	return response.StatusCode
+}
+
+func signLinePayload(channelSecret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(channelSecret))
+	mac.Write(payload)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestLineWebhookDisabledWithoutChannelSecret(t *testing.T) {
+	server := newTestLineServer(t, "")
+
+	payload := []byte(`{"destination":"U123","events":[]}`)
+	if status := postLineEvents(t, server, payload, signLinePayload("", payload)); status != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", status, http.StatusNotFound)
+	}
+}
+
+func TestLineWebhookSignature(t *testing.T) {
+	server := newTestLineServer(t, "channel-secret")
+
+	// 不含事件的請求於驗證簽章後直接回傳 200，不會呼叫事件處理
+	payload := []byte(`{"destination":"U123","events":[]}`)
+	tests := []struct {
+		name      string
+		signature string
+		want      int
+	}{
+		{name: "valid signature", signature: signLinePayload("channel-secret", payload), want: http.StatusOK},
+		{name: "missing signature", want: http.StatusUnauthorized},
+		{name: "wrong channel secret", signature: signLinePayload("other-secret", payload), want: http.StatusUnauthorized},
+		{name: "tampered payload", signature: signLinePayload("channel-secret", []byte(`{"events":[]}`)), want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := postLineEvents(t, server, payload, tt.signature); status != tt.want {
+				t.Fatalf("status = %d, want %d", status, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	shared "notify-service/internal"
+	component "notify-service/internal/components"
+	entity "notify-service/internal/entities"
+	service "notify-service/internal/services"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	MitakeMoPath = "/webhooks/mitake/mo"
+
+	mitakeMoTimeLayout = "20060102150405"
+)
+
+var mitakeMoTimeLocation = time.FixedZone("Asia/Taipei", 8*60*60)
+
+// MitakeMoWebhook 接收三竹上行簡訊 (MO) 回呼，支援 GET query 與 POST form 兩種格式：
+// msgid 上行簡訊 ID、srcaddr 回覆者門號、dstaddr 接收門號、smbody 簡訊內容、rcvtime 接收時間 (yyyyMMddHHmmss)。
+// 三竹回呼不帶簽章，回呼網址需以 /webhooks/mitake/mo/{token} 設定共用密鑰
+type MitakeMoWebhook struct {
+	inboundService *service.InboundService
+	notifyService  *service.NotifyService
+	phoneNumber    *component.PhoneNumber
+	token          string
+}
+
+func NewMitakeMoWebhook(
+	config *shared.Config,
+	inboundService *service.InboundService,
+	notifyService *service.NotifyService,
+	phoneNumber *component.PhoneNumber,
+) *MitakeMoWebhook {
+	return &MitakeMoWebhook{
+		inboundService: inboundService,
+		notifyService:  notifyService,
+		phoneNumber:    phoneNumber,
+		token:          config.MitakeMoToken,
+	}
+}
+
+// 未設定共用密鑰時無法驗證回呼來源，不註冊 webhook
+func (w MitakeMoWebhook) Register(mux *http.ServeMux) {
+	if w.token == "" {
+		log.Warn("MITAKE_MO_TOKEN is not set, mitake mo webhook is disabled")
+		return
+	}
+	mux.HandleFunc(MitakeMoPath+"/{token}", w.handleMo)
+}
+
+func (w MitakeMoWebhook) handleMo(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !w.verify(r) {
+		log.WithContext(ctx).Warn("Invalid mitake mo callback token")
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	msgId := r.FormValue("msgid")
+	sender := w.normalizePhone(r.FormValue("srcaddr"))
+	if msgId == "" || sender == "" {
+		log.WithContext(ctx).Warn("Invalid mitake mo callback")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	receivedAt, err := time.ParseInLocation(mitakeMoTimeLayout, r.FormValue("rcvtime"), mitakeMoTimeLocation)
+	if err != nil {
+		receivedAt = time.Now()
+	}
+
+	inbound, created, err := w.inboundService.HandleInboundSms(ctx, service.InboundSms{
+		Provider:          entity.Provider_MITAKE,
+		ProviderMessageId: msgId,
+		Sender:            sender,
+		Recipient:         r.FormValue("dstaddr"),
+		Body:              r.FormValue("smbody"),
+		ReceivedAt:        receivedAt,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"msgid": msgId,
+		}).Error("Failed to handle mitake mo")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if created {
+		w.sendConfirmation(ctx, inbound, sender)
+	}
+
+	// 三竹以此格式確認已收到回呼，未回應時會重送
+	fmt.Fprintf(rw, "magicid=sms_gateway_rpack\nmsgid=%s\n", msgId)
+}
+
+// 未設定共用密鑰時一律拒絕
+func (w MitakeMoWebhook) verify(r *http.Request) bool {
+	if w.token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(r.PathValue("token")), []byte(w.token)) == 1
+}
+
+// 退訂確認簡訊加入佇列由 worker 發送，不經過抑制名單檢查
+func (w MitakeMoWebhook) sendConfirmation(ctx context.Context, inbound *entity.InboundMessage, sender string) {
+	content := w.inboundService.GetOptOutConfirmation(inbound)
+	if content == "" {
+		return
+	}
+
+	status := entity.ConfirmationStatus_SENT
+	if _, err := w.notifyService.PublishOptOutConfirmation(ctx, sender, content); err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"inbound_id": inbound.Id,
+		}).Error("Failed to publish opt-out confirmation")
+		status = entity.ConfirmationStatus_FAILED
+	}
+	if err := w.inboundService.UpdateConfirmationStatus(ctx, inbound.Id, status); err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"inbound_id": inbound.Id,
+		}).Error("Failed to update confirmation status")
+	}
+}
+
+// 三竹回傳的門號可能為 09 開頭或不含 + 的 886 開頭，統一轉為 E.164 格式，與發送時的接收者雜湊值一致
+func (w MitakeMoWebhook) normalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+	if strings.HasPrefix(phone, "886") {
+		phone = "+" + phone
+	}
+
+	normalized, err := w.phoneNumber.Normalize(phone)
+	if err != nil {
+		return phone
+	}
+	return normalized
+}
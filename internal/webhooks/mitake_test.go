@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	shared "notify-service/internal"
+)
+
+func newTestMitakeMoServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	NewMitakeMoWebhook(&shared.Config{MitakeMoToken: token}, nil, nil, nil).Register(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func getMitakeMo(t *testing.T, server *httptest.Server, path string) int {
+	t.Helper()
+
+	response, err := server.Client().Get(server.URL + path + "?msgid=1&srcaddr=0912345678&smbody=STOP")
+	if err != nil {
+		t.Fatalf("get mitake mo: %v", err)
+	}
+	defer response.Body.Close()
+	return response.StatusCode
+}
+
+func TestMitakeMoWebhookDisabledWithoutToken(t *testing.T) {
+	server := newTestMitakeMoServer(t, "")
+
+	for _, path := range []string{MitakeMoPath, MitakeMoPath + "/"} {
+		if status := getMitakeMo(t, server, path); status != http.StatusNotFound {
+			t.Fatalf("%s status = %d, want %d", path, status, http.StatusNotFound)
+		}
+	}
+}
+
+// 密鑰不符時於處理回呼內容前拒絕，不會寫入上行簡訊或發送確認簡訊
+func TestMitakeMoWebhookToken(t *testing.T) {
+	server := newTestMitakeMoServer(t, "mo-secret")
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{name: "missing token", path: MitakeMoPath, want: http.StatusNotFound},
+		{name: "wrong token", path: MitakeMoPath + "/other-secret", want: http.StatusUnauthorized},
+		{name: "token prefix", path: MitakeMoPath + "/mo-secre", want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := getMitakeMo(t, server, tt.path); status != tt.want {
+				t.Fatalf("status = %d, want %d", status, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	shared "notify-service/internal"
+	entity "notify-service/internal/entities"
+	service "notify-service/internal/services"
+
+	"github.com/sendgrid/sendgrid-go/helpers/eventwebhook"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SendgridEventsPath = "/webhooks/sendgrid/events"
+)
+
+// SendGrid 事件類型，僅處理需加入抑制名單的事件
+const (
+	sendgridEvent_BOUNCE     = "bounce"
+	sendgridEvent_SPAMREPORT = "spamreport"
+
+	// bounce 事件的 type 為 blocked 時屬暫時性退信，不加入抑制名單
+	sendgridBounceType_BOUNCE = "bounce"
+)
+
+type SendgridEvent struct {
+	Email                string `json:"email"`
+	Event                string `json:"event"`
+	Type                 string `json:"type"`
+	Reason               string `json:"reason"`
+	Status               string `json:"status"`
+	SgMessageId          string `json:"sg_message_id"`
+	SgEventId            string `json:"sg_event_id"`
+	Timestamp            int64  `json:"timestamp"`
+	BounceClassification string `json:"bounce_classification"`
+}
+
+type SendgridWebhook struct {
+	suppressionService *service.SuppressionService
+	publicKey          *ecdsa.PublicKey
+}
+
+func NewSendgridWebhook(
+	config *shared.Config,
+	suppressionService *service.SuppressionService,
+) (*SendgridWebhook, error) {
+	webhook := &SendgridWebhook{
+		suppressionService: suppressionService,
+	}
+
+	if config.SendgridWebhookVerificationKey != "" {
+		publicKey, err := eventwebhook.ConvertPublicKeyBase64ToECDSA(config.SendgridWebhookVerificationKey)
+		if err != nil {
+			return nil, err
+		}
+		webhook.publicKey = publicKey
+	}
+	return webhook, nil
+}
+
+// 未設定驗證金鑰時不註冊，避免未簽章的請求寫入抑制名單
+func (w SendgridWebhook) Register(mux *http.ServeMux) {
+	if w.publicKey == nil {
+		log.Warn("SENDGRID_WEBHOOK_VERIFICATION_KEY is not set, sendgrid webhook is disabled")
+		return
+	}
+	mux.HandleFunc("POST "+SendgridEventsPath, w.handleEvents)
+}
+
+func (w SendgridWebhook) handleEvents(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to read sendgrid webhook body")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !w.verify(r, payload) {
+		log.WithContext(ctx).Warn("Invalid sendgrid webhook signature")
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var events []SendgridEvent
+	if err := json.Unmarshal(payload, &events); err != nil {
+		log.WithContext(ctx).WithError(err).Error("Invalid sendgrid webhook payload")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range events {
+		status, reason, ok := convertSendgridEvent(event)
+		if !ok {
+			continue
+		}
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Error("Failed to marshal sendgrid event")
+			continue
+		}
+
+		err = w.suppressionService.IngestProviderEvent(ctx, service.ProviderEvent{
+			Channel:         entity.MessageType_MAIL,
+			Receiver:        event.Email,
+			Provider:        string(entity.Provider_SENDGRID),
+			ProviderTraceId: getSendgridTraceId(event.SgMessageId),
+			Status:          status,
+			Reason:          reason,
+			Data:            data,
+		})
+		if err != nil {
+			// 回傳錯誤讓 SendGrid 重送，抑制名單寫入為冪等操作
+			log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+				"sg_event_id": event.SgEventId,
+			}).Error("Failed to ingest sendgrid event")
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// 未設定驗證金鑰時一律拒絕
+func (w SendgridWebhook) verify(r *http.Request, payload []byte) bool {
+	if w.publicKey == nil {
+		return false
+	}
+
+	signature := r.Header.Get(eventwebhook.VerificationHTTPHeader)
+	timestamp := r.Header.Get(eventwebhook.TimestampHTTPHeader)
+	if signature == "" || timestamp == "" {
+		return false
+	}
+
+	ok, err := eventwebhook.VerifySignature(w.publicKey, payload, signature, timestamp)
+	return err == nil && ok
+}
+
+func convertSendgridEvent(event SendgridEvent) (entity.EventStatus, entity.SuppressionReason, bool) {
+	switch event.Event {
+	case sendgridEvent_BOUNCE:
+		if event.Type != "" && event.Type != sendgridBounceType_BOUNCE {
+			return "", "", false
+		}
+		return entity.EventStatus_BOUNCED, entity.SuppressionReason_BOUNCE, true
+	case sendgridEvent_SPAMREPORT:
+		return entity.EventStatus_SPAM_REPORT, entity.SuppressionReason_SPAM_REPORT, true
+	default:
+		return "", "", false
+	}
+}
+
+// sg_message_id 的格式為 {X-Message-Id}.{filter 資訊}，僅取前段對應發送紀錄
+func getSendgridTraceId(sgMessageId string) string {
+	traceId, _, _ := strings.Cut(sgMessageId, ".")
+	return traceId
+}
@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	shared "notify-service/internal"
+	entity "notify-service/internal/entities"
+	service "notify-service/internal/services"
+	smser "notify-service/internal/smser"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TwilioStatusPath = "/webhooks/twilio/status"
+
+	twilioSignatureHeader = "X-Twilio-Signature"
+)
+
+// TwilioStatusWebhook 接收 Twilio 的訊息狀態回呼 (StatusCallback)，將送達或失敗結果寫回發送紀錄
+type TwilioStatusWebhook struct {
+	smsDeliveryService *service.SmsDeliveryService
+	authToken          string
+	callbackUrl        string
+}
+
+func NewTwilioStatusWebhook(
+	config *shared.Config,
+	smsDeliveryService *service.SmsDeliveryService,
+) *TwilioStatusWebhook {
+	return &TwilioStatusWebhook{
+		smsDeliveryService: smsDeliveryService,
+		authToken:          config.TwilioAuthToken,
+		callbackUrl:        config.TwilioStatusCallbackUrl,
+	}
+}
+
+// 簽章以回呼網址計算，未設定回呼網址或 Auth Token 時無法驗證，不註冊 webhook
+func (w TwilioStatusWebhook) Register(mux *http.ServeMux) {
+	if w.callbackUrl == "" || w.authToken == "" {
+		log.Warn("TWILIO_STATUS_CALLBACK_URL or TWILIO_AUTH_TOKEN is not set, twilio status webhook is disabled")
+		return
+	}
+	mux.HandleFunc("POST "+TwilioStatusPath, w.handleStatus)
+}
+
+func (w TwilioStatusWebhook) handleStatus(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		log.WithContext(ctx).WithError(err).Error("Invalid twilio status callback")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !w.verify(r) {
+		log.WithContext(ctx).Warn("Invalid twilio status callback signature")
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	status := smser.ParseTwilioStatusCallback(r.PostForm)
+	if status.ProviderTraceId == "" {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := w.smsDeliveryService.HandleDeliveryStatus(ctx, string(entity.Provider_TWILIO), status); err != nil {
+		// 回傳錯誤讓 Twilio 重送，狀態僅在仍為已發送時更新
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"message_sid": status.ProviderTraceId,
+		}).Error("Failed to handle twilio status callback")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// 簽章為回呼網址加上依名稱排序的表單參數，以 Auth Token 計算 HMAC-SHA1 後的 base64 值；未設定回呼網址時一律拒絕
+func (w TwilioStatusWebhook) verify(r *http.Request) bool {
+	if w.callbackUrl == "" || w.authToken == "" {
+		return false
+	}
+
+	signature := r.Header.Get(twilioSignatureHeader)
+	if signature == "" {
+		return false
+	}
+
+	expected := computeTwilioSignature(w.authToken, w.callbackUrl, r.PostForm)
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+func computeTwilioSignature(authToken string, callbackUrl string, form url.Values) string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var payload strings.Builder
+	payload.WriteString(callbackUrl)
+	for _, key := range keys {
+		for _, value := range form[key] {
+			payload.WriteString(key)
+			payload.WriteString(value)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(payload.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
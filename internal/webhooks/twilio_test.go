@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	shared "notify-service/internal"
+)
+
+const (
+	testTwilioAuthToken   = "twilio-auth-token"
+	testTwilioCallbackUrl = "https://notify.example.com/webhooks/twilio/status"
+)
+
+func newTestTwilioServer(t *testing.T, config *shared.Config) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	NewTwilioStatusWebhook(config, nil).Register(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func postTwilioStatus(t *testing.T, server *httptest.Server, form url.Values, signature string) int {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+TwilioStatusPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if signature != "" {
+		request.Header.Set(twilioSignatureHeader, signature)
+	}
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("post twilio status: %v", err)
+	}
+	defer response.Body.Close()
+	return response.StatusCode
+}
+
+func TestTwilioStatusWebhookDisabledWithoutCallbackUrl(t *testing.T) {
+	configs := map[string]*shared.Config{
+		"without callback url": {TwilioAuthToken: testTwilioAuthToken},
+		"without auth token":   {TwilioStatusCallbackUrl: testTwilioCallbackUrl},
+	}
+	for name, config := range configs {
+		t.Run(name, func(t *testing.T) {
+			server := newTestTwilioServer(t, config)
+
+			form := url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"delivered"}}
+			status := postTwilioStatus(t, server, form, computeTwilioSignature(config.TwilioAuthToken, config.TwilioStatusCallbackUrl, form))
+			if status != http.StatusNotFound {
+				t.Fatalf("status = %d, want %d", status, http.StatusNotFound)
+			}
+		})
+	}
+}
+
+func TestTwilioStatusWebhookSignature(t *testing.T) {
+	server := newTestTwilioServer(t, &shared.Config{
+		TwilioAuthToken:         testTwilioAuthToken,
+		TwilioStatusCallbackUrl: testTwilioCallbackUrl,
+	})
+
+	// 未帶 MessageSid 的回呼於驗證簽章後回傳 400，不會寫入發送紀錄
+	form := url.Values{"MessageStatus": {"delivered"}, "ErrorCode": {""}}
+	tampered := url.Values{"MessageStatus": {"undelivered"}, "ErrorCode": {""}}
+
+	tests := []struct {
+		name      string
+		form      url.Values
+		signature string
+		want      int
+	}{
+		{
+			name:      "valid signature",
+			form:      form,
+			signature: computeTwilioSignature(testTwilioAuthToken, testTwilioCallbackUrl, form),
+			want:      http.StatusBadRequest,
+		},
+		{
+			name: "missing signature",
+			form: form,
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:      "tampered form",
+			form:      tampered,
+			signature: computeTwilioSignature(testTwilioAuthToken, testTwilioCallbackUrl, form),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "wrong auth token",
+			form:      form,
+			signature: computeTwilioSignature("other-token", testTwilioCallbackUrl, form),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "wrong callback url",
+			form:      form,
+			signature: computeTwilioSignature(testTwilioAuthToken, "https://attacker.example.com/", form),
+			want:      http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := postTwilioStatus(t, server, tt.form, tt.signature); status != tt.want {
+				t.Fatalf("status = %d, want %d", status, tt.want)
+			}
+		})
+	}
+}
+
+// 簽章內容為回呼網址加上依名稱排序的參數名稱與值
+func TestComputeTwilioSignature(t *testing.T) {
+	form := url.Values{
+		"To":            {"+886912345678"},
+		"MessageStatus": {"delivered"},
+		"MessageSid":    {"SM123"},
+		"AccountSid":    {"AC123"},
+	}
+
+	got := computeTwilioSignature(testTwilioAuthToken, testTwilioCallbackUrl, form)
+	if want := "qo9ENgBOor1w1zHOyohcX7IKHzM="; got != want {
+		t.Fatalf("signature = %q, want %q", got, want)
+	}
+}
@@ -34,6 +34,33 @@ const (
 	ErrorReasonCode_ERR_NOTIFY_BODY_EMPTY                       ErrorReasonCode = 1003
 	ErrorReasonCode_ERR_NOTIFY_MESSAGE_NOT_FOUND                ErrorReasonCode = 1004
 	ErrorReasonCode_ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL ErrorReasonCode = 1005
+	ErrorReasonCode_ERR_NOTIFY_QUOTA_EXCEEDED                   ErrorReasonCode = 1006
+	ErrorReasonCode_ERR_NOTIFY_RATE_LIMITED                     ErrorReasonCode = 1007
+	ErrorReasonCode_ERR_NOTIFY_OTP_NOT_FOUND                    ErrorReasonCode = 1008
+	ErrorReasonCode_ERR_NOTIFY_OTP_EXPIRED                      ErrorReasonCode = 1009
+	ErrorReasonCode_ERR_NOTIFY_OTP_ATTEMPTS_EXCEEDED            ErrorReasonCode = 1010
+	ErrorReasonCode_ERR_NOTIFY_OTP_RESEND_TOO_SOON              ErrorReasonCode = 1011
+	ErrorReasonCode_ERR_NOTIFY_OTP_INVALID_TTL                  ErrorReasonCode = 1012
+	ErrorReasonCode_ERR_NOTIFY_SUPPRESSION_NOT_FOUND            ErrorReasonCode = 1013
+	ErrorReasonCode_ERR_NOTIFY_CONTACT_NOT_FOUND                ErrorReasonCode = 1014
+	ErrorReasonCode_ERR_NOTIFY_CONTACT_ALREADY_EXISTS           ErrorReasonCode = 1015
+	ErrorReasonCode_ERR_NOTIFY_GROUP_NOT_FOUND                  ErrorReasonCode = 1016
+	ErrorReasonCode_ERR_NOTIFY_TEMPLATE_NOT_FOUND               ErrorReasonCode = 1017
+	ErrorReasonCode_ERR_NOTIFY_BULK_SEND_JOB_NOT_FOUND          ErrorReasonCode = 1018
+	ErrorReasonCode_ERR_NOTIFY_BULK_SEND_INVALID_CSV            ErrorReasonCode = 1019
+	ErrorReasonCode_ERR_NOTIFY_INVALID_SCHEDULED_AT             ErrorReasonCode = 1020
+	ErrorReasonCode_ERR_NOTIFY_INVALID_VALID_UNTIL              ErrorReasonCode = 1021
+	ErrorReasonCode_ERR_NOTIFY_INVALID_PRIORITY                 ErrorReasonCode = 1022
+	ErrorReasonCode_ERR_NOTIFY_INVALID_TIMEZONE                 ErrorReasonCode = 1023
+	ErrorReasonCode_ERR_NOTIFY_INVALID_CATEGORY                 ErrorReasonCode = 1024
+	ErrorReasonCode_ERR_NOTIFY_INVALID_GROUP_ID                 ErrorReasonCode = 1025
+	ErrorReasonCode_ERR_NOTIFY_TOO_MANY_RECEIVERS               ErrorReasonCode = 1026
+	ErrorReasonCode_ERR_NOTIFY_INVALID_SENDER_ADDRESS           ErrorReasonCode = 1027
+	ErrorReasonCode_ERR_NOTIFY_INVALID_EXPIRES_AT               ErrorReasonCode = 1028
+	ErrorReasonCode_ERR_NOTIFY_SMS_TOO_MANY_SEGMENTS            ErrorReasonCode = 1029
+	ErrorReasonCode_ERR_NOTIFY_PROVIDER_CANCEL_FAILED           ErrorReasonCode = 1030
+	ErrorReasonCode_ERR_NOTIFY_NOTIFICATION_NOT_FOUND           ErrorReasonCode = 1031
+	ErrorReasonCode_ERR_NOTIFY_OTP_RECEIVER_SUPPRESSED          ErrorReasonCode = 1032
 )
 
 // Enum value maps for ErrorReasonCode.
@@ -47,6 +74,33 @@ var (
 		1003: "ERR_NOTIFY_BODY_EMPTY",
 		1004: "ERR_NOTIFY_MESSAGE_NOT_FOUND",
 		1005: "ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL",
+		1006: "ERR_NOTIFY_QUOTA_EXCEEDED",
+		1007: "ERR_NOTIFY_RATE_LIMITED",
+		1008: "ERR_NOTIFY_OTP_NOT_FOUND",
+		1009: "ERR_NOTIFY_OTP_EXPIRED",
+		1010: "ERR_NOTIFY_OTP_ATTEMPTS_EXCEEDED",
+		1011: "ERR_NOTIFY_OTP_RESEND_TOO_SOON",
+		1012: "ERR_NOTIFY_OTP_INVALID_TTL",
+		1013: "ERR_NOTIFY_SUPPRESSION_NOT_FOUND",
+		1014: "ERR_NOTIFY_CONTACT_NOT_FOUND",
+		1015: "ERR_NOTIFY_CONTACT_ALREADY_EXISTS",
+		1016: "ERR_NOTIFY_GROUP_NOT_FOUND",
+		1017: "ERR_NOTIFY_TEMPLATE_NOT_FOUND",
+		1018: "ERR_NOTIFY_BULK_SEND_JOB_NOT_FOUND",
+		1019: "ERR_NOTIFY_BULK_SEND_INVALID_CSV",
+		1020: "ERR_NOTIFY_INVALID_SCHEDULED_AT",
+		1021: "ERR_NOTIFY_INVALID_VALID_UNTIL",
+		1022: "ERR_NOTIFY_INVALID_PRIORITY",
+		1023: "ERR_NOTIFY_INVALID_TIMEZONE",
+		1024: "ERR_NOTIFY_INVALID_CATEGORY",
+		1025: "ERR_NOTIFY_INVALID_GROUP_ID",
+		1026: "ERR_NOTIFY_TOO_MANY_RECEIVERS",
+		1027: "ERR_NOTIFY_INVALID_SENDER_ADDRESS",
+		1028: "ERR_NOTIFY_INVALID_EXPIRES_AT",
+		1029: "ERR_NOTIFY_SMS_TOO_MANY_SEGMENTS",
+		1030: "ERR_NOTIFY_PROVIDER_CANCEL_FAILED",
+		1031: "ERR_NOTIFY_NOTIFICATION_NOT_FOUND",
+		1032: "ERR_NOTIFY_OTP_RECEIVER_SUPPRESSED",
 	}
 	ErrorReasonCode_value = map[string]int32{
 		"ERR_COMMON_INTERNAL":                         0,
@@ -57,6 +111,33 @@ var (
 		"ERR_NOTIFY_BODY_EMPTY":                       1003,
 		"ERR_NOTIFY_MESSAGE_NOT_FOUND":                1004,
 		"ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL": 1005,
+		"ERR_NOTIFY_QUOTA_EXCEEDED":                   1006,
+		"ERR_NOTIFY_RATE_LIMITED":                     1007,
+		"ERR_NOTIFY_OTP_NOT_FOUND":                    1008,
+		"ERR_NOTIFY_OTP_EXPIRED":                      1009,
+		"ERR_NOTIFY_OTP_ATTEMPTS_EXCEEDED":            1010,
+		"ERR_NOTIFY_OTP_RESEND_TOO_SOON":              1011,
+		"ERR_NOTIFY_OTP_INVALID_TTL":                  1012,
+		"ERR_NOTIFY_SUPPRESSION_NOT_FOUND":            1013,
+		"ERR_NOTIFY_CONTACT_NOT_FOUND":                1014,
+		"ERR_NOTIFY_CONTACT_ALREADY_EXISTS":           1015,
+		"ERR_NOTIFY_GROUP_NOT_FOUND":                  1016,
+		"ERR_NOTIFY_TEMPLATE_NOT_FOUND":               1017,
+		"ERR_NOTIFY_BULK_SEND_JOB_NOT_FOUND":          1018,
+		"ERR_NOTIFY_BULK_SEND_INVALID_CSV":            1019,
+		"ERR_NOTIFY_INVALID_SCHEDULED_AT":             1020,
+		"ERR_NOTIFY_INVALID_VALID_UNTIL":              1021,
+		"ERR_NOTIFY_INVALID_PRIORITY":                 1022,
+		"ERR_NOTIFY_INVALID_TIMEZONE":                 1023,
+		"ERR_NOTIFY_INVALID_CATEGORY":                 1024,
+		"ERR_NOTIFY_INVALID_GROUP_ID":                 1025,
+		"ERR_NOTIFY_TOO_MANY_RECEIVERS":               1026,
+		"ERR_NOTIFY_INVALID_SENDER_ADDRESS":           1027,
+		"ERR_NOTIFY_INVALID_EXPIRES_AT":               1028,
+		"ERR_NOTIFY_SMS_TOO_MANY_SEGMENTS":            1029,
+		"ERR_NOTIFY_PROVIDER_CANCEL_FAILED":           1030,
+		"ERR_NOTIFY_NOTIFICATION_NOT_FOUND":           1031,
+		"ERR_NOTIFY_OTP_RECEIVER_SUPPRESSED":          1032,
 	}
 )
 
@@ -91,7 +172,7 @@ var File_error_error_proto protoreflect.FileDescriptor
 
 const file_error_error_proto_rawDesc = "" +
 	"\n" +
-	"\x11error/error.proto\x12\x0fnotify.v1.error*\x9d\x02\n" +
+	"\x11error/error.proto\x12\x0fnotify.v1.error*\xee\t\n" +
 	"\x0fErrorReasonCode\x12\x17\n" +
 	"\x13ERR_COMMON_INTERNAL\x10\x00\x12\x1f\n" +
 	"\x1bERR_COMMON_INVALID_ARGUMENT\x10\x01\x12 \n" +
@@ -100,7 +181,34 @@ const file_error_error_proto_rawDesc = "" +
 	"\x18ERR_NOTIFY_SUBJECT_EMPTY\x10\xea\a\x12\x1a\n" +
 	"\x15ERR_NOTIFY_BODY_EMPTY\x10\xeb\a\x12!\n" +
 	"\x1cERR_NOTIFY_MESSAGE_NOT_FOUND\x10\xec\a\x120\n" +
-	"+ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL\x10\xed\aB\x1bZ\x19proto/pkg/notify/v1/errorb\x06proto3"
+	"+ERR_NOTIFY_MESSAGE_IS_ENQUEUE_CANNOT_CANCEL\x10\xed\a\x12\x1e\n" +
+	"\x19ERR_NOTIFY_QUOTA_EXCEEDED\x10\xee\a\x12\x1c\n" +
+	"\x17ERR_NOTIFY_RATE_LIMITED\x10\xef\a\x12\x1d\n" +
+	"\x18ERR_NOTIFY_OTP_NOT_FOUND\x10\xf0\a\x12\x1b\n" +
+	"\x16ERR_NOTIFY_OTP_EXPIRED\x10\xf1\a\x12%\n" +
+	" ERR_NOTIFY_OTP_ATTEMPTS_EXCEEDED\x10\xf2\a\x12#\n" +
+	"\x1eERR_NOTIFY_OTP_RESEND_TOO_SOON\x10\xf3\a\x12\x1f\n" +
+	"\x1aERR_NOTIFY_OTP_INVALID_TTL\x10\xf4\a\x12%\n" +
+	" ERR_NOTIFY_SUPPRESSION_NOT_FOUND\x10\xf5\a\x12!\n" +
+	"\x1cERR_NOTIFY_CONTACT_NOT_FOUND\x10\xf6\a\x12&\n" +
+	"!ERR_NOTIFY_CONTACT_ALREADY_EXISTS\x10\xf7\a\x12\x1f\n" +
+	"\x1aERR_NOTIFY_GROUP_NOT_FOUND\x10\xf8\a\x12\"\n" +
+	"\x1dERR_NOTIFY_TEMPLATE_NOT_FOUND\x10\xf9\a\x12'\n" +
+	"\"ERR_NOTIFY_BULK_SEND_JOB_NOT_FOUND\x10\xfa\a\x12%\n" +
+	" ERR_NOTIFY_BULK_SEND_INVALID_CSV\x10\xfb\a\x12$\n" +
+	"\x1fERR_NOTIFY_INVALID_SCHEDULED_AT\x10\xfc\a\x12#\n" +
+	"\x1eERR_NOTIFY_INVALID_VALID_UNTIL\x10\xfd\a\x12 \n" +
+	"\x1bERR_NOTIFY_INVALID_PRIORITY\x10\xfe\a\x12 \n" +
+	"\x1bERR_NOTIFY_INVALID_TIMEZONE\x10\xff\a\x12 \n" +
+	"\x1bERR_NOTIFY_INVALID_CATEGORY\x10\x80\b\x12 \n" +
+	"\x1bERR_NOTIFY_INVALID_GROUP_ID\x10\x81\b\x12\"\n" +
+	"\x1dERR_NOTIFY_TOO_MANY_RECEIVERS\x10\x82\b\x12&\n" +
+	"!ERR_NOTIFY_INVALID_SENDER_ADDRESS\x10\x83\b\x12\"\n" +
+	"\x1dERR_NOTIFY_INVALID_EXPIRES_AT\x10\x84\b\x12%\n" +
+	" ERR_NOTIFY_SMS_TOO_MANY_SEGMENTS\x10\x85\b\x12&\n" +
+	"!ERR_NOTIFY_PROVIDER_CANCEL_FAILED\x10\x86\b\x12&\n" +
+	"!ERR_NOTIFY_NOTIFICATION_NOT_FOUND\x10\x87\b\x12'\n" +
+	"\"ERR_NOTIFY_OTP_RECEIVER_SUPPRESSED\x10\x88\bB\x1bZ\x19proto/pkg/notify/v1/errorb\x06proto3"
 
 var (
 	file_error_error_proto_rawDescOnce sync.Once
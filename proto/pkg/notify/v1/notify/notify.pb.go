@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -24,6 +25,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// *
+// 發送優先級枚舉
+type Priority int32
+
+const (
+	Priority_PRIORITY_UNSPECIFIED Priority = 0 // 未指定，視為 NORMAL
+	Priority_HIGH                 Priority = 1 // 高優先級（OTP、交易通知）
+	Priority_NORMAL               Priority = 2 // 一般
+	Priority_BULK                 Priority = 3 // 大量發送（行銷）
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "HIGH",
+		2: "NORMAL",
+		3: "BULK",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"HIGH":                 1,
+		"NORMAL":               2,
+		"BULK":                 3,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_notify_notify_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_notify_notify_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{0}
+}
+
+// *
+// 簡訊編碼
+type SmsEncoding int32
+
+const (
+	SmsEncoding_SMS_ENCODING_UNSPECIFIED SmsEncoding = 0
+	SmsEncoding_GSM7                     SmsEncoding = 1 // GSM 03.38 預設字元集，單則 160 字元，長簡訊每段 153 字元
+	SmsEncoding_UCS2                     SmsEncoding = 2 // 含中文等非 GSM 字元，單則 70 字元，長簡訊每段 67 字元
+)
+
+// Enum value maps for SmsEncoding.
+var (
+	SmsEncoding_name = map[int32]string{
+		0: "SMS_ENCODING_UNSPECIFIED",
+		1: "GSM7",
+		2: "UCS2",
+	}
+	SmsEncoding_value = map[string]int32{
+		"SMS_ENCODING_UNSPECIFIED": 0,
+		"GSM7":                     1,
+		"UCS2":                     2,
+	}
+)
+
+func (x SmsEncoding) Enum() *SmsEncoding {
+	p := new(SmsEncoding)
+	*p = x
+	return p
+}
+
+func (x SmsEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmsEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_notify_notify_proto_enumTypes[1].Descriptor()
+}
+
+func (SmsEncoding) Type() protoreflect.EnumType {
+	return &file_notify_notify_proto_enumTypes[1]
+}
+
+func (x SmsEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmsEncoding.Descriptor instead.
+func (SmsEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{1}
+}
+
 // *
 // 訊息類型枚舉
 type MessageType int32
@@ -32,6 +138,9 @@ const (
 	MessageType_MESSAGE_TYPE_UNSPECIFIED MessageType = 0
 	MessageType_SMS                      MessageType = 1
 	MessageType_MAIL                     MessageType = 2
+	MessageType_WEBHOOK                  MessageType = 3
+	MessageType_PUSH                     MessageType = 4
+	MessageType_LINE                     MessageType = 5
 )
 
 // Enum value maps for MessageType.
@@ -40,11 +149,17 @@ var (
 		0: "MESSAGE_TYPE_UNSPECIFIED",
 		1: "SMS",
 		2: "MAIL",
+		3: "WEBHOOK",
+		4: "PUSH",
+		5: "LINE",
 	}
 	MessageType_value = map[string]int32{
 		"MESSAGE_TYPE_UNSPECIFIED": 0,
 		"SMS":                      1,
 		"MAIL":                     2,
+		"WEBHOOK":                  3,
+		"PUSH":                     4,
+		"LINE":                     5,
 	}
 )
 
@@ -59,11 +174,11 @@ func (x MessageType) String() string {
 }
 
 func (MessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_notify_notify_proto_enumTypes[0].Descriptor()
+	return file_notify_notify_proto_enumTypes[2].Descriptor()
 }
 
 func (MessageType) Type() protoreflect.EnumType {
-	return &file_notify_notify_proto_enumTypes[0]
+	return &file_notify_notify_proto_enumTypes[2]
 }
 
 func (x MessageType) Number() protoreflect.EnumNumber {
@@ -72,7 +187,70 @@ func (x MessageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageType.Descriptor instead.
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return file_notify_notify_proto_rawDescGZIP(), []int{0}
+	return file_notify_notify_proto_rawDescGZIP(), []int{2}
+}
+
+// *
+// 抑制名單原因枚舉
+type SuppressionReason int32
+
+const (
+	SuppressionReason_SUPPRESSION_REASON_UNSPECIFIED SuppressionReason = 0
+	SuppressionReason_BOUNCE                         SuppressionReason = 1 // 硬退信
+	SuppressionReason_SPAM_REPORT                    SuppressionReason = 2 // 垃圾郵件檢舉
+	SuppressionReason_INVALID_NUMBER                 SuppressionReason = 3 // 無效的手機號碼
+	SuppressionReason_MANUAL                         SuppressionReason = 4 // 手動加入
+	SuppressionReason_OPT_OUT                        SuppressionReason = 5 // 收件者回覆退訂
+	SuppressionReason_INVALID_TOKEN                  SuppressionReason = 6 // 無效的推播裝置 token，呼叫端可據此清除已失效的 token
+)
+
+// Enum value maps for SuppressionReason.
+var (
+	SuppressionReason_name = map[int32]string{
+		0: "SUPPRESSION_REASON_UNSPECIFIED",
+		1: "BOUNCE",
+		2: "SPAM_REPORT",
+		3: "INVALID_NUMBER",
+		4: "MANUAL",
+		5: "OPT_OUT",
+		6: "INVALID_TOKEN",
+	}
+	SuppressionReason_value = map[string]int32{
+		"SUPPRESSION_REASON_UNSPECIFIED": 0,
+		"BOUNCE":                         1,
+		"SPAM_REPORT":                    2,
+		"INVALID_NUMBER":                 3,
+		"MANUAL":                         4,
+		"OPT_OUT":                        5,
+		"INVALID_TOKEN":                  6,
+	}
+)
+
+func (x SuppressionReason) Enum() *SuppressionReason {
+	p := new(SuppressionReason)
+	*p = x
+	return p
+}
+
+func (x SuppressionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuppressionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_notify_notify_proto_enumTypes[3].Descriptor()
+}
+
+func (SuppressionReason) Type() protoreflect.EnumType {
+	return &file_notify_notify_proto_enumTypes[3]
+}
+
+func (x SuppressionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuppressionReason.Descriptor instead.
+func (SuppressionReason) EnumDescriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{3}
 }
 
 // *
@@ -126,8 +304,15 @@ func (x *Sms) GetBody() string {
 type SendSmsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sms           *Sms                   `protobuf:"bytes,1,opt,name=sms,proto3" json:"sms,omitempty"`                                    // 簡訊
-	Receivers     []string               `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`                        // 收件者手機號碼
+	Receivers     []string               `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`                        // 收件者手機號碼（E.164 格式，或預設地區的國內格式，伺服器端正規化為 E.164）
 	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 預約時間（限制30天內，可選填）
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"` // 發送優先級（可選填，預設 NORMAL）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`    // 有效期限，逾期未發送則不再發送（可選填）
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                          // 收件者時區（IANA 格式，例如 Asia/Taipei，可選填，用於勿擾時段計算）
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`                          // 訊息分類（例如 promo，可選填，用於發送頻率上限計算）
+	GroupIds      []string               `protobuf:"bytes,8,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`          // 聯絡人群組 ID 列表（可選填，伺服器端展開為收件者，與 receivers 至少擇一）
+	ClientRef     string                 `protobuf:"bytes,9,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`       // 呼叫端自訂識別碼（可選填，批量發送時原樣回傳以對應請求）
+	Fallback      *FallbackPolicy        `protobuf:"bytes,10,opt,name=fallback,proto3" json:"fallback,omitempty"`                         // 備援通道（可選填，未在期限內送達時依序改由備援通道發送，不可與 group_ids 併用）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -183,11 +368,62 @@ func (x *SendSmsRequest) GetScheduledAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SendSmsRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SendSmsRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *SendSmsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendSmsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendSmsRequest) GetGroupIds() []string {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+func (x *SendSmsRequest) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendSmsRequest) GetFallback() *FallbackPolicy {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
 // *
 // 發送簡訊響應
 type SendSmsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID（發送失敗時為空）
+	ClientRef     string                 `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"` // 對應請求的 client_ref
+	Error         *ItemError             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                          // 批量發送時單筆失敗的錯誤資訊（成功時為空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,32 +465,45 @@ func (x *SendSmsResponse) GetMessageId() string {
 	return ""
 }
 
+func (x *SendSmsResponse) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendSmsResponse) GetError() *ItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 // *
-// 郵件
-type Mail struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	SenderAddress *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"` // 寄件者電子郵件地址
-	SenderName    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`          // 寄件者名稱
-	Subject       string                  `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`                                  // 郵件主旨
-	Body          string                  `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`                                        // 郵件內容
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 批量發送單筆失敗的錯誤資訊
+type ItemError struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Reason          string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`                                          // 錯誤原因代碼（ErrorReasonCode 名稱）
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                        // 錯誤訊息
+	FieldViolations []*FieldViolation      `protobuf:"bytes,3,rep,name=field_violations,json=fieldViolations,proto3" json:"field_violations,omitempty"` // 欄位驗證錯誤
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Mail) Reset() {
-	*x = Mail{}
+func (x *ItemError) Reset() {
+	*x = ItemError{}
 	mi := &file_notify_notify_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Mail) String() string {
+func (x *ItemError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Mail) ProtoMessage() {}
+func (*ItemError) ProtoMessage() {}
 
-func (x *Mail) ProtoReflect() protoreflect.Message {
+func (x *ItemError) ProtoReflect() protoreflect.Message {
 	mi := &file_notify_notify_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -266,64 +515,57 @@ func (x *Mail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
-func (*Mail) Descriptor() ([]byte, []int) {
+// Deprecated: Use ItemError.ProtoReflect.Descriptor instead.
+func (*ItemError) Descriptor() ([]byte, []int) {
 	return file_notify_notify_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Mail) GetSenderAddress() *wrapperspb.StringValue {
-	if x != nil {
-		return x.SenderAddress
-	}
-	return nil
-}
-
-func (x *Mail) GetSenderName() *wrapperspb.StringValue {
+func (x *ItemError) GetReason() string {
 	if x != nil {
-		return x.SenderName
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *Mail) GetSubject() string {
+func (x *ItemError) GetMessage() string {
 	if x != nil {
-		return x.Subject
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Mail) GetBody() string {
+func (x *ItemError) GetFieldViolations() []*FieldViolation {
 	if x != nil {
-		return x.Body
+		return x.FieldViolations
 	}
-	return ""
+	return nil
 }
 
 // *
-// 發送郵件請求
-type SendMailRequest struct {
+// 欄位驗證錯誤
+type FieldViolation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mail          *Mail                  `protobuf:"bytes,1,opt,name=mail,proto3" json:"mail,omitempty"`                                  // 郵件資訊
-	Receivers     []string               `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`                        // 收件者電子郵件地址
-	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 預約時間（限制30天內，可選填）
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`             // 欄位路徑（例如 receivers[3]、mail.subject）
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 錯誤說明（依 ERROR_LOCALE 設定的語系）
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`           // 錯誤原因代碼（ErrorReasonCode 名稱）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMailRequest) Reset() {
-	*x = SendMailRequest{}
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
 	mi := &file_notify_notify_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMailRequest) String() string {
+func (x *FieldViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMailRequest) ProtoMessage() {}
+func (*FieldViolation) ProtoMessage() {}
 
-func (x *SendMailRequest) ProtoReflect() protoreflect.Message {
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
 	mi := &file_notify_notify_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,55 +577,57 @@ func (x *SendMailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMailRequest.ProtoReflect.Descriptor instead.
-func (*SendMailRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldViolation.ProtoReflect.Descriptor instead.
+func (*FieldViolation) Descriptor() ([]byte, []int) {
 	return file_notify_notify_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SendMailRequest) GetMail() *Mail {
+func (x *FieldViolation) GetField() string {
 	if x != nil {
-		return x.Mail
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *SendMailRequest) GetReceivers() []string {
+func (x *FieldViolation) GetDescription() string {
 	if x != nil {
-		return x.Receivers
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *SendMailRequest) GetScheduledAt() *timestamppb.Timestamp {
+func (x *FieldViolation) GetReason() string {
 	if x != nil {
-		return x.ScheduledAt
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
 // *
-// 發送郵件響應
-type SendMailResponse struct {
+// 簡訊費用估算請求
+type EstimateSmsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	Sms           *Sms                   `protobuf:"bytes,1,opt,name=sms,proto3" json:"sms,omitempty"`                           // 簡訊
+	Receivers     []string               `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`               // 收件者手機號碼（可選填，用於依門號地區估算費用）
+	GroupIds      []string               `protobuf:"bytes,3,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"` // 聯絡人群組 ID 列表（可選填，伺服器端展開為收件者）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMailResponse) Reset() {
-	*x = SendMailResponse{}
+func (x *EstimateSmsRequest) Reset() {
+	*x = EstimateSmsRequest{}
 	mi := &file_notify_notify_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMailResponse) String() string {
+func (x *EstimateSmsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMailResponse) ProtoMessage() {}
+func (*EstimateSmsRequest) ProtoMessage() {}
 
-func (x *SendMailResponse) ProtoReflect() protoreflect.Message {
+func (x *EstimateSmsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_notify_notify_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,41 +639,58 @@ func (x *SendMailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMailResponse.ProtoReflect.Descriptor instead.
-func (*SendMailResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateSmsRequest.ProtoReflect.Descriptor instead.
+func (*EstimateSmsRequest) Descriptor() ([]byte, []int) {
 	return file_notify_notify_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SendMailResponse) GetMessageId() string {
+func (x *EstimateSmsRequest) GetSms() *Sms {
 	if x != nil {
-		return x.MessageId
+		return x.Sms
 	}
-	return ""
+	return nil
+}
+
+func (x *EstimateSmsRequest) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *EstimateSmsRequest) GetGroupIds() []string {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
 }
 
 // *
-// 取消預約訊息請求
-type CancelScheduledByMessageIdRequest struct {
+// 單一收件者的估算結果
+type SmsReceiverEstimate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	Receiver      string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`  // 收件者手機號碼（E.164 格式）
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`      // 門號所屬地區代碼，例如 TW、JP
+	Segments      int32                  `protobuf:"varint,3,opt,name=segments,proto3" json:"segments,omitempty"` // 分段數
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`        // 預估費用
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelScheduledByMessageIdRequest) Reset() {
-	*x = CancelScheduledByMessageIdRequest{}
+func (x *SmsReceiverEstimate) Reset() {
+	*x = SmsReceiverEstimate{}
 	mi := &file_notify_notify_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelScheduledByMessageIdRequest) String() string {
+func (x *SmsReceiverEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelScheduledByMessageIdRequest) ProtoMessage() {}
+func (*SmsReceiverEstimate) ProtoMessage() {}
 
-func (x *CancelScheduledByMessageIdRequest) ProtoReflect() protoreflect.Message {
+func (x *SmsReceiverEstimate) ProtoReflect() protoreflect.Message {
 	mi := &file_notify_notify_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,44 +702,68 @@ func (x *CancelScheduledByMessageIdRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelScheduledByMessageIdRequest.ProtoReflect.Descriptor instead.
-func (*CancelScheduledByMessageIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SmsReceiverEstimate.ProtoReflect.Descriptor instead.
+func (*SmsReceiverEstimate) Descriptor() ([]byte, []int) {
 	return file_notify_notify_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CancelScheduledByMessageIdRequest) GetMessageId() string {
+func (x *SmsReceiverEstimate) GetReceiver() string {
 	if x != nil {
-		return x.MessageId
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *SmsReceiverEstimate) GetRegion() string {
+	if x != nil {
+		return x.Region
 	}
 	return ""
 }
 
+func (x *SmsReceiverEstimate) GetSegments() int32 {
+	if x != nil {
+		return x.Segments
+	}
+	return 0
+}
+
+func (x *SmsReceiverEstimate) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
 // *
-// 分頁請求資訊
-type PageRequest struct {
+// 簡訊費用估算響應
+type EstimateSmsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                         // 當前分頁索引
-	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                           // 每頁筆數
-	SortField     string                 `protobuf:"bytes,3,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"` // 排序欄位
-	SortOrder     string                 `protobuf:"bytes,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序方向（ASC 或 DESC）
+	Encoding      SmsEncoding            `protobuf:"varint,1,opt,name=encoding,proto3,enum=notify.v1.SmsEncoding" json:"encoding,omitempty"`      // 編碼
+	Characters    int32                  `protobuf:"varint,2,opt,name=characters,proto3" json:"characters,omitempty"`                             // 字元數
+	Segments      int32                  `protobuf:"varint,3,opt,name=segments,proto3" json:"segments,omitempty"`                                 // 每位收件者的分段數
+	MaxSegments   int32                  `protobuf:"varint,4,opt,name=max_segments,json=maxSegments,proto3" json:"max_segments,omitempty"`        // SendSms 允許的分段數上限（0 表示不限制）
+	EstimatedCost float64                `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"` // 全部收件者的預估費用
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                                  // 幣別
+	Receivers     []*SmsReceiverEstimate `protobuf:"bytes,7,rep,name=receivers,proto3" json:"receivers,omitempty"`                                // 各收件者的估算結果
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageRequest) Reset() {
-	*x = PageRequest{}
+func (x *EstimateSmsResponse) Reset() {
+	*x = EstimateSmsResponse{}
 	mi := &file_notify_notify_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageRequest) String() string {
+func (x *EstimateSmsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageRequest) ProtoMessage() {}
+func (*EstimateSmsResponse) ProtoMessage() {}
 
-func (x *PageRequest) ProtoReflect() protoreflect.Message {
+func (x *EstimateSmsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_notify_notify_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,71 +775,4241 @@ func (x *PageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
-func (*PageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateSmsResponse.ProtoReflect.Descriptor instead.
+func (*EstimateSmsResponse) Descriptor() ([]byte, []int) {
 	return file_notify_notify_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PageRequest) GetIndex() int32 {
+func (x *EstimateSmsResponse) GetEncoding() SmsEncoding {
 	if x != nil {
-		return x.Index
+		return x.Encoding
+	}
+	return SmsEncoding_SMS_ENCODING_UNSPECIFIED
+}
+
+func (x *EstimateSmsResponse) GetCharacters() int32 {
+	if x != nil {
+		return x.Characters
 	}
 	return 0
 }
 
-func (x *PageRequest) GetSize() int32 {
+func (x *EstimateSmsResponse) GetSegments() int32 {
 	if x != nil {
-		return x.Size
+		return x.Segments
 	}
 	return 0
 }
 
-func (x *PageRequest) GetSortField() string {
+func (x *EstimateSmsResponse) GetMaxSegments() int32 {
 	if x != nil {
-		return x.SortField
+		return x.MaxSegments
+	}
+	return 0
+}
+
+func (x *EstimateSmsResponse) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+func (x *EstimateSmsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *PageRequest) GetSortOrder() string {
+func (x *EstimateSmsResponse) GetReceivers() []*SmsReceiverEstimate {
 	if x != nil {
-		return x.SortOrder
+		return x.Receivers
+	}
+	return nil
+}
+
+// *
+// 郵件
+type Mail struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SenderAddress *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"` // 寄件者電子郵件地址
+	SenderName    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`          // 寄件者名稱
+	Subject       string                  `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`                                  // 郵件主旨
+	Body          string                  `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`                                        // 郵件內容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	mi := &file_notify_notify_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Mail) GetSenderAddress() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SenderAddress
+	}
+	return nil
+}
+
+func (x *Mail) GetSenderName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SenderName
+	}
+	return nil
+}
+
+func (x *Mail) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Mail) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// *
+// 發送郵件請求
+type SendMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mail          *Mail                  `protobuf:"bytes,1,opt,name=mail,proto3" json:"mail,omitempty"`                                  // 郵件資訊
+	Receivers     []string               `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`                        // 收件者電子郵件地址
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 預約時間（限制30天內，可選填）
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"` // 發送優先級（可選填，預設 NORMAL）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`    // 有效期限，逾期未發送則不再發送（可選填）
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                          // 收件者時區（IANA 格式，例如 Asia/Taipei，可選填，用於勿擾時段計算）
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`                          // 訊息分類（例如 promo，可選填，用於發送頻率上限計算）
+	GroupIds      []string               `protobuf:"bytes,8,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`          // 聯絡人群組 ID 列表（可選填，伺服器端展開為收件者，與 receivers 至少擇一）
+	ClientRef     string                 `protobuf:"bytes,9,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`       // 呼叫端自訂識別碼（可選填，批量發送時原樣回傳以對應請求）
+	Fallback      *FallbackPolicy        `protobuf:"bytes,10,opt,name=fallback,proto3" json:"fallback,omitempty"`                         // 備援通道（可選填，未在期限內送達時依序改由備援通道發送，不可與 group_ids 併用）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMailRequest) Reset() {
+	*x = SendMailRequest{}
+	mi := &file_notify_notify_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMailRequest) ProtoMessage() {}
+
+func (x *SendMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMailRequest.ProtoReflect.Descriptor instead.
+func (*SendMailRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SendMailRequest) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *SendMailRequest) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *SendMailRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *SendMailRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SendMailRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *SendMailRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetGroupIds() []string {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+func (x *SendMailRequest) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetFallback() *FallbackPolicy {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+// *
+// 發送郵件響應
+type SendMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID（發送失敗時為空）
+	ClientRef     string                 `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"` // 對應請求的 client_ref
+	Error         *ItemError             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                          // 批量發送時單筆失敗的錯誤資訊（成功時為空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMailResponse) Reset() {
+	*x = SendMailResponse{}
+	mi := &file_notify_notify_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMailResponse) ProtoMessage() {}
+
+func (x *SendMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMailResponse.ProtoReflect.Descriptor instead.
+func (*SendMailResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendMailResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SendMailResponse) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
 	}
 	return ""
 }
 
+func (x *SendMailResponse) GetError() *ItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// *
+// 推播通知
+type Push struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                                                         // 標題
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`                                                                           // 內容
+	Data          map[string]string      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 自訂資料（可選填，由 App 自行解讀）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Push) Reset() {
+	*x = Push{}
+	mi := &file_notify_notify_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Push) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Push) ProtoMessage() {}
+
+func (x *Push) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Push.ProtoReflect.Descriptor instead.
+func (*Push) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Push) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Push) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Push) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// *
+// 發送推播通知請求
+type SendPushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Push          *Push                  `protobuf:"bytes,1,opt,name=push,proto3" json:"push,omitempty"`                                     // 推播通知
+	DeviceTokens  []string               `protobuf:"bytes,2,rep,name=device_tokens,json=deviceTokens,proto3" json:"device_tokens,omitempty"` // 裝置 token（FCM registration token 或 APNs device token）
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`    // 預約時間（限制30天內，可選填）
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"`    // 發送優先級（可選填，預設 NORMAL）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`       // 有效期限，逾期未發送則不再發送，裝置離線時保留至此時間（可選填）
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                             // 收件者時區（IANA 格式，例如 Asia/Taipei，可選填，用於勿擾時段計算）
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`                             // 訊息分類（例如 promo，可選填，用於發送頻率上限計算）
+	ClientRef     string                 `protobuf:"bytes,8,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`          // 呼叫端自訂識別碼（可選填，批量發送時原樣回傳以對應請求）
+	Fallback      *FallbackPolicy        `protobuf:"bytes,9,opt,name=fallback,proto3" json:"fallback,omitempty"`                             // 備援通道（可選填，未在期限內送達時依序改由備援通道發送）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPushRequest) Reset() {
+	*x = SendPushRequest{}
+	mi := &file_notify_notify_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPushRequest) ProtoMessage() {}
+
+func (x *SendPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPushRequest.ProtoReflect.Descriptor instead.
+func (*SendPushRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendPushRequest) GetPush() *Push {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *SendPushRequest) GetDeviceTokens() []string {
+	if x != nil {
+		return x.DeviceTokens
+	}
+	return nil
+}
+
+func (x *SendPushRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *SendPushRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SendPushRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *SendPushRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetFallback() *FallbackPolicy {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+// *
+// 發送推播通知響應
+type SendPushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID（發送失敗時為空）
+	ClientRef     string                 `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"` // 對應請求的 client_ref
+	Error         *ItemError             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                          // 批量發送時單筆失敗的錯誤資訊（成功時為空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPushResponse) Reset() {
+	*x = SendPushResponse{}
+	mi := &file_notify_notify_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPushResponse) ProtoMessage() {}
+
+func (x *SendPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPushResponse.ProtoReflect.Descriptor instead.
+func (*SendPushResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SendPushResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SendPushResponse) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendPushResponse) GetError() *ItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// *
+// LINE 訊息，文字訊息與 Flex 訊息擇一
+type Line struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`                                     // 文字訊息
+	AltText       string                 `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`                // Flex 訊息的替代文字（使用 flex_contents 時必填，顯示於通知與聊天列表）
+	FlexContents  string                 `protobuf:"bytes,3,opt,name=flex_contents,json=flexContents,proto3" json:"flex_contents,omitempty"` // Flex 訊息內容 JSON（bubble 或 carousel 容器）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Line) Reset() {
+	*x = Line{}
+	mi := &file_notify_notify_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Line) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Line) ProtoMessage() {}
+
+func (x *Line) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Line.ProtoReflect.Descriptor instead.
+func (*Line) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Line) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Line) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *Line) GetFlexContents() string {
+	if x != nil {
+		return x.FlexContents
+	}
+	return ""
+}
+
+// *
+// 發送 LINE 訊息請求
+type SendLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          *Line                  `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`                                  // LINE 訊息
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`             // LINE 使用者 ID（單一使用者以 push 發送，多位使用者以 multicast 發送）
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 預約時間（限制30天內，可選填）
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"` // 發送優先級（可選填，預設 NORMAL）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`    // 有效期限，逾期未發送則不再發送（可選填）
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                          // 收件者時區（IANA 格式，例如 Asia/Taipei，可選填，用於勿擾時段計算）
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`                          // 訊息分類（例如 promo，可選填，用於發送頻率上限計算）
+	ClientRef     string                 `protobuf:"bytes,8,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`       // 呼叫端自訂識別碼（可選填，原樣回傳以對應請求）
+	Fallback      *FallbackPolicy        `protobuf:"bytes,9,opt,name=fallback,proto3" json:"fallback,omitempty"`                          // 備援通道（可選填，未在期限內送達時依序改由備援通道發送）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendLineRequest) Reset() {
+	*x = SendLineRequest{}
+	mi := &file_notify_notify_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLineRequest) ProtoMessage() {}
+
+func (x *SendLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLineRequest.ProtoReflect.Descriptor instead.
+func (*SendLineRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SendLineRequest) GetLine() *Line {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *SendLineRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *SendLineRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *SendLineRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SendLineRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *SendLineRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendLineRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendLineRequest) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendLineRequest) GetFallback() *FallbackPolicy {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+// *
+// 發送 LINE 訊息響應
+type SendLineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	ClientRef     string                 `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"` // 對應請求的 client_ref
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendLineResponse) Reset() {
+	*x = SendLineResponse{}
+	mi := &file_notify_notify_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLineResponse) ProtoMessage() {}
+
+func (x *SendLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLineResponse.ProtoReflect.Descriptor instead.
+func (*SendLineResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SendLineResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SendLineResponse) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+// *
+// 外部 HTTP 推送
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // 推送網址（http 或 https）
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                                                                             // HTTP 方法（可選填，預設 POST）
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 自訂請求標頭（可選填，加密保存）
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`                                                                                 // 請求內容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_notify_notify_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Webhook) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Webhook) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// *
+// 發送外部 HTTP 推送請求
+type SendWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`                            // 推送內容
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 預約時間（限制30天內，可選填）
+	Priority      Priority               `protobuf:"varint,3,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"` // 發送優先級（可選填，預設 NORMAL）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`    // 有效期限，逾期未發送則不再發送（可選填）
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                          // 訊息分類（可選填）
+	ClientRef     string                 `protobuf:"bytes,6,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`       // 呼叫端自訂識別碼（可選填，原樣回傳以對應請求）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWebhookRequest) Reset() {
+	*x = SendWebhookRequest{}
+	mi := &file_notify_notify_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWebhookRequest) ProtoMessage() {}
+
+func (x *SendWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SendWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SendWebhookRequest) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *SendWebhookRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *SendWebhookRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SendWebhookRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *SendWebhookRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendWebhookRequest) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+// *
+// 發送外部 HTTP 推送響應
+type SendWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	ClientRef     string                 `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"` // 對應請求的 client_ref
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWebhookResponse) Reset() {
+	*x = SendWebhookResponse{}
+	mi := &file_notify_notify_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWebhookResponse) ProtoMessage() {}
+
+func (x *SendWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWebhookResponse.ProtoReflect.Descriptor instead.
+func (*SendWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SendWebhookResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SendWebhookResponse) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+// *
+// 備援通道，前一通道未在期限內送達時改由此通道發送
+type FallbackStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       MessageType            `protobuf:"varint,1,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"` // 備援通道（SMS、MAIL、PUSH 或 LINE）
+	Deadline      *durationpb.Duration   `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`                           // 等待前一通道送達的期限（1 分鐘至 24 小時）
+	Receivers     []string               `protobuf:"bytes,3,rep,name=receivers,proto3" json:"receivers,omitempty"`                         // 此通道的收件者，依序對應原請求的收件者，空字串表示該收件者不改由此通道及後續通道發送
+	Sms           *Sms                   `protobuf:"bytes,4,opt,name=sms,proto3" json:"sms,omitempty"`                                     // 簡訊內容（channel 為 SMS 時必填）
+	Mail          *Mail                  `protobuf:"bytes,5,opt,name=mail,proto3" json:"mail,omitempty"`                                   // 郵件內容（channel 為 MAIL 時必填）
+	Push          *Push                  `protobuf:"bytes,6,opt,name=push,proto3" json:"push,omitempty"`                                   // 推播內容（channel 為 PUSH 時必填）
+	Line          *Line                  `protobuf:"bytes,7,opt,name=line,proto3" json:"line,omitempty"`                                   // LINE 訊息內容（channel 為 LINE 時必填）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FallbackStep) Reset() {
+	*x = FallbackStep{}
+	mi := &file_notify_notify_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FallbackStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackStep) ProtoMessage() {}
+
+func (x *FallbackStep) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackStep.ProtoReflect.Descriptor instead.
+func (*FallbackStep) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FallbackStep) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *FallbackStep) GetDeadline() *durationpb.Duration {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *FallbackStep) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *FallbackStep) GetSms() *Sms {
+	if x != nil {
+		return x.Sms
+	}
+	return nil
+}
+
+func (x *FallbackStep) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *FallbackStep) GetPush() *Push {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *FallbackStep) GetLine() *Line {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+// *
+// 備援策略，前一通道發送失敗、收件者在抑制名單中或逾期未送達時，依序改由下一個通道發送
+type FallbackPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steps         []*FallbackStep        `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"` // 依序嘗試的備援通道（最多 3 個）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FallbackPolicy) Reset() {
+	*x = FallbackPolicy{}
+	mi := &file_notify_notify_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FallbackPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackPolicy) ProtoMessage() {}
+
+func (x *FallbackPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackPolicy.ProtoReflect.Descriptor instead.
+func (*FallbackPolicy) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FallbackPolicy) GetSteps() []*FallbackStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// *
+// 取消預約訊息請求
+type CancelScheduledByMessageIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledByMessageIdRequest) Reset() {
+	*x = CancelScheduledByMessageIdRequest{}
+	mi := &file_notify_notify_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledByMessageIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledByMessageIdRequest) ProtoMessage() {}
+
+func (x *CancelScheduledByMessageIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledByMessageIdRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledByMessageIdRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelScheduledByMessageIdRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// *
+// 查詢訊息請求
+type GetMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 訊息 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageRequest) Reset() {
+	*x = GetMessageRequest{}
+	mi := &file_notify_notify_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRequest) ProtoMessage() {}
+
+func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// *
+// 訊息的備援狀態
+type MessageFallback struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Step              int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`                                                     // 備援順序（1 為第一個備援通道）
+	Channel           MessageType            `protobuf:"varint,2,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"`                    // 備援通道
+	Status            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                                  // 備援狀態（pending/triggered/skipped/canceled/failed）
+	DeadlineAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`                        // 等待本訊息送達的期限
+	FallbackMessageId string                 `protobuf:"bytes,5,opt,name=fallback_message_id,json=fallbackMessageId,proto3" json:"fallback_message_id,omitempty"` // 已建立的備援訊息 ID
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MessageFallback) Reset() {
+	*x = MessageFallback{}
+	mi := &file_notify_notify_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageFallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFallback) ProtoMessage() {}
+
+func (x *MessageFallback) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFallback.ProtoReflect.Descriptor instead.
+func (*MessageFallback) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MessageFallback) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *MessageFallback) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *MessageFallback) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MessageFallback) GetDeadlineAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return nil
+}
+
+func (x *MessageFallback) GetFallbackMessageId() string {
+	if x != nil {
+		return x.FallbackMessageId
+	}
+	return ""
+}
+
+// *
+// 訊息
+type Message struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MessageId             string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                                                                                     // 訊息 ID
+	MessageType           MessageType            `protobuf:"varint,2,opt,name=message_type,json=messageType,proto3,enum=notify.v1.MessageType" json:"message_type,omitempty"`                                                   // 訊息類型
+	Status                string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                                                                                            // 訊息狀態
+	Category              string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`                                                                                                        // 訊息分類
+	ScheduledAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`                                                                               // 預約時間
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                                                     // 建立時間
+	TargetCounts          map[string]int32       `protobuf:"bytes,7,rep,name=target_counts,json=targetCounts,proto3" json:"target_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 各發送狀態的收件者數量
+	FallbackFromMessageId string                 `protobuf:"bytes,8,opt,name=fallback_from_message_id,json=fallbackFromMessageId,proto3" json:"fallback_from_message_id,omitempty"`                                             // 觸發本訊息的原訊息 ID（本訊息為備援訊息時）
+	Fallback              *MessageFallback       `protobuf:"bytes,9,opt,name=fallback,proto3" json:"fallback,omitempty"`                                                                                                        // 本訊息的備援狀態（未設定備援時為空）
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_notify_notify_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Message) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Message) GetMessageType() MessageType {
+	if x != nil {
+		return x.MessageType
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *Message) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Message) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Message) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Message) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Message) GetTargetCounts() map[string]int32 {
+	if x != nil {
+		return x.TargetCounts
+	}
+	return nil
+}
+
+func (x *Message) GetFallbackFromMessageId() string {
+	if x != nil {
+		return x.FallbackFromMessageId
+	}
+	return ""
+}
+
+func (x *Message) GetFallback() *MessageFallback {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+// *
+// 發送多通道通知請求，各通道的內容與收件者成對填寫，至少填寫一個通道
+type SendNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sms           *Sms                   `protobuf:"bytes,1,opt,name=sms,proto3" json:"sms,omitempty"`                                          // 簡訊內容（可選填，與 sms_receivers 同時填寫）
+	SmsReceivers  []string               `protobuf:"bytes,2,rep,name=sms_receivers,json=smsReceivers,proto3" json:"sms_receivers,omitempty"`    // 簡訊收件者手機號碼（E.164 格式，或預設地區的國內格式，伺服器端正規化為 E.164）
+	Mail          *Mail                  `protobuf:"bytes,3,opt,name=mail,proto3" json:"mail,omitempty"`                                        // 郵件資訊（可選填，與 mail_receivers 同時填寫）
+	MailReceivers []string               `protobuf:"bytes,4,rep,name=mail_receivers,json=mailReceivers,proto3" json:"mail_receivers,omitempty"` // 郵件收件者電子郵件地址
+	Push          *Push                  `protobuf:"bytes,5,opt,name=push,proto3" json:"push,omitempty"`                                        // 推播通知（可選填，與 device_tokens 同時填寫）
+	DeviceTokens  []string               `protobuf:"bytes,6,rep,name=device_tokens,json=deviceTokens,proto3" json:"device_tokens,omitempty"`    // 推播裝置 token（FCM registration token 或 APNs device token）
+	Line          *Line                  `protobuf:"bytes,7,opt,name=line,proto3" json:"line,omitempty"`                                        // LINE 訊息（可選填，與 line_user_ids 同時填寫）
+	LineUserIds   []string               `protobuf:"bytes,8,rep,name=line_user_ids,json=lineUserIds,proto3" json:"line_user_ids,omitempty"`     // LINE 使用者 ID
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`       // 預約時間（限制30天內，可選填）
+	Priority      Priority               `protobuf:"varint,10,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"`      // 發送優先級（可選填，預設 NORMAL）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`         // 有效期限，逾期未發送則不再發送（可選填）
+	Timezone      string                 `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`                               // 收件者時區（IANA 格式，例如 Asia/Taipei，可選填，用於勿擾時段計算）
+	Category      string                 `protobuf:"bytes,13,opt,name=category,proto3" json:"category,omitempty"`                               // 訊息分類（例如 promo，可選填，用於發送頻率上限計算）
+	ClientRef     string                 `protobuf:"bytes,14,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`            // 呼叫端自訂識別碼（可選填，原樣回傳以對應請求）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationRequest) Reset() {
+	*x = SendNotificationRequest{}
+	mi := &file_notify_notify_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest) ProtoMessage() {}
+
+func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SendNotificationRequest) GetSms() *Sms {
+	if x != nil {
+		return x.Sms
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetSmsReceivers() []string {
+	if x != nil {
+		return x.SmsReceivers
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetMailReceivers() []string {
+	if x != nil {
+		return x.MailReceivers
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetPush() *Push {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetDeviceTokens() []string {
+	if x != nil {
+		return x.DeviceTokens
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetLine() *Line {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetLineUserIds() []string {
+	if x != nil {
+		return x.LineUserIds
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SendNotificationRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+// *
+// 發送多通道通知響應
+type SendNotificationResponse struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	NotificationId string                       `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"` // 通知 ID
+	ClientRef      string                       `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`                // 對應請求的 client_ref
+	Results        []*NotificationChannelResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`                                     // 各通道的建立結果
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	mi := &file_notify_notify_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SendNotificationResponse) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetClientRef() string {
+	if x != nil {
+		return x.ClientRef
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetResults() []*NotificationChannelResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// *
+// 多通道通知各通道的建立結果
+type NotificationChannelResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       MessageType            `protobuf:"varint,1,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"` // 通道
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`        // 訊息 ID（建立失敗時為空）
+	Error         *ItemError             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                                 // 建立失敗的錯誤資訊（成功時為空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationChannelResult) Reset() {
+	*x = NotificationChannelResult{}
+	mi := &file_notify_notify_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannelResult) ProtoMessage() {}
+
+func (x *NotificationChannelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannelResult.ProtoReflect.Descriptor instead.
+func (*NotificationChannelResult) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NotificationChannelResult) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationChannelResult) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *NotificationChannelResult) GetError() *ItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// *
+// 取消預約通知請求
+type CancelNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"` // 通知 ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelNotificationRequest) Reset() {
+	*x = CancelNotificationRequest{}
+	mi := &file_notify_notify_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNotificationRequest) ProtoMessage() {}
+
+func (x *CancelNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CancelNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CancelNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+// *
+// 查詢通知請求
+type GetNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"` // 通知 ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetNotificationRequest) Reset() {
+	*x = GetNotificationRequest{}
+	mi := &file_notify_notify_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationRequest) ProtoMessage() {}
+
+func (x *GetNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+// *
+// 多通道通知，彙整各通道訊息的狀態
+type Notification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`                                                                      // 通知 ID
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                                                                                            // 彙整狀態（scheduled/processing/sent/partially_sent/failed/canceled）
+	Category       string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                                                                                                        // 訊息分類
+	ScheduledAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`                                                                               // 預約時間
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                                                     // 建立時間
+	TargetCounts   map[string]int32       `protobuf:"bytes,6,rep,name=target_counts,json=targetCounts,proto3" json:"target_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 所有通道各發送狀態的收件者數量
+	Messages       []*Message             `protobuf:"bytes,7,rep,name=messages,proto3" json:"messages,omitempty"`                                                                                                        // 各通道的訊息
+	FailedChannels []MessageType          `protobuf:"varint,8,rep,packed,name=failed_channels,json=failedChannels,proto3,enum=notify.v1.MessageType" json:"failed_channels,omitempty"`                                   // 建立訊息失敗的通道（彙整狀態時視為未發送）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notify_notify_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Notification) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Notification) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Notification) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetTargetCounts() map[string]int32 {
+	if x != nil {
+		return x.TargetCounts
+	}
+	return nil
+}
+
+func (x *Notification) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Notification) GetFailedChannels() []MessageType {
+	if x != nil {
+		return x.FailedChannels
+	}
+	return nil
+}
+
+// *
+// 分頁請求資訊
+type PageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                         // 當前分頁索引
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                           // 每頁筆數
+	SortField     string                 `protobuf:"bytes,3,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"` // 排序欄位
+	SortOrder     string                 `protobuf:"bytes,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序方向（ASC 或 DESC）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_notify_notify_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PageRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PageRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PageRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *PageRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// *
+// 查詢發送狀態請求（支持分頁）
+type ListStatusWithPagingRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MessageType MessageType            `protobuf:"varint,1,opt,name=message_type,json=messageType,proto3,enum=notify.v1.MessageType" json:"message_type,omitempty"` // 訊息類型（SMS 或 MAIL）
+	// Types that are valid to be assigned to Query:
+	//
+	//	*ListStatusWithPagingRequest_MessageId
+	//	*ListStatusWithPagingRequest_Receiver
+	Query         isListStatusWithPagingRequest_Query `protobuf_oneof:"query"`
+	Page          *PageRequest                        `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`                      // 分頁請求資訊
+	StartAt       *timestamppb.Timestamp              `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // 起始時間（限制90天內）
+	EndAt         *timestamppb.Timestamp              `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // 結束時間（限制90天內）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusWithPagingRequest) Reset() {
+	*x = ListStatusWithPagingRequest{}
+	mi := &file_notify_notify_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusWithPagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusWithPagingRequest) ProtoMessage() {}
+
+func (x *ListStatusWithPagingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusWithPagingRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusWithPagingRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListStatusWithPagingRequest) GetMessageType() MessageType {
+	if x != nil {
+		return x.MessageType
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *ListStatusWithPagingRequest) GetQuery() isListStatusWithPagingRequest_Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ListStatusWithPagingRequest) GetMessageId() string {
+	if x != nil {
+		if x, ok := x.Query.(*ListStatusWithPagingRequest_MessageId); ok {
+			return x.MessageId
+		}
+	}
+	return ""
+}
+
+func (x *ListStatusWithPagingRequest) GetReceiver() string {
+	if x != nil {
+		if x, ok := x.Query.(*ListStatusWithPagingRequest_Receiver); ok {
+			return x.Receiver
+		}
+	}
+	return ""
+}
+
+func (x *ListStatusWithPagingRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListStatusWithPagingRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ListStatusWithPagingRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+type isListStatusWithPagingRequest_Query interface {
+	isListStatusWithPagingRequest_Query()
+}
+
+type ListStatusWithPagingRequest_MessageId struct {
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3,oneof"` // 訊息 ID
+}
+
+type ListStatusWithPagingRequest_Receiver struct {
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3,oneof"` // 收件者手機號碼或電子郵件地址
+}
+
+func (*ListStatusWithPagingRequest_MessageId) isListStatusWithPagingRequest_Query() {}
+
+func (*ListStatusWithPagingRequest_Receiver) isListStatusWithPagingRequest_Query() {}
+
+// *
+// 發送記錄資訊
+type Target struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageType    string                 `protobuf:"bytes,1,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`          // 訊息類型（SMS 或 MAIL）
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                // 訊息 ID
+	MessageContent string                 `protobuf:"bytes,3,opt,name=message_content,json=messageContent,proto3" json:"message_content,omitempty"` // 訊息內容（文字 或 HTML）
+	Receiver       string                 `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`                                   // 收件者（手機號碼或電子郵件地址）
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                       // 寄送狀態
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // 建立時間
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // 更新時間
+	StatusReason   string                 `protobuf:"bytes,8,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`       // 狀態原因（例如超過發送頻率上限）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_notify_notify_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Target) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
+func (x *Target) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Target) GetMessageContent() string {
+	if x != nil {
+		return x.MessageContent
+	}
+	return ""
+}
+
+func (x *Target) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *Target) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Target) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Target) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Target) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+// *
+// 分頁資訊響應結構
+type Paging struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	SortField     string                 `protobuf:"bytes,4,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"` // 排序欄位
+	SortOrder     string                 `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序方向（ASC 或 DESC）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Paging) Reset() {
+	*x = Paging{}
+	mi := &file_notify_notify_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Paging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Paging) ProtoMessage() {}
+
+func (x *Paging) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Paging.ProtoReflect.Descriptor instead.
+func (*Paging) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Paging) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Paging) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Paging) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Paging) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *Paging) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// *
+// 查詢發送狀態響應
+type ListStatusWithPagingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        []*Target              `protobuf:"bytes,1,rep,name=target,proto3" json:"target,omitempty"` // 訊息發送紀錄
+	Paging        *Paging                `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"` // 分頁資訊
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusWithPagingResponse) Reset() {
+	*x = ListStatusWithPagingResponse{}
+	mi := &file_notify_notify_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusWithPagingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusWithPagingResponse) ProtoMessage() {}
+
+func (x *ListStatusWithPagingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusWithPagingResponse.ProtoReflect.Descriptor instead.
+func (*ListStatusWithPagingResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListStatusWithPagingResponse) GetTarget() []*Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ListStatusWithPagingResponse) GetPaging() *Paging {
+	if x != nil {
+		return x.Paging
+	}
+	return nil
+}
+
+// *
+// 申請一次性密碼請求
+type RequestOtpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receiver      string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`                           // 收件者手機號碼或電子郵件地址
+	Channel       MessageType            `protobuf:"varint,2,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"` // 發送通道（SMS 或 MAIL）
+	Purpose       string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`                             // 用途（例如 login、reset_password）
+	Ttl           *durationpb.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`                                     // 有效時間（可選填，預設依服務設定）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestOtpRequest) Reset() {
+	*x = RequestOtpRequest{}
+	mi := &file_notify_notify_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestOtpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestOtpRequest) ProtoMessage() {}
+
+func (x *RequestOtpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestOtpRequest.ProtoReflect.Descriptor instead.
+func (*RequestOtpRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RequestOtpRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *RequestOtpRequest) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *RequestOtpRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *RequestOtpRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// *
+// 申請一次性密碼響應
+type RequestOtpResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OtpId             string                 `protobuf:"bytes,1,opt,name=otp_id,json=otpId,proto3" json:"otp_id,omitempty"`                                       // 一次性密碼 ID
+	MessageId         string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                           // 發送訊息 ID
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                           // 到期時間
+	ResendAvailableAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=resend_available_at,json=resendAvailableAt,proto3" json:"resend_available_at,omitempty"` // 可重新申請時間
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RequestOtpResponse) Reset() {
+	*x = RequestOtpResponse{}
+	mi := &file_notify_notify_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestOtpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestOtpResponse) ProtoMessage() {}
+
+func (x *RequestOtpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestOtpResponse.ProtoReflect.Descriptor instead.
+func (*RequestOtpResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequestOtpResponse) GetOtpId() string {
+	if x != nil {
+		return x.OtpId
+	}
+	return ""
+}
+
+func (x *RequestOtpResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RequestOtpResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RequestOtpResponse) GetResendAvailableAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResendAvailableAt
+	}
+	return nil
+}
+
+// *
+// 驗證一次性密碼請求
+type VerifyOtpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receiver      string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"` // 收件者手機號碼或電子郵件地址
+	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`   // 用途
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`         // 驗證碼
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOtpRequest) Reset() {
+	*x = VerifyOtpRequest{}
+	mi := &file_notify_notify_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOtpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOtpRequest) ProtoMessage() {}
+
+func (x *VerifyOtpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOtpRequest.ProtoReflect.Descriptor instead.
+func (*VerifyOtpRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VerifyOtpRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *VerifyOtpRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *VerifyOtpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// *
+// 驗證一次性密碼響應
+type VerifyOtpResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Verified          bool                   `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`                                            // 是否驗證成功
+	RemainingAttempts int32                  `protobuf:"varint,2,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"` // 剩餘可嘗試次數
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerifyOtpResponse) Reset() {
+	*x = VerifyOtpResponse{}
+	mi := &file_notify_notify_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOtpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOtpResponse) ProtoMessage() {}
+
+func (x *VerifyOtpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOtpResponse.ProtoReflect.Descriptor instead.
+func (*VerifyOtpResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifyOtpResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *VerifyOtpResponse) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+// *
+// 抑制名單資訊
+type Suppression struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 抑制名單 ID
+	Channel       MessageType            `protobuf:"varint,2,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"`     // 發送通道（SMS 或 MAIL）
+	Receiver      string                 `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`                               // 收件者手機號碼或電子郵件地址
+	Reason        SuppressionReason      `protobuf:"varint,4,opt,name=reason,proto3,enum=notify.v1.SuppressionReason" json:"reason,omitempty"` // 抑制原因
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                   // 來源（發送商名稱或 admin）
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`            // 到期時間（未設定表示永久）
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`            // 建立時間
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`            // 更新時間
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suppression) Reset() {
+	*x = Suppression{}
+	mi := &file_notify_notify_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suppression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suppression) ProtoMessage() {}
+
+func (x *Suppression) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suppression.ProtoReflect.Descriptor instead.
+func (*Suppression) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Suppression) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suppression) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *Suppression) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *Suppression) GetReason() SuppressionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SuppressionReason_SUPPRESSION_REASON_UNSPECIFIED
+}
+
+func (x *Suppression) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Suppression) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Suppression) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Suppression) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// *
+// 查詢抑制名單請求（支持分頁）
+type ListSuppressionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       MessageType            `protobuf:"varint,1,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"`     // 發送通道（SMS 或 MAIL）
+	Receiver      string                 `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`                               // 收件者手機號碼或電子郵件地址（可選填）
+	Reason        SuppressionReason      `protobuf:"varint,3,opt,name=reason,proto3,enum=notify.v1.SuppressionReason" json:"reason,omitempty"` // 抑制原因（可選填）
+	Page          *PageRequest           `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`                                       // 分頁請求資訊（sort_field 可為 reason、expires_at、created_at、updated_at）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppressionsRequest) Reset() {
+	*x = ListSuppressionsRequest{}
+	mi := &file_notify_notify_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppressionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppressionsRequest) ProtoMessage() {}
+
+func (x *ListSuppressionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppressionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppressionsRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListSuppressionsRequest) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *ListSuppressionsRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListSuppressionsRequest) GetReason() SuppressionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SuppressionReason_SUPPRESSION_REASON_UNSPECIFIED
+}
+
+func (x *ListSuppressionsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// *
+// 查詢抑制名單響應
+type ListSuppressionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppressions  []*Suppression         `protobuf:"bytes,1,rep,name=suppressions,proto3" json:"suppressions,omitempty"` // 抑制名單
+	Paging        *Paging                `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"`             // 分頁資訊
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppressionsResponse) Reset() {
+	*x = ListSuppressionsResponse{}
+	mi := &file_notify_notify_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppressionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppressionsResponse) ProtoMessage() {}
+
+func (x *ListSuppressionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppressionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppressionsResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListSuppressionsResponse) GetSuppressions() []*Suppression {
+	if x != nil {
+		return x.Suppressions
+	}
+	return nil
+}
+
+func (x *ListSuppressionsResponse) GetPaging() *Paging {
+	if x != nil {
+		return x.Paging
+	}
+	return nil
+}
+
+// *
+// 新增抑制名單請求
+type AddSuppressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       MessageType            `protobuf:"varint,1,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"`     // 發送通道（SMS 或 MAIL）
+	Receiver      string                 `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`                               // 收件者手機號碼或電子郵件地址
+	Reason        SuppressionReason      `protobuf:"varint,3,opt,name=reason,proto3,enum=notify.v1.SuppressionReason" json:"reason,omitempty"` // 抑制原因（可選填，預設 MANUAL）
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`            // 到期時間（可選填，未設定表示永久）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSuppressionRequest) Reset() {
+	*x = AddSuppressionRequest{}
+	mi := &file_notify_notify_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSuppressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSuppressionRequest) ProtoMessage() {}
+
+func (x *AddSuppressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSuppressionRequest.ProtoReflect.Descriptor instead.
+func (*AddSuppressionRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AddSuppressionRequest) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *AddSuppressionRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *AddSuppressionRequest) GetReason() SuppressionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SuppressionReason_SUPPRESSION_REASON_UNSPECIFIED
+}
+
+func (x *AddSuppressionRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// *
+// 移除抑制名單請求
+type RemoveSuppressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       MessageType            `protobuf:"varint,1,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"` // 發送通道（SMS 或 MAIL）
+	Receiver      string                 `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`                           // 收件者手機號碼或電子郵件地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSuppressionRequest) Reset() {
+	*x = RemoveSuppressionRequest{}
+	mi := &file_notify_notify_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSuppressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSuppressionRequest) ProtoMessage() {}
+
+func (x *RemoveSuppressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSuppressionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSuppressionRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveSuppressionRequest) GetChannel() MessageType {
+	if x != nil {
+		return x.Channel
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *RemoveSuppressionRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+// *
+// 查詢上行簡訊請求（支持分頁）
+type ListInboundMessagesWithPagingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sender        string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`                  // 回覆者手機號碼（可選填）
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`                      // 分頁請求資訊（sort_field 可為 received_at、created_at）
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // 起始時間
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // 結束時間
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboundMessagesWithPagingRequest) Reset() {
+	*x = ListInboundMessagesWithPagingRequest{}
+	mi := &file_notify_notify_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboundMessagesWithPagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboundMessagesWithPagingRequest) ProtoMessage() {}
+
+func (x *ListInboundMessagesWithPagingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboundMessagesWithPagingRequest.ProtoReflect.Descriptor instead.
+func (*ListInboundMessagesWithPagingRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListInboundMessagesWithPagingRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ListInboundMessagesWithPagingRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListInboundMessagesWithPagingRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ListInboundMessagesWithPagingRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+// *
+// 上行簡訊資訊
+type InboundMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                           // 上行簡訊 ID
+	Provider           string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`                                               // 發送商
+	Sender             string                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`                                                   // 回覆者手機號碼
+	Recipient          string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`                                             // 接收門號
+	Body               string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`                                                       // 簡訊內容
+	Keyword            string                 `protobuf:"bytes,6,opt,name=keyword,proto3" json:"keyword,omitempty"`                                                 // 符合的關鍵字
+	Language           string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`                                               // 關鍵字語系
+	Action             string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`                                                   // 處理動作（opt_out 或 none）
+	ConfirmationStatus string                 `protobuf:"bytes,9,opt,name=confirmation_status,json=confirmationStatus,proto3" json:"confirmation_status,omitempty"` // 確認簡訊狀態（sent/failed/skipped）
+	ReceivedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`                        // 接收時間
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                           // 建立時間
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InboundMessage) Reset() {
+	*x = InboundMessage{}
+	mi := &file_notify_notify_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboundMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboundMessage) ProtoMessage() {}
+
+func (x *InboundMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboundMessage.ProtoReflect.Descriptor instead.
+func (*InboundMessage) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InboundMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetConfirmationStatus() string {
+	if x != nil {
+		return x.ConfirmationStatus
+	}
+	return ""
+}
+
+func (x *InboundMessage) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *InboundMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// *
+// 查詢上行簡訊響應
+type ListInboundMessagesWithPagingResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InboundMessages []*InboundMessage      `protobuf:"bytes,1,rep,name=inbound_messages,json=inboundMessages,proto3" json:"inbound_messages,omitempty"` // 上行簡訊
+	Paging          *Paging                `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"`                                          // 分頁資訊
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInboundMessagesWithPagingResponse) Reset() {
+	*x = ListInboundMessagesWithPagingResponse{}
+	mi := &file_notify_notify_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboundMessagesWithPagingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboundMessagesWithPagingResponse) ProtoMessage() {}
+
+func (x *ListInboundMessagesWithPagingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboundMessagesWithPagingResponse.ProtoReflect.Descriptor instead.
+func (*ListInboundMessagesWithPagingResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListInboundMessagesWithPagingResponse) GetInboundMessages() []*InboundMessage {
+	if x != nil {
+		return x.InboundMessages
+	}
+	return nil
+}
+
+func (x *ListInboundMessagesWithPagingResponse) GetPaging() *Paging {
+	if x != nil {
+		return x.Paging
+	}
+	return nil
+}
+
+// *
+// 聯絡人資訊
+type Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // 聯絡人 ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // 名稱
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`                          // 手機號碼
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                          // 電子郵件地址
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 建立時間
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 更新時間
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_notify_notify_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Contact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Contact) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// *
+// 聯絡人資料（建立與匯入共用）
+type ContactInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // 名稱（可選填）
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"` // 手機號碼（與 email 至少擇一）
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // 電子郵件地址（與 phone 至少擇一）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactInput) Reset() {
+	*x = ContactInput{}
+	mi := &file_notify_notify_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactInput) ProtoMessage() {}
+
+func (x *ContactInput) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactInput.ProtoReflect.Descriptor instead.
+func (*ContactInput) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ContactInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContactInput) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ContactInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// *
+// 更新聯絡人請求
+type UpdateContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`           // 聯絡人 ID
+	Contact       *ContactInput          `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"` // 聯絡人資料
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	mi := &file_notify_notify_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactRequest) ProtoMessage() {}
+
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetContact() *ContactInput {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+// *
+// 刪除聯絡人請求
+type DeleteContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 聯絡人 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactRequest) Reset() {
+	*x = DeleteContactRequest{}
+	mi := &file_notify_notify_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactRequest) ProtoMessage() {}
+
+func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// *
+// 查詢聯絡人請求（支持分頁）
+type ListContactsWithPagingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群組 ID（可選填，僅列出該群組成員）
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`                    // 手機號碼（可選填）
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                    // 電子郵件地址（可選填）
+	Page          *PageRequest           `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`                      // 分頁請求資訊（sort_field 可為 name、created_at、updated_at）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsWithPagingRequest) Reset() {
+	*x = ListContactsWithPagingRequest{}
+	mi := &file_notify_notify_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsWithPagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsWithPagingRequest) ProtoMessage() {}
+
+func (x *ListContactsWithPagingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsWithPagingRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsWithPagingRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListContactsWithPagingRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListContactsWithPagingRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ListContactsWithPagingRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListContactsWithPagingRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// *
+// 查詢聯絡人響應
+type ListContactsWithPagingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"` // 聯絡人列表
+	Paging        *Paging                `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"`     // 分頁資訊
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsWithPagingResponse) Reset() {
+	*x = ListContactsWithPagingResponse{}
+	mi := &file_notify_notify_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsWithPagingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsWithPagingResponse) ProtoMessage() {}
+
+func (x *ListContactsWithPagingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsWithPagingResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsWithPagingResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListContactsWithPagingResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *ListContactsWithPagingResponse) GetPaging() *Paging {
+	if x != nil {
+		return x.Paging
+	}
+	return nil
+}
+
+// *
+// 批量匯入聯絡人請求，手機號碼或電子郵件地址已存在時更新原聯絡人
+type ImportContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*ContactInput        `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`              // 聯絡人列表
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群組 ID（可選填，匯入後加入該群組）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportContactsRequest) Reset() {
+	*x = ImportContactsRequest{}
+	mi := &file_notify_notify_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContactsRequest) ProtoMessage() {}
+
+func (x *ImportContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContactsRequest.ProtoReflect.Descriptor instead.
+func (*ImportContactsRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportContactsRequest) GetContacts() []*ContactInput {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *ImportContactsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+// *
+// 批量匯入聯絡人響應
+type ImportContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"` // 新建立的聯絡人數量
+	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"` // 更新的聯絡人數量
+	ContactIds    []string               `protobuf:"bytes,3,rep,name=contact_ids,json=contactIds,proto3" json:"contact_ids,omitempty"`        // 聯絡人 ID 列表（與請求順序相同）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportContactsResponse) Reset() {
+	*x = ImportContactsResponse{}
+	mi := &file_notify_notify_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContactsResponse) ProtoMessage() {}
+
+func (x *ImportContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContactsResponse.ProtoReflect.Descriptor instead.
+func (*ImportContactsResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportContactsResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportContactsResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportContactsResponse) GetContactIds() []string {
+	if x != nil {
+		return x.ContactIds
+	}
+	return nil
+}
+
+// *
+// 聯絡人群組資訊
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                       // 群組 ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                   // 名稱
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                     // 描述
+	MemberCount   int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // 成員數量
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // 建立時間
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`        // 更新時間
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_notify_notify_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// *
+// 建立群組請求
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // 名稱
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 描述（可選填）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_notify_notify_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// *
+// 更新群組請求
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // 群組 ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 名稱
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 描述（可選填）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_notify_notify_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// *
+// 刪除群組請求，僅刪除群組與成員關係，不刪除聯絡人
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 群組 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_notify_notify_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// *
+// 查詢群組請求（支持分頁）
+type ListGroupsWithPagingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 名稱（可選填，模糊搜尋）
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"` // 分頁請求資訊（sort_field 可為 name、member_count、created_at、updated_at）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsWithPagingRequest) Reset() {
+	*x = ListGroupsWithPagingRequest{}
+	mi := &file_notify_notify_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsWithPagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsWithPagingRequest) ProtoMessage() {}
+
+func (x *ListGroupsWithPagingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsWithPagingRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsWithPagingRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListGroupsWithPagingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListGroupsWithPagingRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// *
+// 查詢群組響應
+type ListGroupsWithPagingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // 群組列表
+	Paging        *Paging                `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"` // 分頁資訊
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsWithPagingResponse) Reset() {
+	*x = ListGroupsWithPagingResponse{}
+	mi := &file_notify_notify_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsWithPagingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsWithPagingResponse) ProtoMessage() {}
+
+func (x *ListGroupsWithPagingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsWithPagingResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsWithPagingResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListGroupsWithPagingResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListGroupsWithPagingResponse) GetPaging() *Paging {
+	if x != nil {
+		return x.Paging
+	}
+	return nil
+}
+
+// *
+// 新增或移除群組成員請求
+type GroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`          // 群組 ID
+	ContactIds    []string               `protobuf:"bytes,2,rep,name=contact_ids,json=contactIds,proto3" json:"contact_ids,omitempty"` // 聯絡人 ID 列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMembersRequest) Reset() {
+	*x = GroupMembersRequest{}
+	mi := &file_notify_notify_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembersRequest) ProtoMessage() {}
+
+func (x *GroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*GroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMembersRequest) GetContactIds() []string {
+	if x != nil {
+		return x.ContactIds
+	}
+	return nil
+}
+
+// *
+// 批量發送設定，須為上傳的第一個訊框
+type BulkSendHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`    // 訊息範本 ID
+	Priority      Priority               `protobuf:"varint,2,opt,name=priority,proto3,enum=notify.v1.Priority" json:"priority,omitempty"` // 發送優先級（可選填，預設 NORMAL）
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 預約時間（限制30天內，可選填）
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`    // 有效期限，逾期未發送則不再發送（可選填）
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                          // 收件者時區（IANA 格式，可選填，用於勿擾時段計算）
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                          // 訊息分類（可選填，用於發送頻率上限計算）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkSendHeader) Reset() {
+	*x = BulkSendHeader{}
+	mi := &file_notify_notify_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkSendHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSendHeader) ProtoMessage() {}
+
+func (x *BulkSendHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkSendHeader.ProtoReflect.Descriptor instead.
+func (*BulkSendHeader) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *BulkSendHeader) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *BulkSendHeader) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *BulkSendHeader) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *BulkSendHeader) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *BulkSendHeader) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BulkSendHeader) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// *
+// 批量發送上傳請求，第一個訊框為設定，其後為 CSV 內容片段。
+// CSV 第一列為欄位名稱，須包含 receiver 欄位，其餘欄位作為範本變數
+type UploadBulkSendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadBulkSendRequest_Header
+	//	*UploadBulkSendRequest_Chunk
+	Payload       isUploadBulkSendRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadBulkSendRequest) Reset() {
+	*x = UploadBulkSendRequest{}
+	mi := &file_notify_notify_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadBulkSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBulkSendRequest) ProtoMessage() {}
+
+func (x *UploadBulkSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBulkSendRequest.ProtoReflect.Descriptor instead.
+func (*UploadBulkSendRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UploadBulkSendRequest) GetPayload() isUploadBulkSendRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadBulkSendRequest) GetHeader() *BulkSendHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadBulkSendRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadBulkSendRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadBulkSendRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadBulkSendRequest_Payload interface {
+	isUploadBulkSendRequest_Payload()
+}
+
+type UploadBulkSendRequest_Header struct {
+	Header *BulkSendHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"` // 批量發送設定
+}
+
+type UploadBulkSendRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // CSV 內容片段
+}
+
+func (*UploadBulkSendRequest_Header) isUploadBulkSendRequest_Payload() {}
+
+func (*UploadBulkSendRequest_Chunk) isUploadBulkSendRequest_Payload() {}
+
 // *
-// 查詢發送狀態請求（支持分頁）
-type ListStatusWithPagingRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	MessageType MessageType            `protobuf:"varint,1,opt,name=message_type,json=messageType,proto3,enum=notify.v1.MessageType" json:"message_type,omitempty"` // 訊息類型（SMS 或 MAIL）
-	// Types that are valid to be assigned to Query:
-	//
-	//	*ListStatusWithPagingRequest_MessageId
-	//	*ListStatusWithPagingRequest_Receiver
-	Query         isListStatusWithPagingRequest_Query `protobuf_oneof:"query"`
-	Page          *PageRequest                        `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`                      // 分頁請求資訊
-	StartAt       *timestamppb.Timestamp              `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // 起始時間（限制90天內）
-	EndAt         *timestamppb.Timestamp              `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // 結束時間（限制90天內）
+// 批量發送上傳響應
+type UploadBulkSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                       // 批量發送工作 ID
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`           // 訊息 ID
+	TotalRows     int32                  `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`          // 資料列總數
+	AcceptedRows  int32                  `protobuf:"varint,4,opt,name=accepted_rows,json=acceptedRows,proto3" json:"accepted_rows,omitempty"` // 成功建立發送紀錄的列數
+	FailedRows    int32                  `protobuf:"varint,5,opt,name=failed_rows,json=failedRows,proto3" json:"failed_rows,omitempty"`       // 驗證失敗的列數，明細可由 DownloadBulkSendReport 下載
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStatusWithPagingRequest) Reset() {
-	*x = ListStatusWithPagingRequest{}
-	mi := &file_notify_notify_proto_msgTypes[8]
+func (x *UploadBulkSendResponse) Reset() {
+	*x = UploadBulkSendResponse{}
+	mi := &file_notify_notify_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStatusWithPagingRequest) String() string {
+func (x *UploadBulkSendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStatusWithPagingRequest) ProtoMessage() {}
+func (*UploadBulkSendResponse) ProtoMessage() {}
 
-func (x *ListStatusWithPagingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notify_notify_proto_msgTypes[8]
+func (x *UploadBulkSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,110 +5020,80 @@ func (x *ListStatusWithPagingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStatusWithPagingRequest.ProtoReflect.Descriptor instead.
-func (*ListStatusWithPagingRequest) Descriptor() ([]byte, []int) {
-	return file_notify_notify_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListStatusWithPagingRequest) GetMessageType() MessageType {
-	if x != nil {
-		return x.MessageType
-	}
-	return MessageType_MESSAGE_TYPE_UNSPECIFIED
-}
-
-func (x *ListStatusWithPagingRequest) GetQuery() isListStatusWithPagingRequest_Query {
-	if x != nil {
-		return x.Query
-	}
-	return nil
+// Deprecated: Use UploadBulkSendResponse.ProtoReflect.Descriptor instead.
+func (*UploadBulkSendResponse) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListStatusWithPagingRequest) GetMessageId() string {
+func (x *UploadBulkSendResponse) GetJobId() string {
 	if x != nil {
-		if x, ok := x.Query.(*ListStatusWithPagingRequest_MessageId); ok {
-			return x.MessageId
-		}
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *ListStatusWithPagingRequest) GetReceiver() string {
+func (x *UploadBulkSendResponse) GetMessageId() string {
 	if x != nil {
-		if x, ok := x.Query.(*ListStatusWithPagingRequest_Receiver); ok {
-			return x.Receiver
-		}
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *ListStatusWithPagingRequest) GetPage() *PageRequest {
+func (x *UploadBulkSendResponse) GetTotalRows() int32 {
 	if x != nil {
-		return x.Page
+		return x.TotalRows
 	}
-	return nil
+	return 0
 }
 
-func (x *ListStatusWithPagingRequest) GetStartAt() *timestamppb.Timestamp {
+func (x *UploadBulkSendResponse) GetAcceptedRows() int32 {
 	if x != nil {
-		return x.StartAt
+		return x.AcceptedRows
 	}
-	return nil
+	return 0
 }
 
-func (x *ListStatusWithPagingRequest) GetEndAt() *timestamppb.Timestamp {
+func (x *UploadBulkSendResponse) GetFailedRows() int32 {
 	if x != nil {
-		return x.EndAt
+		return x.FailedRows
 	}
-	return nil
-}
-
-type isListStatusWithPagingRequest_Query interface {
-	isListStatusWithPagingRequest_Query()
-}
-
-type ListStatusWithPagingRequest_MessageId struct {
-	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3,oneof"` // 訊息 ID
-}
-
-type ListStatusWithPagingRequest_Receiver struct {
-	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3,oneof"` // 收件者手機號碼或電子郵件地址
+	return 0
 }
 
-func (*ListStatusWithPagingRequest_MessageId) isListStatusWithPagingRequest_Query() {}
-
-func (*ListStatusWithPagingRequest_Receiver) isListStatusWithPagingRequest_Query() {}
-
 // *
-// 發送記錄資訊
-type Target struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	MessageType    string                 `protobuf:"bytes,1,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`          // 訊息類型（SMS 或 MAIL）
-	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                // 訊息 ID
-	MessageContent string                 `protobuf:"bytes,3,opt,name=message_content,json=messageContent,proto3" json:"message_content,omitempty"` // 訊息內容（文字 或 HTML）
-	Receiver       string                 `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`                                   // 收件者（手機號碼或電子郵件地址）
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                       // 寄送狀態
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // 建立時間
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // 更新時間
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 批量發送工作資訊
+type BulkSendJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 批量發送工作 ID
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`        // 訊息範本 ID
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`           // 訊息 ID
+	Channel       MessageType            `protobuf:"varint,4,opt,name=channel,proto3,enum=notify.v1.MessageType" json:"channel,omitempty"`    // 發送通道（SMS 或 MAIL）
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                  // 狀態（processing/completed/failed）
+	TotalRows     int32                  `protobuf:"varint,6,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`          // 資料列總數
+	AcceptedRows  int32                  `protobuf:"varint,7,opt,name=accepted_rows,json=acceptedRows,proto3" json:"accepted_rows,omitempty"` // 成功建立發送紀錄的列數
+	FailedRows    int32                  `protobuf:"varint,8,opt,name=failed_rows,json=failedRows,proto3" json:"failed_rows,omitempty"`       // 驗證失敗的列數
+	ErrorMessage  string                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`  // 工作失敗原因
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // 建立時間
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`    // 完成時間
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Target) Reset() {
-	*x = Target{}
-	mi := &file_notify_notify_proto_msgTypes[9]
+func (x *BulkSendJob) Reset() {
+	*x = BulkSendJob{}
+	mi := &file_notify_notify_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Target) String() string {
+func (x *BulkSendJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Target) ProtoMessage() {}
+func (*BulkSendJob) ProtoMessage() {}
 
-func (x *Target) ProtoReflect() protoreflect.Message {
-	mi := &file_notify_notify_proto_msgTypes[9]
+func (x *BulkSendJob) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,88 +5104,112 @@ func (x *Target) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Target.ProtoReflect.Descriptor instead.
-func (*Target) Descriptor() ([]byte, []int) {
-	return file_notify_notify_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BulkSendJob.ProtoReflect.Descriptor instead.
+func (*BulkSendJob) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Target) GetMessageType() string {
+func (x *BulkSendJob) GetId() string {
 	if x != nil {
-		return x.MessageType
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Target) GetMessageId() string {
+func (x *BulkSendJob) GetTemplateId() string {
 	if x != nil {
-		return x.MessageId
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *Target) GetMessageContent() string {
+func (x *BulkSendJob) GetMessageId() string {
 	if x != nil {
-		return x.MessageContent
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *Target) GetReceiver() string {
+func (x *BulkSendJob) GetChannel() MessageType {
 	if x != nil {
-		return x.Receiver
+		return x.Channel
 	}
-	return ""
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
 }
 
-func (x *Target) GetStatus() string {
+func (x *BulkSendJob) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *Target) GetCreatedAt() *timestamppb.Timestamp {
+func (x *BulkSendJob) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *BulkSendJob) GetAcceptedRows() int32 {
+	if x != nil {
+		return x.AcceptedRows
+	}
+	return 0
+}
+
+func (x *BulkSendJob) GetFailedRows() int32 {
+	if x != nil {
+		return x.FailedRows
+	}
+	return 0
+}
+
+func (x *BulkSendJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *BulkSendJob) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Target) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *BulkSendJob) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CompletedAt
 	}
 	return nil
 }
 
 // *
-// 分頁資訊響應結構
-type Paging struct {
+// 查詢批量發送工作請求
+type GetBulkSendJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	SortField     string                 `protobuf:"bytes,4,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"` // 排序欄位
-	SortOrder     string                 `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序方向（ASC 或 DESC）
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // 批量發送工作 ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Paging) Reset() {
-	*x = Paging{}
-	mi := &file_notify_notify_proto_msgTypes[10]
+func (x *GetBulkSendJobRequest) Reset() {
+	*x = GetBulkSendJobRequest{}
+	mi := &file_notify_notify_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Paging) String() string {
+func (x *GetBulkSendJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Paging) ProtoMessage() {}
+func (*GetBulkSendJobRequest) ProtoMessage() {}
 
-func (x *Paging) ProtoReflect() protoreflect.Message {
-	mi := &file_notify_notify_proto_msgTypes[10]
+func (x *GetBulkSendJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,71 +5220,88 @@ func (x *Paging) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Paging.ProtoReflect.Descriptor instead.
-func (*Paging) Descriptor() ([]byte, []int) {
-	return file_notify_notify_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetBulkSendJobRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkSendJobRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *Paging) GetIndex() int32 {
+func (x *GetBulkSendJobRequest) GetJobId() string {
 	if x != nil {
-		return x.Index
+		return x.JobId
 	}
-	return 0
+	return ""
 }
 
-func (x *Paging) GetSize() int32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+// *
+// 下載批量發送錯誤報告請求
+type DownloadBulkSendReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // 批量發送工作 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Paging) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+func (x *DownloadBulkSendReportRequest) Reset() {
+	*x = DownloadBulkSendReportRequest{}
+	mi := &file_notify_notify_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Paging) GetSortField() string {
+func (x *DownloadBulkSendReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBulkSendReportRequest) ProtoMessage() {}
+
+func (x *DownloadBulkSendReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[69]
 	if x != nil {
-		return x.SortField
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Paging) GetSortOrder() string {
+// Deprecated: Use DownloadBulkSendReportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadBulkSendReportRequest) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DownloadBulkSendReportRequest) GetJobId() string {
 	if x != nil {
-		return x.SortOrder
+		return x.JobId
 	}
 	return ""
 }
 
 // *
-// 查詢發送狀態響應
-type ListStatusWithPagingResponse struct {
+// 批量發送錯誤報告片段，依序串接即為 CSV 檔案（row,field,value,reason）
+type BulkSendReportChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Target        []*Target              `protobuf:"bytes,1,rep,name=target,proto3" json:"target,omitempty"` // 訊息發送紀錄
-	Paging        *Paging                `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"` // 分頁資訊
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // CSV 內容片段
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStatusWithPagingResponse) Reset() {
-	*x = ListStatusWithPagingResponse{}
-	mi := &file_notify_notify_proto_msgTypes[11]
+func (x *BulkSendReportChunk) Reset() {
+	*x = BulkSendReportChunk{}
+	mi := &file_notify_notify_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStatusWithPagingResponse) String() string {
+func (x *BulkSendReportChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStatusWithPagingResponse) ProtoMessage() {}
+func (*BulkSendReportChunk) ProtoMessage() {}
 
-func (x *ListStatusWithPagingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notify_notify_proto_msgTypes[11]
+func (x *BulkSendReportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_notify_notify_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,21 +5312,14 @@ func (x *ListStatusWithPagingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStatusWithPagingResponse.ProtoReflect.Descriptor instead.
-func (*ListStatusWithPagingResponse) Descriptor() ([]byte, []int) {
-	return file_notify_notify_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ListStatusWithPagingResponse) GetTarget() []*Target {
-	if x != nil {
-		return x.Target
-	}
-	return nil
+// Deprecated: Use BulkSendReportChunk.ProtoReflect.Descriptor instead.
+func (*BulkSendReportChunk) Descriptor() ([]byte, []int) {
+	return file_notify_notify_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListStatusWithPagingResponse) GetPaging() *Paging {
+func (x *BulkSendReportChunk) GetData() []byte {
 	if x != nil {
-		return x.Paging
+		return x.Data
 	}
 	return nil
 }
@@ -869,32 +5328,233 @@ var File_notify_notify_proto protoreflect.FileDescriptor
 
 const file_notify_notify_proto_rawDesc = "" +
 	"\n" +
-	"\x13notify/notify.proto\x12\tnotify.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x19\n" +
+	"\x13notify/notify.proto\x12\tnotify.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1egoogle/protobuf/duration.proto\"\x19\n" +
 	"\x03Sms\x12\x12\n" +
-	"\x04body\x18\x01 \x01(\tR\x04body\"\x8f\x01\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"\xa8\x03\n" +
 	"\x0eSendSmsRequest\x12 \n" +
 	"\x03sms\x18\x01 \x01(\v2\x0e.notify.v1.SmsR\x03sms\x12\x1c\n" +
 	"\treceivers\x18\x02 \x03(\tR\treceivers\x12=\n" +
-	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"0\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12/\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x1b\n" +
+	"\tgroup_ids\x18\b \x03(\tR\bgroupIds\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\t \x01(\tR\tclientRef\x125\n" +
+	"\bfallback\x18\n" +
+	" \x01(\v2\x19.notify.v1.FallbackPolicyR\bfallback\"{\n" +
 	"\x0fSendSmsResponse\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId\"\xb8\x01\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x02 \x01(\tR\tclientRef\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x14.notify.v1.ItemErrorR\x05error\"\x83\x01\n" +
+	"\tItemError\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12D\n" +
+	"\x10field_violations\x18\x03 \x03(\v2\x19.notify.v1.FieldViolationR\x0ffieldViolations\"`\n" +
+	"\x0eFieldViolation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"q\n" +
+	"\x12EstimateSmsRequest\x12 \n" +
+	"\x03sms\x18\x01 \x01(\v2\x0e.notify.v1.SmsR\x03sms\x12\x1c\n" +
+	"\treceivers\x18\x02 \x03(\tR\treceivers\x12\x1b\n" +
+	"\tgroup_ids\x18\x03 \x03(\tR\bgroupIds\"y\n" +
+	"\x13SmsReceiverEstimate\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
+	"\bsegments\x18\x03 \x01(\x05R\bsegments\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\"\xa9\x02\n" +
+	"\x13EstimateSmsResponse\x122\n" +
+	"\bencoding\x18\x01 \x01(\x0e2\x16.notify.v1.SmsEncodingR\bencoding\x12\x1e\n" +
+	"\n" +
+	"characters\x18\x02 \x01(\x05R\n" +
+	"characters\x12\x1a\n" +
+	"\bsegments\x18\x03 \x01(\x05R\bsegments\x12!\n" +
+	"\fmax_segments\x18\x04 \x01(\x05R\vmaxSegments\x12%\n" +
+	"\x0eestimated_cost\x18\x05 \x01(\x01R\restimatedCost\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12<\n" +
+	"\treceivers\x18\a \x03(\v2\x1e.notify.v1.SmsReceiverEstimateR\treceivers\"\xb8\x01\n" +
 	"\x04Mail\x12C\n" +
 	"\x0esender_address\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\rsenderAddress\x12=\n" +
 	"\vsender_name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
 	"senderName\x12\x18\n" +
 	"\asubject\x18\x03 \x01(\tR\asubject\x12\x12\n" +
-	"\x04body\x18\x04 \x01(\tR\x04body\"\x93\x01\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"\xac\x03\n" +
 	"\x0fSendMailRequest\x12#\n" +
 	"\x04mail\x18\x01 \x01(\v2\x0f.notify.v1.MailR\x04mail\x12\x1c\n" +
 	"\treceivers\x18\x02 \x03(\tR\treceivers\x12=\n" +
-	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"1\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12/\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x1b\n" +
+	"\tgroup_ids\x18\b \x03(\tR\bgroupIds\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\t \x01(\tR\tclientRef\x125\n" +
+	"\bfallback\x18\n" +
+	" \x01(\v2\x19.notify.v1.FallbackPolicyR\bfallback\"|\n" +
 	"\x10SendMailResponse\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId\"B\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x02 \x01(\tR\tclientRef\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x14.notify.v1.ItemErrorR\x05error\"\x98\x01\n" +
+	"\x04Push\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.notify.v1.Push.DataEntryR\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x03\n" +
+	"\x0fSendPushRequest\x12#\n" +
+	"\x04push\x18\x01 \x01(\v2\x0f.notify.v1.PushR\x04push\x12#\n" +
+	"\rdevice_tokens\x18\x02 \x03(\tR\fdeviceTokens\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12/\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\b \x01(\tR\tclientRef\x125\n" +
+	"\bfallback\x18\t \x01(\v2\x19.notify.v1.FallbackPolicyR\bfallback\"|\n" +
+	"\x10SendPushResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x02 \x01(\tR\tclientRef\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x14.notify.v1.ItemErrorR\x05error\"Z\n" +
+	"\x04Line\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x19\n" +
+	"\balt_text\x18\x02 \x01(\tR\aaltText\x12#\n" +
+	"\rflex_contents\x18\x03 \x01(\tR\fflexContents\"\x8c\x03\n" +
+	"\x0fSendLineRequest\x12#\n" +
+	"\x04line\x18\x01 \x01(\v2\x0f.notify.v1.LineR\x04line\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12/\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\b \x01(\tR\tclientRef\x125\n" +
+	"\bfallback\x18\t \x01(\v2\x19.notify.v1.FallbackPolicyR\bfallback\"P\n" +
+	"\x10SendLineResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x02 \x01(\tR\tclientRef\"\xbe\x01\n" +
+	"\aWebhook\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x129\n" +
+	"\aheaders\x18\x03 \x03(\v2\x1f.notify.v1.Webhook.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaa\x02\n" +
+	"\x12SendWebhookRequest\x12,\n" +
+	"\awebhook\x18\x01 \x01(\v2\x12.notify.v1.WebhookR\awebhook\x12=\n" +
+	"\fscheduled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12/\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12;\n" +
+	"\vvalid_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x06 \x01(\tR\tclientRef\"S\n" +
+	"\x13SendWebhookResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x02 \x01(\tR\tclientRef\"\xa6\x02\n" +
+	"\fFallbackStep\x120\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x125\n" +
+	"\bdeadline\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bdeadline\x12\x1c\n" +
+	"\treceivers\x18\x03 \x03(\tR\treceivers\x12 \n" +
+	"\x03sms\x18\x04 \x01(\v2\x0e.notify.v1.SmsR\x03sms\x12#\n" +
+	"\x04mail\x18\x05 \x01(\v2\x0f.notify.v1.MailR\x04mail\x12#\n" +
+	"\x04push\x18\x06 \x01(\v2\x0f.notify.v1.PushR\x04push\x12#\n" +
+	"\x04line\x18\a \x01(\v2\x0f.notify.v1.LineR\x04line\"?\n" +
+	"\x0eFallbackPolicy\x12-\n" +
+	"\x05steps\x18\x01 \x03(\v2\x17.notify.v1.FallbackStepR\x05steps\"B\n" +
 	"!CancelScheduledByMessageIdRequest\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId\"u\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"2\n" +
+	"\x11GetMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"\xdc\x01\n" +
+	"\x0fMessageFallback\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x120\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12;\n" +
+	"\vdeadline_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deadlineAt\x12.\n" +
+	"\x13fallback_message_id\x18\x05 \x01(\tR\x11fallbackMessageId\"\x8e\x04\n" +
+	"\aMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x129\n" +
+	"\fmessage_type\x18\x02 \x01(\x0e2\x16.notify.v1.MessageTypeR\vmessageType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12=\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12I\n" +
+	"\rtarget_counts\x18\a \x03(\v2$.notify.v1.Message.TargetCountsEntryR\ftargetCounts\x127\n" +
+	"\x18fallback_from_message_id\x18\b \x01(\tR\x15fallbackFromMessageId\x126\n" +
+	"\bfallback\x18\t \x01(\v2\x1a.notify.v1.MessageFallbackR\bfallback\x1a?\n" +
+	"\x11TargetCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc3\x04\n" +
+	"\x17SendNotificationRequest\x12 \n" +
+	"\x03sms\x18\x01 \x01(\v2\x0e.notify.v1.SmsR\x03sms\x12#\n" +
+	"\rsms_receivers\x18\x02 \x03(\tR\fsmsReceivers\x12#\n" +
+	"\x04mail\x18\x03 \x01(\v2\x0f.notify.v1.MailR\x04mail\x12%\n" +
+	"\x0email_receivers\x18\x04 \x03(\tR\rmailReceivers\x12#\n" +
+	"\x04push\x18\x05 \x01(\v2\x0f.notify.v1.PushR\x04push\x12#\n" +
+	"\rdevice_tokens\x18\x06 \x03(\tR\fdeviceTokens\x12#\n" +
+	"\x04line\x18\a \x01(\v2\x0f.notify.v1.LineR\x04line\x12\"\n" +
+	"\rline_user_ids\x18\b \x03(\tR\vlineUserIds\x12=\n" +
+	"\fscheduled_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12/\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12;\n" +
+	"\vvalid_until\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\btimezone\x18\f \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcategory\x18\r \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x0e \x01(\tR\tclientRef\"\xa2\x01\n" +
+	"\x18SendNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x1d\n" +
+	"\n" +
+	"client_ref\x18\x02 \x01(\tR\tclientRef\x12>\n" +
+	"\aresults\x18\x03 \x03(\v2$.notify.v1.NotificationChannelResultR\aresults\"\x98\x01\n" +
+	"\x19NotificationChannelResult\x120\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x14.notify.v1.ItemErrorR\x05error\"D\n" +
+	"\x19CancelNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"A\n" +
+	"\x16GetNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"\xe7\x03\n" +
+	"\fNotification\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12=\n" +
+	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12N\n" +
+	"\rtarget_counts\x18\x06 \x03(\v2).notify.v1.Notification.TargetCountsEntryR\ftargetCounts\x12.\n" +
+	"\bmessages\x18\a \x03(\v2\x12.notify.v1.MessageR\bmessages\x12?\n" +
+	"\x0ffailed_channels\x18\b \x03(\x0e2\x16.notify.v1.MessageTypeR\x0efailedChannels\x1a?\n" +
+	"\x11TargetCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"u\n" +
 	"\vPageRequest\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1d\n" +
@@ -910,7 +5570,7 @@ const file_notify_notify_proto_rawDesc = "" +
 	"\x04page\x18\x04 \x01(\v2\x16.notify.v1.PageRequestR\x04page\x125\n" +
 	"\bstart_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
 	"\x06end_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAtB\a\n" +
-	"\x05query\"\x9d\x02\n" +
+	"\x05query\"\xc2\x02\n" +
 	"\x06Target\x12!\n" +
 	"\fmessage_type\x18\x01 \x01(\tR\vmessageType\x12\x1d\n" +
 	"\n" +
@@ -921,7 +5581,8 @@ const file_notify_notify_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x86\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rstatus_reason\x18\b \x01(\tR\fstatusReason\"\x86\x01\n" +
 	"\x06Paging\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x14\n" +
@@ -932,18 +5593,251 @@ const file_notify_notify_proto_rawDesc = "" +
 	"sort_order\x18\x05 \x01(\tR\tsortOrder\"t\n" +
 	"\x1cListStatusWithPagingResponse\x12)\n" +
 	"\x06target\x18\x01 \x03(\v2\x11.notify.v1.TargetR\x06target\x12)\n" +
-	"\x06paging\x18\x02 \x01(\v2\x11.notify.v1.PagingR\x06paging*>\n" +
+	"\x06paging\x18\x02 \x01(\v2\x11.notify.v1.PagingR\x06paging\"\xa8\x01\n" +
+	"\x11RequestOtpRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x120\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"\xd1\x01\n" +
+	"\x12RequestOtpResponse\x12\x15\n" +
+	"\x06otp_id\x18\x01 \x01(\tR\x05otpId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12J\n" +
+	"\x13resend_available_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11resendAvailableAt\"\\\n" +
+	"\x10VerifyOtpRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"^\n" +
+	"\x11VerifyOtpResponse\x12\x1a\n" +
+	"\bverified\x18\x01 \x01(\bR\bverified\x12-\n" +
+	"\x12remaining_attempts\x18\x02 \x01(\x05R\x11remainingAttempts\"\xea\x02\n" +
+	"\vSuppression\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x1a\n" +
+	"\breceiver\x18\x03 \x01(\tR\breceiver\x124\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x1c.notify.v1.SuppressionReasonR\x06reason\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc9\x01\n" +
+	"\x17ListSuppressionsRequest\x120\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x1a\n" +
+	"\breceiver\x18\x02 \x01(\tR\breceiver\x124\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1c.notify.v1.SuppressionReasonR\x06reason\x12*\n" +
+	"\x04page\x18\x04 \x01(\v2\x16.notify.v1.PageRequestR\x04page\"\x81\x01\n" +
+	"\x18ListSuppressionsResponse\x12:\n" +
+	"\fsuppressions\x18\x01 \x03(\v2\x16.notify.v1.SuppressionR\fsuppressions\x12)\n" +
+	"\x06paging\x18\x02 \x01(\v2\x11.notify.v1.PagingR\x06paging\"\xd6\x01\n" +
+	"\x15AddSuppressionRequest\x120\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x1a\n" +
+	"\breceiver\x18\x02 \x01(\tR\breceiver\x124\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1c.notify.v1.SuppressionReasonR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"h\n" +
+	"\x18RemoveSuppressionRequest\x120\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x1a\n" +
+	"\breceiver\x18\x02 \x01(\tR\breceiver\"\xd4\x01\n" +
+	"$ListInboundMessagesWithPagingRequest\x12\x16\n" +
+	"\x06sender\x18\x01 \x01(\tR\x06sender\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.notify.v1.PageRequestR\x04page\x125\n" +
+	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\"\xfd\x02\n" +
+	"\x0eInboundMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06sender\x18\x03 \x01(\tR\x06sender\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x18\n" +
+	"\akeyword\x18\x06 \x01(\tR\akeyword\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12\x16\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12/\n" +
+	"\x13confirmation_status\x18\t \x01(\tR\x12confirmationStatus\x12;\n" +
+	"\vreceived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x98\x01\n" +
+	"%ListInboundMessagesWithPagingResponse\x12D\n" +
+	"\x10inbound_messages\x18\x01 \x03(\v2\x19.notify.v1.InboundMessageR\x0finboundMessages\x12)\n" +
+	"\x06paging\x18\x02 \x01(\v2\x11.notify.v1.PagingR\x06paging\"\xcf\x01\n" +
+	"\aContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"N\n" +
+	"\fContactInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"Y\n" +
+	"\x14UpdateContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\acontact\x18\x02 \x01(\v2\x17.notify.v1.ContactInputR\acontact\"&\n" +
+	"\x14DeleteContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x92\x01\n" +
+	"\x1dListContactsWithPagingRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12*\n" +
+	"\x04page\x18\x04 \x01(\v2\x16.notify.v1.PageRequestR\x04page\"{\n" +
+	"\x1eListContactsWithPagingResponse\x12.\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x12.notify.v1.ContactR\bcontacts\x12)\n" +
+	"\x06paging\x18\x02 \x01(\v2\x11.notify.v1.PagingR\x06paging\"g\n" +
+	"\x15ImportContactsRequest\x123\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x17.notify.v1.ContactInputR\bcontacts\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"\x83\x01\n" +
+	"\x16ImportContactsResponse\x12#\n" +
+	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12\x1f\n" +
+	"\vcontact_ids\x18\x03 \x03(\tR\n" +
+	"contactIds\"\xe6\x01\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x12CreateGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"Z\n" +
+	"\x12UpdateGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"$\n" +
+	"\x12DeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
+	"\x1bListGroupsWithPagingRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.notify.v1.PageRequestR\x04page\"s\n" +
+	"\x1cListGroupsWithPagingResponse\x12(\n" +
+	"\x06groups\x18\x01 \x03(\v2\x10.notify.v1.GroupR\x06groups\x12)\n" +
+	"\x06paging\x18\x02 \x01(\v2\x11.notify.v1.PagingR\x06paging\"Q\n" +
+	"\x13GroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\vcontact_ids\x18\x02 \x03(\tR\n" +
+	"contactIds\"\x96\x02\n" +
+	"\x0eBulkSendHeader\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12/\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x13.notify.v1.PriorityR\bpriority\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12;\n" +
+	"\vvalid_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\"o\n" +
+	"\x15UploadBulkSendRequest\x123\n" +
+	"\x06header\x18\x01 \x01(\v2\x19.notify.v1.BulkSendHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xb3\x01\n" +
+	"\x16UploadBulkSendResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x03 \x01(\x05R\ttotalRows\x12#\n" +
+	"\raccepted_rows\x18\x04 \x01(\x05R\facceptedRows\x12\x1f\n" +
+	"\vfailed_rows\x18\x05 \x01(\x05R\n" +
+	"failedRows\"\xab\x03\n" +
+	"\vBulkSendJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x120\n" +
+	"\achannel\x18\x04 \x01(\x0e2\x16.notify.v1.MessageTypeR\achannel\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x06 \x01(\x05R\ttotalRows\x12#\n" +
+	"\raccepted_rows\x18\a \x01(\x05R\facceptedRows\x12\x1f\n" +
+	"\vfailed_rows\x18\b \x01(\x05R\n" +
+	"failedRows\x12#\n" +
+	"\rerror_message\x18\t \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\".\n" +
+	"\x15GetBulkSendJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"6\n" +
+	"\x1dDownloadBulkSendReportRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\")\n" +
+	"\x13BulkSendReportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data*D\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04HIGH\x10\x01\x12\n" +
+	"\n" +
+	"\x06NORMAL\x10\x02\x12\b\n" +
+	"\x04BULK\x10\x03*?\n" +
+	"\vSmsEncoding\x12\x1c\n" +
+	"\x18SMS_ENCODING_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04GSM7\x10\x01\x12\b\n" +
+	"\x04UCS2\x10\x02*_\n" +
 	"\vMessageType\x12\x1c\n" +
 	"\x18MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03SMS\x10\x01\x12\b\n" +
-	"\x04MAIL\x10\x022\xfc\x03\n" +
+	"\x04MAIL\x10\x02\x12\v\n" +
+	"\aWEBHOOK\x10\x03\x12\b\n" +
+	"\x04PUSH\x10\x04\x12\b\n" +
+	"\x04LINE\x10\x05*\x94\x01\n" +
+	"\x11SuppressionReason\x12\"\n" +
+	"\x1eSUPPRESSION_REASON_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06BOUNCE\x10\x01\x12\x0f\n" +
+	"\vSPAM_REPORT\x10\x02\x12\x12\n" +
+	"\x0eINVALID_NUMBER\x10\x03\x12\n" +
+	"\n" +
+	"\x06MANUAL\x10\x04\x12\v\n" +
+	"\aOPT_OUT\x10\x05\x12\x11\n" +
+	"\rINVALID_TOKEN\x10\x062\xaa\x16\n" +
 	"\rNotifyService\x12@\n" +
 	"\aSendSms\x12\x19.notify.v1.SendSmsRequest\x1a\x1a.notify.v1.SendSmsResponse\x12I\n" +
-	"\fSendBatchSms\x12\x19.notify.v1.SendSmsRequest\x1a\x1a.notify.v1.SendSmsResponse(\x010\x01\x12C\n" +
+	"\fSendBatchSms\x12\x19.notify.v1.SendSmsRequest\x1a\x1a.notify.v1.SendSmsResponse(\x010\x01\x12L\n" +
+	"\vEstimateSms\x12\x1d.notify.v1.EstimateSmsRequest\x1a\x1e.notify.v1.EstimateSmsResponse\x12C\n" +
 	"\bSendMail\x12\x1a.notify.v1.SendMailRequest\x1a\x1b.notify.v1.SendMailResponse\x12L\n" +
-	"\rSendBatchMail\x12\x1a.notify.v1.SendMailRequest\x1a\x1b.notify.v1.SendMailResponse(\x010\x01\x12b\n" +
+	"\rSendBatchMail\x12\x1a.notify.v1.SendMailRequest\x1a\x1b.notify.v1.SendMailResponse(\x010\x01\x12C\n" +
+	"\bSendPush\x12\x1a.notify.v1.SendPushRequest\x1a\x1b.notify.v1.SendPushResponse\x12L\n" +
+	"\rSendBatchPush\x12\x1a.notify.v1.SendPushRequest\x1a\x1b.notify.v1.SendPushResponse(\x010\x01\x12C\n" +
+	"\bSendLine\x12\x1a.notify.v1.SendLineRequest\x1a\x1b.notify.v1.SendLineResponse\x12L\n" +
+	"\vSendWebhook\x12\x1d.notify.v1.SendWebhookRequest\x1a\x1e.notify.v1.SendWebhookResponse\x12b\n" +
 	"\x1aCancelScheduledByMessageId\x12,.notify.v1.CancelScheduledByMessageIdRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
-	"\x14ListStatusWithPaging\x12&.notify.v1.ListStatusWithPagingRequest\x1a'.notify.v1.ListStatusWithPagingResponseB\x15Z\x13proto/pkg/notify/v1b\x06proto3"
+	"\x14ListStatusWithPaging\x12&.notify.v1.ListStatusWithPagingRequest\x1a'.notify.v1.ListStatusWithPagingResponse\x12>\n" +
+	"\n" +
+	"GetMessage\x12\x1c.notify.v1.GetMessageRequest\x1a\x12.notify.v1.Message\x12[\n" +
+	"\x10SendNotification\x12\".notify.v1.SendNotificationRequest\x1a#.notify.v1.SendNotificationResponse\x12R\n" +
+	"\x12CancelNotification\x12$.notify.v1.CancelNotificationRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x0fGetNotification\x12!.notify.v1.GetNotificationRequest\x1a\x17.notify.v1.Notification\x12I\n" +
+	"\n" +
+	"RequestOtp\x12\x1c.notify.v1.RequestOtpRequest\x1a\x1d.notify.v1.RequestOtpResponse\x12F\n" +
+	"\tVerifyOtp\x12\x1b.notify.v1.VerifyOtpRequest\x1a\x1c.notify.v1.VerifyOtpResponse\x12[\n" +
+	"\x10ListSuppressions\x12\".notify.v1.ListSuppressionsRequest\x1a#.notify.v1.ListSuppressionsResponse\x12J\n" +
+	"\x0eAddSuppression\x12 .notify.v1.AddSuppressionRequest\x1a\x16.notify.v1.Suppression\x12P\n" +
+	"\x11RemoveSuppression\x12#.notify.v1.RemoveSuppressionRequest\x1a\x16.google.protobuf.Empty\x12\x82\x01\n" +
+	"\x1dListInboundMessagesWithPaging\x12/.notify.v1.ListInboundMessagesWithPagingRequest\x1a0.notify.v1.ListInboundMessagesWithPagingResponse\x12<\n" +
+	"\rCreateContact\x12\x17.notify.v1.ContactInput\x1a\x12.notify.v1.Contact\x12D\n" +
+	"\rUpdateContact\x12\x1f.notify.v1.UpdateContactRequest\x1a\x12.notify.v1.Contact\x12H\n" +
+	"\rDeleteContact\x12\x1f.notify.v1.DeleteContactRequest\x1a\x16.google.protobuf.Empty\x12m\n" +
+	"\x16ListContactsWithPaging\x12(.notify.v1.ListContactsWithPagingRequest\x1a).notify.v1.ListContactsWithPagingResponse\x12U\n" +
+	"\x0eImportContacts\x12 .notify.v1.ImportContactsRequest\x1a!.notify.v1.ImportContactsResponse\x12>\n" +
+	"\vCreateGroup\x12\x1d.notify.v1.CreateGroupRequest\x1a\x10.notify.v1.Group\x12>\n" +
+	"\vUpdateGroup\x12\x1d.notify.v1.UpdateGroupRequest\x1a\x10.notify.v1.Group\x12D\n" +
+	"\vDeleteGroup\x12\x1d.notify.v1.DeleteGroupRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
+	"\x14ListGroupsWithPaging\x12&.notify.v1.ListGroupsWithPagingRequest\x1a'.notify.v1.ListGroupsWithPagingResponse\x12I\n" +
+	"\x0fAddGroupMembers\x12\x1e.notify.v1.GroupMembersRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x12RemoveGroupMembers\x12\x1e.notify.v1.GroupMembersRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x0eUploadBulkSend\x12 .notify.v1.UploadBulkSendRequest\x1a!.notify.v1.UploadBulkSendResponse(\x01\x12J\n" +
+	"\x0eGetBulkSendJob\x12 .notify.v1.GetBulkSendJobRequest\x1a\x16.notify.v1.BulkSendJob\x12d\n" +
+	"\x16DownloadBulkSendReport\x12(.notify.v1.DownloadBulkSendReportRequest\x1a\x1e.notify.v1.BulkSendReportChunk0\x01B\x15Z\x13proto/pkg/notify/v1b\x06proto3"
 
 var (
 	file_notify_notify_proto_rawDescOnce sync.Once
@@ -957,58 +5851,285 @@ func file_notify_notify_proto_rawDescGZIP() []byte {
 	return file_notify_notify_proto_rawDescData
 }
 
-var file_notify_notify_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_notify_notify_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_notify_notify_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_notify_notify_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_notify_notify_proto_goTypes = []any{
-	(MessageType)(0),                          // 0: notify.v1.MessageType
-	(*Sms)(nil),                               // 1: notify.v1.Sms
-	(*SendSmsRequest)(nil),                    // 2: notify.v1.SendSmsRequest
-	(*SendSmsResponse)(nil),                   // 3: notify.v1.SendSmsResponse
-	(*Mail)(nil),                              // 4: notify.v1.Mail
-	(*SendMailRequest)(nil),                   // 5: notify.v1.SendMailRequest
-	(*SendMailResponse)(nil),                  // 6: notify.v1.SendMailResponse
-	(*CancelScheduledByMessageIdRequest)(nil), // 7: notify.v1.CancelScheduledByMessageIdRequest
-	(*PageRequest)(nil),                       // 8: notify.v1.PageRequest
-	(*ListStatusWithPagingRequest)(nil),       // 9: notify.v1.ListStatusWithPagingRequest
-	(*Target)(nil),                            // 10: notify.v1.Target
-	(*Paging)(nil),                            // 11: notify.v1.Paging
-	(*ListStatusWithPagingResponse)(nil),      // 12: notify.v1.ListStatusWithPagingResponse
-	(*timestamppb.Timestamp)(nil),             // 13: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil),            // 14: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),                     // 15: google.protobuf.Empty
+	(Priority)(0),                                 // 0: notify.v1.Priority
+	(SmsEncoding)(0),                              // 1: notify.v1.SmsEncoding
+	(MessageType)(0),                              // 2: notify.v1.MessageType
+	(SuppressionReason)(0),                        // 3: notify.v1.SuppressionReason
+	(*Sms)(nil),                                   // 4: notify.v1.Sms
+	(*SendSmsRequest)(nil),                        // 5: notify.v1.SendSmsRequest
+	(*SendSmsResponse)(nil),                       // 6: notify.v1.SendSmsResponse
+	(*ItemError)(nil),                             // 7: notify.v1.ItemError
+	(*FieldViolation)(nil),                        // 8: notify.v1.FieldViolation
+	(*EstimateSmsRequest)(nil),                    // 9: notify.v1.EstimateSmsRequest
+	(*SmsReceiverEstimate)(nil),                   // 10: notify.v1.SmsReceiverEstimate
+	(*EstimateSmsResponse)(nil),                   // 11: notify.v1.EstimateSmsResponse
+	(*Mail)(nil),                                  // 12: notify.v1.Mail
+	(*SendMailRequest)(nil),                       // 13: notify.v1.SendMailRequest
+	(*SendMailResponse)(nil),                      // 14: notify.v1.SendMailResponse
+	(*Push)(nil),                                  // 15: notify.v1.Push
+	(*SendPushRequest)(nil),                       // 16: notify.v1.SendPushRequest
+	(*SendPushResponse)(nil),                      // 17: notify.v1.SendPushResponse
+	(*Line)(nil),                                  // 18: notify.v1.Line
+	(*SendLineRequest)(nil),                       // 19: notify.v1.SendLineRequest
+	(*SendLineResponse)(nil),                      // 20: notify.v1.SendLineResponse
+	(*Webhook)(nil),                               // 21: notify.v1.Webhook
+	(*SendWebhookRequest)(nil),                    // 22: notify.v1.SendWebhookRequest
+	(*SendWebhookResponse)(nil),                   // 23: notify.v1.SendWebhookResponse
+	(*FallbackStep)(nil),                          // 24: notify.v1.FallbackStep
+	(*FallbackPolicy)(nil),                        // 25: notify.v1.FallbackPolicy
+	(*CancelScheduledByMessageIdRequest)(nil),     // 26: notify.v1.CancelScheduledByMessageIdRequest
+	(*GetMessageRequest)(nil),                     // 27: notify.v1.GetMessageRequest
+	(*MessageFallback)(nil),                       // 28: notify.v1.MessageFallback
+	(*Message)(nil),                               // 29: notify.v1.Message
+	(*SendNotificationRequest)(nil),               // 30: notify.v1.SendNotificationRequest
+	(*SendNotificationResponse)(nil),              // 31: notify.v1.SendNotificationResponse
+	(*NotificationChannelResult)(nil),             // 32: notify.v1.NotificationChannelResult
+	(*CancelNotificationRequest)(nil),             // 33: notify.v1.CancelNotificationRequest
+	(*GetNotificationRequest)(nil),                // 34: notify.v1.GetNotificationRequest
+	(*Notification)(nil),                          // 35: notify.v1.Notification
+	(*PageRequest)(nil),                           // 36: notify.v1.PageRequest
+	(*ListStatusWithPagingRequest)(nil),           // 37: notify.v1.ListStatusWithPagingRequest
+	(*Target)(nil),                                // 38: notify.v1.Target
+	(*Paging)(nil),                                // 39: notify.v1.Paging
+	(*ListStatusWithPagingResponse)(nil),          // 40: notify.v1.ListStatusWithPagingResponse
+	(*RequestOtpRequest)(nil),                     // 41: notify.v1.RequestOtpRequest
+	(*RequestOtpResponse)(nil),                    // 42: notify.v1.RequestOtpResponse
+	(*VerifyOtpRequest)(nil),                      // 43: notify.v1.VerifyOtpRequest
+	(*VerifyOtpResponse)(nil),                     // 44: notify.v1.VerifyOtpResponse
+	(*Suppression)(nil),                           // 45: notify.v1.Suppression
+	(*ListSuppressionsRequest)(nil),               // 46: notify.v1.ListSuppressionsRequest
+	(*ListSuppressionsResponse)(nil),              // 47: notify.v1.ListSuppressionsResponse
+	(*AddSuppressionRequest)(nil),                 // 48: notify.v1.AddSuppressionRequest
+	(*RemoveSuppressionRequest)(nil),              // 49: notify.v1.RemoveSuppressionRequest
+	(*ListInboundMessagesWithPagingRequest)(nil),  // 50: notify.v1.ListInboundMessagesWithPagingRequest
+	(*InboundMessage)(nil),                        // 51: notify.v1.InboundMessage
+	(*ListInboundMessagesWithPagingResponse)(nil), // 52: notify.v1.ListInboundMessagesWithPagingResponse
+	(*Contact)(nil),                               // 53: notify.v1.Contact
+	(*ContactInput)(nil),                          // 54: notify.v1.ContactInput
+	(*UpdateContactRequest)(nil),                  // 55: notify.v1.UpdateContactRequest
+	(*DeleteContactRequest)(nil),                  // 56: notify.v1.DeleteContactRequest
+	(*ListContactsWithPagingRequest)(nil),         // 57: notify.v1.ListContactsWithPagingRequest
+	(*ListContactsWithPagingResponse)(nil),        // 58: notify.v1.ListContactsWithPagingResponse
+	(*ImportContactsRequest)(nil),                 // 59: notify.v1.ImportContactsRequest
+	(*ImportContactsResponse)(nil),                // 60: notify.v1.ImportContactsResponse
+	(*Group)(nil),                                 // 61: notify.v1.Group
+	(*CreateGroupRequest)(nil),                    // 62: notify.v1.CreateGroupRequest
+	(*UpdateGroupRequest)(nil),                    // 63: notify.v1.UpdateGroupRequest
+	(*DeleteGroupRequest)(nil),                    // 64: notify.v1.DeleteGroupRequest
+	(*ListGroupsWithPagingRequest)(nil),           // 65: notify.v1.ListGroupsWithPagingRequest
+	(*ListGroupsWithPagingResponse)(nil),          // 66: notify.v1.ListGroupsWithPagingResponse
+	(*GroupMembersRequest)(nil),                   // 67: notify.v1.GroupMembersRequest
+	(*BulkSendHeader)(nil),                        // 68: notify.v1.BulkSendHeader
+	(*UploadBulkSendRequest)(nil),                 // 69: notify.v1.UploadBulkSendRequest
+	(*UploadBulkSendResponse)(nil),                // 70: notify.v1.UploadBulkSendResponse
+	(*BulkSendJob)(nil),                           // 71: notify.v1.BulkSendJob
+	(*GetBulkSendJobRequest)(nil),                 // 72: notify.v1.GetBulkSendJobRequest
+	(*DownloadBulkSendReportRequest)(nil),         // 73: notify.v1.DownloadBulkSendReportRequest
+	(*BulkSendReportChunk)(nil),                   // 74: notify.v1.BulkSendReportChunk
+	nil,                                           // 75: notify.v1.Push.DataEntry
+	nil,                                           // 76: notify.v1.Webhook.HeadersEntry
+	nil,                                           // 77: notify.v1.Message.TargetCountsEntry
+	nil,                                           // 78: notify.v1.Notification.TargetCountsEntry
+	(*timestamppb.Timestamp)(nil),                 // 79: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),                // 80: google.protobuf.StringValue
+	(*durationpb.Duration)(nil),                   // 81: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                         // 82: google.protobuf.Empty
 }
 var file_notify_notify_proto_depIdxs = []int32{
-	1,  // 0: notify.v1.SendSmsRequest.sms:type_name -> notify.v1.Sms
-	13, // 1: notify.v1.SendSmsRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	14, // 2: notify.v1.Mail.sender_address:type_name -> google.protobuf.StringValue
-	14, // 3: notify.v1.Mail.sender_name:type_name -> google.protobuf.StringValue
-	4,  // 4: notify.v1.SendMailRequest.mail:type_name -> notify.v1.Mail
-	13, // 5: notify.v1.SendMailRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: notify.v1.ListStatusWithPagingRequest.message_type:type_name -> notify.v1.MessageType
-	8,  // 7: notify.v1.ListStatusWithPagingRequest.page:type_name -> notify.v1.PageRequest
-	13, // 8: notify.v1.ListStatusWithPagingRequest.start_at:type_name -> google.protobuf.Timestamp
-	13, // 9: notify.v1.ListStatusWithPagingRequest.end_at:type_name -> google.protobuf.Timestamp
-	13, // 10: notify.v1.Target.created_at:type_name -> google.protobuf.Timestamp
-	13, // 11: notify.v1.Target.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 12: notify.v1.ListStatusWithPagingResponse.target:type_name -> notify.v1.Target
-	11, // 13: notify.v1.ListStatusWithPagingResponse.paging:type_name -> notify.v1.Paging
-	2,  // 14: notify.v1.NotifyService.SendSms:input_type -> notify.v1.SendSmsRequest
-	2,  // 15: notify.v1.NotifyService.SendBatchSms:input_type -> notify.v1.SendSmsRequest
-	5,  // 16: notify.v1.NotifyService.SendMail:input_type -> notify.v1.SendMailRequest
-	5,  // 17: notify.v1.NotifyService.SendBatchMail:input_type -> notify.v1.SendMailRequest
-	7,  // 18: notify.v1.NotifyService.CancelScheduledByMessageId:input_type -> notify.v1.CancelScheduledByMessageIdRequest
-	9,  // 19: notify.v1.NotifyService.ListStatusWithPaging:input_type -> notify.v1.ListStatusWithPagingRequest
-	3,  // 20: notify.v1.NotifyService.SendSms:output_type -> notify.v1.SendSmsResponse
-	3,  // 21: notify.v1.NotifyService.SendBatchSms:output_type -> notify.v1.SendSmsResponse
-	6,  // 22: notify.v1.NotifyService.SendMail:output_type -> notify.v1.SendMailResponse
-	6,  // 23: notify.v1.NotifyService.SendBatchMail:output_type -> notify.v1.SendMailResponse
-	15, // 24: notify.v1.NotifyService.CancelScheduledByMessageId:output_type -> google.protobuf.Empty
-	12, // 25: notify.v1.NotifyService.ListStatusWithPaging:output_type -> notify.v1.ListStatusWithPagingResponse
-	20, // [20:26] is the sub-list for method output_type
-	14, // [14:20] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	4,   // 0: notify.v1.SendSmsRequest.sms:type_name -> notify.v1.Sms
+	79,  // 1: notify.v1.SendSmsRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: notify.v1.SendSmsRequest.priority:type_name -> notify.v1.Priority
+	79,  // 3: notify.v1.SendSmsRequest.valid_until:type_name -> google.protobuf.Timestamp
+	25,  // 4: notify.v1.SendSmsRequest.fallback:type_name -> notify.v1.FallbackPolicy
+	7,   // 5: notify.v1.SendSmsResponse.error:type_name -> notify.v1.ItemError
+	8,   // 6: notify.v1.ItemError.field_violations:type_name -> notify.v1.FieldViolation
+	4,   // 7: notify.v1.EstimateSmsRequest.sms:type_name -> notify.v1.Sms
+	1,   // 8: notify.v1.EstimateSmsResponse.encoding:type_name -> notify.v1.SmsEncoding
+	10,  // 9: notify.v1.EstimateSmsResponse.receivers:type_name -> notify.v1.SmsReceiverEstimate
+	80,  // 10: notify.v1.Mail.sender_address:type_name -> google.protobuf.StringValue
+	80,  // 11: notify.v1.Mail.sender_name:type_name -> google.protobuf.StringValue
+	12,  // 12: notify.v1.SendMailRequest.mail:type_name -> notify.v1.Mail
+	79,  // 13: notify.v1.SendMailRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,   // 14: notify.v1.SendMailRequest.priority:type_name -> notify.v1.Priority
+	79,  // 15: notify.v1.SendMailRequest.valid_until:type_name -> google.protobuf.Timestamp
+	25,  // 16: notify.v1.SendMailRequest.fallback:type_name -> notify.v1.FallbackPolicy
+	7,   // 17: notify.v1.SendMailResponse.error:type_name -> notify.v1.ItemError
+	75,  // 18: notify.v1.Push.data:type_name -> notify.v1.Push.DataEntry
+	15,  // 19: notify.v1.SendPushRequest.push:type_name -> notify.v1.Push
+	79,  // 20: notify.v1.SendPushRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,   // 21: notify.v1.SendPushRequest.priority:type_name -> notify.v1.Priority
+	79,  // 22: notify.v1.SendPushRequest.valid_until:type_name -> google.protobuf.Timestamp
+	25,  // 23: notify.v1.SendPushRequest.fallback:type_name -> notify.v1.FallbackPolicy
+	7,   // 24: notify.v1.SendPushResponse.error:type_name -> notify.v1.ItemError
+	18,  // 25: notify.v1.SendLineRequest.line:type_name -> notify.v1.Line
+	79,  // 26: notify.v1.SendLineRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,   // 27: notify.v1.SendLineRequest.priority:type_name -> notify.v1.Priority
+	79,  // 28: notify.v1.SendLineRequest.valid_until:type_name -> google.protobuf.Timestamp
+	25,  // 29: notify.v1.SendLineRequest.fallback:type_name -> notify.v1.FallbackPolicy
+	76,  // 30: notify.v1.Webhook.headers:type_name -> notify.v1.Webhook.HeadersEntry
+	21,  // 31: notify.v1.SendWebhookRequest.webhook:type_name -> notify.v1.Webhook
+	79,  // 32: notify.v1.SendWebhookRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,   // 33: notify.v1.SendWebhookRequest.priority:type_name -> notify.v1.Priority
+	79,  // 34: notify.v1.SendWebhookRequest.valid_until:type_name -> google.protobuf.Timestamp
+	2,   // 35: notify.v1.FallbackStep.channel:type_name -> notify.v1.MessageType
+	81,  // 36: notify.v1.FallbackStep.deadline:type_name -> google.protobuf.Duration
+	4,   // 37: notify.v1.FallbackStep.sms:type_name -> notify.v1.Sms
+	12,  // 38: notify.v1.FallbackStep.mail:type_name -> notify.v1.Mail
+	15,  // 39: notify.v1.FallbackStep.push:type_name -> notify.v1.Push
+	18,  // 40: notify.v1.FallbackStep.line:type_name -> notify.v1.Line
+	24,  // 41: notify.v1.FallbackPolicy.steps:type_name -> notify.v1.FallbackStep
+	2,   // 42: notify.v1.MessageFallback.channel:type_name -> notify.v1.MessageType
+	79,  // 43: notify.v1.MessageFallback.deadline_at:type_name -> google.protobuf.Timestamp
+	2,   // 44: notify.v1.Message.message_type:type_name -> notify.v1.MessageType
+	79,  // 45: notify.v1.Message.scheduled_at:type_name -> google.protobuf.Timestamp
+	79,  // 46: notify.v1.Message.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 47: notify.v1.Message.target_counts:type_name -> notify.v1.Message.TargetCountsEntry
+	28,  // 48: notify.v1.Message.fallback:type_name -> notify.v1.MessageFallback
+	4,   // 49: notify.v1.SendNotificationRequest.sms:type_name -> notify.v1.Sms
+	12,  // 50: notify.v1.SendNotificationRequest.mail:type_name -> notify.v1.Mail
+	15,  // 51: notify.v1.SendNotificationRequest.push:type_name -> notify.v1.Push
+	18,  // 52: notify.v1.SendNotificationRequest.line:type_name -> notify.v1.Line
+	79,  // 53: notify.v1.SendNotificationRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,   // 54: notify.v1.SendNotificationRequest.priority:type_name -> notify.v1.Priority
+	79,  // 55: notify.v1.SendNotificationRequest.valid_until:type_name -> google.protobuf.Timestamp
+	32,  // 56: notify.v1.SendNotificationResponse.results:type_name -> notify.v1.NotificationChannelResult
+	2,   // 57: notify.v1.NotificationChannelResult.channel:type_name -> notify.v1.MessageType
+	7,   // 58: notify.v1.NotificationChannelResult.error:type_name -> notify.v1.ItemError
+	79,  // 59: notify.v1.Notification.scheduled_at:type_name -> google.protobuf.Timestamp
+	79,  // 60: notify.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 61: notify.v1.Notification.target_counts:type_name -> notify.v1.Notification.TargetCountsEntry
+	29,  // 62: notify.v1.Notification.messages:type_name -> notify.v1.Message
+	2,   // 63: notify.v1.Notification.failed_channels:type_name -> notify.v1.MessageType
+	2,   // 64: notify.v1.ListStatusWithPagingRequest.message_type:type_name -> notify.v1.MessageType
+	36,  // 65: notify.v1.ListStatusWithPagingRequest.page:type_name -> notify.v1.PageRequest
+	79,  // 66: notify.v1.ListStatusWithPagingRequest.start_at:type_name -> google.protobuf.Timestamp
+	79,  // 67: notify.v1.ListStatusWithPagingRequest.end_at:type_name -> google.protobuf.Timestamp
+	79,  // 68: notify.v1.Target.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 69: notify.v1.Target.updated_at:type_name -> google.protobuf.Timestamp
+	38,  // 70: notify.v1.ListStatusWithPagingResponse.target:type_name -> notify.v1.Target
+	39,  // 71: notify.v1.ListStatusWithPagingResponse.paging:type_name -> notify.v1.Paging
+	2,   // 72: notify.v1.RequestOtpRequest.channel:type_name -> notify.v1.MessageType
+	81,  // 73: notify.v1.RequestOtpRequest.ttl:type_name -> google.protobuf.Duration
+	79,  // 74: notify.v1.RequestOtpResponse.expires_at:type_name -> google.protobuf.Timestamp
+	79,  // 75: notify.v1.RequestOtpResponse.resend_available_at:type_name -> google.protobuf.Timestamp
+	2,   // 76: notify.v1.Suppression.channel:type_name -> notify.v1.MessageType
+	3,   // 77: notify.v1.Suppression.reason:type_name -> notify.v1.SuppressionReason
+	79,  // 78: notify.v1.Suppression.expires_at:type_name -> google.protobuf.Timestamp
+	79,  // 79: notify.v1.Suppression.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 80: notify.v1.Suppression.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 81: notify.v1.ListSuppressionsRequest.channel:type_name -> notify.v1.MessageType
+	3,   // 82: notify.v1.ListSuppressionsRequest.reason:type_name -> notify.v1.SuppressionReason
+	36,  // 83: notify.v1.ListSuppressionsRequest.page:type_name -> notify.v1.PageRequest
+	45,  // 84: notify.v1.ListSuppressionsResponse.suppressions:type_name -> notify.v1.Suppression
+	39,  // 85: notify.v1.ListSuppressionsResponse.paging:type_name -> notify.v1.Paging
+	2,   // 86: notify.v1.AddSuppressionRequest.channel:type_name -> notify.v1.MessageType
+	3,   // 87: notify.v1.AddSuppressionRequest.reason:type_name -> notify.v1.SuppressionReason
+	79,  // 88: notify.v1.AddSuppressionRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,   // 89: notify.v1.RemoveSuppressionRequest.channel:type_name -> notify.v1.MessageType
+	36,  // 90: notify.v1.ListInboundMessagesWithPagingRequest.page:type_name -> notify.v1.PageRequest
+	79,  // 91: notify.v1.ListInboundMessagesWithPagingRequest.start_at:type_name -> google.protobuf.Timestamp
+	79,  // 92: notify.v1.ListInboundMessagesWithPagingRequest.end_at:type_name -> google.protobuf.Timestamp
+	79,  // 93: notify.v1.InboundMessage.received_at:type_name -> google.protobuf.Timestamp
+	79,  // 94: notify.v1.InboundMessage.created_at:type_name -> google.protobuf.Timestamp
+	51,  // 95: notify.v1.ListInboundMessagesWithPagingResponse.inbound_messages:type_name -> notify.v1.InboundMessage
+	39,  // 96: notify.v1.ListInboundMessagesWithPagingResponse.paging:type_name -> notify.v1.Paging
+	79,  // 97: notify.v1.Contact.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 98: notify.v1.Contact.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 99: notify.v1.UpdateContactRequest.contact:type_name -> notify.v1.ContactInput
+	36,  // 100: notify.v1.ListContactsWithPagingRequest.page:type_name -> notify.v1.PageRequest
+	53,  // 101: notify.v1.ListContactsWithPagingResponse.contacts:type_name -> notify.v1.Contact
+	39,  // 102: notify.v1.ListContactsWithPagingResponse.paging:type_name -> notify.v1.Paging
+	54,  // 103: notify.v1.ImportContactsRequest.contacts:type_name -> notify.v1.ContactInput
+	79,  // 104: notify.v1.Group.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 105: notify.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 106: notify.v1.ListGroupsWithPagingRequest.page:type_name -> notify.v1.PageRequest
+	61,  // 107: notify.v1.ListGroupsWithPagingResponse.groups:type_name -> notify.v1.Group
+	39,  // 108: notify.v1.ListGroupsWithPagingResponse.paging:type_name -> notify.v1.Paging
+	0,   // 109: notify.v1.BulkSendHeader.priority:type_name -> notify.v1.Priority
+	79,  // 110: notify.v1.BulkSendHeader.scheduled_at:type_name -> google.protobuf.Timestamp
+	79,  // 111: notify.v1.BulkSendHeader.valid_until:type_name -> google.protobuf.Timestamp
+	68,  // 112: notify.v1.UploadBulkSendRequest.header:type_name -> notify.v1.BulkSendHeader
+	2,   // 113: notify.v1.BulkSendJob.channel:type_name -> notify.v1.MessageType
+	79,  // 114: notify.v1.BulkSendJob.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 115: notify.v1.BulkSendJob.completed_at:type_name -> google.protobuf.Timestamp
+	5,   // 116: notify.v1.NotifyService.SendSms:input_type -> notify.v1.SendSmsRequest
+	5,   // 117: notify.v1.NotifyService.SendBatchSms:input_type -> notify.v1.SendSmsRequest
+	9,   // 118: notify.v1.NotifyService.EstimateSms:input_type -> notify.v1.EstimateSmsRequest
+	13,  // 119: notify.v1.NotifyService.SendMail:input_type -> notify.v1.SendMailRequest
+	13,  // 120: notify.v1.NotifyService.SendBatchMail:input_type -> notify.v1.SendMailRequest
+	16,  // 121: notify.v1.NotifyService.SendPush:input_type -> notify.v1.SendPushRequest
+	16,  // 122: notify.v1.NotifyService.SendBatchPush:input_type -> notify.v1.SendPushRequest
+	19,  // 123: notify.v1.NotifyService.SendLine:input_type -> notify.v1.SendLineRequest
+	22,  // 124: notify.v1.NotifyService.SendWebhook:input_type -> notify.v1.SendWebhookRequest
+	26,  // 125: notify.v1.NotifyService.CancelScheduledByMessageId:input_type -> notify.v1.CancelScheduledByMessageIdRequest
+	37,  // 126: notify.v1.NotifyService.ListStatusWithPaging:input_type -> notify.v1.ListStatusWithPagingRequest
+	27,  // 127: notify.v1.NotifyService.GetMessage:input_type -> notify.v1.GetMessageRequest
+	30,  // 128: notify.v1.NotifyService.SendNotification:input_type -> notify.v1.SendNotificationRequest
+	33,  // 129: notify.v1.NotifyService.CancelNotification:input_type -> notify.v1.CancelNotificationRequest
+	34,  // 130: notify.v1.NotifyService.GetNotification:input_type -> notify.v1.GetNotificationRequest
+	41,  // 131: notify.v1.NotifyService.RequestOtp:input_type -> notify.v1.RequestOtpRequest
+	43,  // 132: notify.v1.NotifyService.VerifyOtp:input_type -> notify.v1.VerifyOtpRequest
+	46,  // 133: notify.v1.NotifyService.ListSuppressions:input_type -> notify.v1.ListSuppressionsRequest
+	48,  // 134: notify.v1.NotifyService.AddSuppression:input_type -> notify.v1.AddSuppressionRequest
+	49,  // 135: notify.v1.NotifyService.RemoveSuppression:input_type -> notify.v1.RemoveSuppressionRequest
+	50,  // 136: notify.v1.NotifyService.ListInboundMessagesWithPaging:input_type -> notify.v1.ListInboundMessagesWithPagingRequest
+	54,  // 137: notify.v1.NotifyService.CreateContact:input_type -> notify.v1.ContactInput
+	55,  // 138: notify.v1.NotifyService.UpdateContact:input_type -> notify.v1.UpdateContactRequest
+	56,  // 139: notify.v1.NotifyService.DeleteContact:input_type -> notify.v1.DeleteContactRequest
+	57,  // 140: notify.v1.NotifyService.ListContactsWithPaging:input_type -> notify.v1.ListContactsWithPagingRequest
+	59,  // 141: notify.v1.NotifyService.ImportContacts:input_type -> notify.v1.ImportContactsRequest
+	62,  // 142: notify.v1.NotifyService.CreateGroup:input_type -> notify.v1.CreateGroupRequest
+	63,  // 143: notify.v1.NotifyService.UpdateGroup:input_type -> notify.v1.UpdateGroupRequest
+	64,  // 144: notify.v1.NotifyService.DeleteGroup:input_type -> notify.v1.DeleteGroupRequest
+	65,  // 145: notify.v1.NotifyService.ListGroupsWithPaging:input_type -> notify.v1.ListGroupsWithPagingRequest
+	67,  // 146: notify.v1.NotifyService.AddGroupMembers:input_type -> notify.v1.GroupMembersRequest
+	67,  // 147: notify.v1.NotifyService.RemoveGroupMembers:input_type -> notify.v1.GroupMembersRequest
+	69,  // 148: notify.v1.NotifyService.UploadBulkSend:input_type -> notify.v1.UploadBulkSendRequest
+	72,  // 149: notify.v1.NotifyService.GetBulkSendJob:input_type -> notify.v1.GetBulkSendJobRequest
+	73,  // 150: notify.v1.NotifyService.DownloadBulkSendReport:input_type -> notify.v1.DownloadBulkSendReportRequest
+	6,   // 151: notify.v1.NotifyService.SendSms:output_type -> notify.v1.SendSmsResponse
+	6,   // 152: notify.v1.NotifyService.SendBatchSms:output_type -> notify.v1.SendSmsResponse
+	11,  // 153: notify.v1.NotifyService.EstimateSms:output_type -> notify.v1.EstimateSmsResponse
+	14,  // 154: notify.v1.NotifyService.SendMail:output_type -> notify.v1.SendMailResponse
+	14,  // 155: notify.v1.NotifyService.SendBatchMail:output_type -> notify.v1.SendMailResponse
+	17,  // 156: notify.v1.NotifyService.SendPush:output_type -> notify.v1.SendPushResponse
+	17,  // 157: notify.v1.NotifyService.SendBatchPush:output_type -> notify.v1.SendPushResponse
+	20,  // 158: notify.v1.NotifyService.SendLine:output_type -> notify.v1.SendLineResponse
+	23,  // 159: notify.v1.NotifyService.SendWebhook:output_type -> notify.v1.SendWebhookResponse
+	82,  // 160: notify.v1.NotifyService.CancelScheduledByMessageId:output_type -> google.protobuf.Empty
+	40,  // 161: notify.v1.NotifyService.ListStatusWithPaging:output_type -> notify.v1.ListStatusWithPagingResponse
+	29,  // 162: notify.v1.NotifyService.GetMessage:output_type -> notify.v1.Message
+	31,  // 163: notify.v1.NotifyService.SendNotification:output_type -> notify.v1.SendNotificationResponse
+	82,  // 164: notify.v1.NotifyService.CancelNotification:output_type -> google.protobuf.Empty
+	35,  // 165: notify.v1.NotifyService.GetNotification:output_type -> notify.v1.Notification
+	42,  // 166: notify.v1.NotifyService.RequestOtp:output_type -> notify.v1.RequestOtpResponse
+	44,  // 167: notify.v1.NotifyService.VerifyOtp:output_type -> notify.v1.VerifyOtpResponse
+	47,  // 168: notify.v1.NotifyService.ListSuppressions:output_type -> notify.v1.ListSuppressionsResponse
+	45,  // 169: notify.v1.NotifyService.AddSuppression:output_type -> notify.v1.Suppression
+	82,  // 170: notify.v1.NotifyService.RemoveSuppression:output_type -> google.protobuf.Empty
+	52,  // 171: notify.v1.NotifyService.ListInboundMessagesWithPaging:output_type -> notify.v1.ListInboundMessagesWithPagingResponse
+	53,  // 172: notify.v1.NotifyService.CreateContact:output_type -> notify.v1.Contact
+	53,  // 173: notify.v1.NotifyService.UpdateContact:output_type -> notify.v1.Contact
+	82,  // 174: notify.v1.NotifyService.DeleteContact:output_type -> google.protobuf.Empty
+	58,  // 175: notify.v1.NotifyService.ListContactsWithPaging:output_type -> notify.v1.ListContactsWithPagingResponse
+	60,  // 176: notify.v1.NotifyService.ImportContacts:output_type -> notify.v1.ImportContactsResponse
+	61,  // 177: notify.v1.NotifyService.CreateGroup:output_type -> notify.v1.Group
+	61,  // 178: notify.v1.NotifyService.UpdateGroup:output_type -> notify.v1.Group
+	82,  // 179: notify.v1.NotifyService.DeleteGroup:output_type -> google.protobuf.Empty
+	66,  // 180: notify.v1.NotifyService.ListGroupsWithPaging:output_type -> notify.v1.ListGroupsWithPagingResponse
+	82,  // 181: notify.v1.NotifyService.AddGroupMembers:output_type -> google.protobuf.Empty
+	82,  // 182: notify.v1.NotifyService.RemoveGroupMembers:output_type -> google.protobuf.Empty
+	70,  // 183: notify.v1.NotifyService.UploadBulkSend:output_type -> notify.v1.UploadBulkSendResponse
+	71,  // 184: notify.v1.NotifyService.GetBulkSendJob:output_type -> notify.v1.BulkSendJob
+	74,  // 185: notify.v1.NotifyService.DownloadBulkSendReport:output_type -> notify.v1.BulkSendReportChunk
+	151, // [151:186] is the sub-list for method output_type
+	116, // [116:151] is the sub-list for method input_type
+	116, // [116:116] is the sub-list for extension type_name
+	116, // [116:116] is the sub-list for extension extendee
+	0,   // [0:116] is the sub-list for field type_name
 }
 
 func init() { file_notify_notify_proto_init() }
@@ -1016,17 +6137,21 @@ func file_notify_notify_proto_init() {
 	if File_notify_notify_proto != nil {
 		return
 	}
-	file_notify_notify_proto_msgTypes[8].OneofWrappers = []any{
+	file_notify_notify_proto_msgTypes[33].OneofWrappers = []any{
 		(*ListStatusWithPagingRequest_MessageId)(nil),
 		(*ListStatusWithPagingRequest_Receiver)(nil),
 	}
+	file_notify_notify_proto_msgTypes[65].OneofWrappers = []any{
+		(*UploadBulkSendRequest_Header)(nil),
+		(*UploadBulkSendRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notify_notify_proto_rawDesc), len(file_notify_notify_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,12 +20,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NotifyService_SendSms_FullMethodName                    = "/notify.v1.NotifyService/SendSms"
-	NotifyService_SendBatchSms_FullMethodName               = "/notify.v1.NotifyService/SendBatchSms"
-	NotifyService_SendMail_FullMethodName                   = "/notify.v1.NotifyService/SendMail"
-	NotifyService_SendBatchMail_FullMethodName              = "/notify.v1.NotifyService/SendBatchMail"
-	NotifyService_CancelScheduledByMessageId_FullMethodName = "/notify.v1.NotifyService/CancelScheduledByMessageId"
-	NotifyService_ListStatusWithPaging_FullMethodName       = "/notify.v1.NotifyService/ListStatusWithPaging"
+	NotifyService_SendSms_FullMethodName                       = "/notify.v1.NotifyService/SendSms"
+	NotifyService_SendBatchSms_FullMethodName                  = "/notify.v1.NotifyService/SendBatchSms"
+	NotifyService_EstimateSms_FullMethodName                   = "/notify.v1.NotifyService/EstimateSms"
+	NotifyService_SendMail_FullMethodName                      = "/notify.v1.NotifyService/SendMail"
+	NotifyService_SendBatchMail_FullMethodName                 = "/notify.v1.NotifyService/SendBatchMail"
+	NotifyService_SendPush_FullMethodName                      = "/notify.v1.NotifyService/SendPush"
+	NotifyService_SendBatchPush_FullMethodName                 = "/notify.v1.NotifyService/SendBatchPush"
+	NotifyService_SendLine_FullMethodName                      = "/notify.v1.NotifyService/SendLine"
+	NotifyService_SendWebhook_FullMethodName                   = "/notify.v1.NotifyService/SendWebhook"
+	NotifyService_CancelScheduledByMessageId_FullMethodName    = "/notify.v1.NotifyService/CancelScheduledByMessageId"
+	NotifyService_ListStatusWithPaging_FullMethodName          = "/notify.v1.NotifyService/ListStatusWithPaging"
+	NotifyService_GetMessage_FullMethodName                    = "/notify.v1.NotifyService/GetMessage"
+	NotifyService_SendNotification_FullMethodName              = "/notify.v1.NotifyService/SendNotification"
+	NotifyService_CancelNotification_FullMethodName            = "/notify.v1.NotifyService/CancelNotification"
+	NotifyService_GetNotification_FullMethodName               = "/notify.v1.NotifyService/GetNotification"
+	NotifyService_RequestOtp_FullMethodName                    = "/notify.v1.NotifyService/RequestOtp"
+	NotifyService_VerifyOtp_FullMethodName                     = "/notify.v1.NotifyService/VerifyOtp"
+	NotifyService_ListSuppressions_FullMethodName              = "/notify.v1.NotifyService/ListSuppressions"
+	NotifyService_AddSuppression_FullMethodName                = "/notify.v1.NotifyService/AddSuppression"
+	NotifyService_RemoveSuppression_FullMethodName             = "/notify.v1.NotifyService/RemoveSuppression"
+	NotifyService_ListInboundMessagesWithPaging_FullMethodName = "/notify.v1.NotifyService/ListInboundMessagesWithPaging"
+	NotifyService_CreateContact_FullMethodName                 = "/notify.v1.NotifyService/CreateContact"
+	NotifyService_UpdateContact_FullMethodName                 = "/notify.v1.NotifyService/UpdateContact"
+	NotifyService_DeleteContact_FullMethodName                 = "/notify.v1.NotifyService/DeleteContact"
+	NotifyService_ListContactsWithPaging_FullMethodName        = "/notify.v1.NotifyService/ListContactsWithPaging"
+	NotifyService_ImportContacts_FullMethodName                = "/notify.v1.NotifyService/ImportContacts"
+	NotifyService_CreateGroup_FullMethodName                   = "/notify.v1.NotifyService/CreateGroup"
+	NotifyService_UpdateGroup_FullMethodName                   = "/notify.v1.NotifyService/UpdateGroup"
+	NotifyService_DeleteGroup_FullMethodName                   = "/notify.v1.NotifyService/DeleteGroup"
+	NotifyService_ListGroupsWithPaging_FullMethodName          = "/notify.v1.NotifyService/ListGroupsWithPaging"
+	NotifyService_AddGroupMembers_FullMethodName               = "/notify.v1.NotifyService/AddGroupMembers"
+	NotifyService_RemoveGroupMembers_FullMethodName            = "/notify.v1.NotifyService/RemoveGroupMembers"
+	NotifyService_UploadBulkSend_FullMethodName                = "/notify.v1.NotifyService/UploadBulkSend"
+	NotifyService_GetBulkSendJob_FullMethodName                = "/notify.v1.NotifyService/GetBulkSendJob"
+	NotifyService_DownloadBulkSendReport_FullMethodName        = "/notify.v1.NotifyService/DownloadBulkSendReport"
 )
 
 // NotifyServiceClient is the client API for NotifyService service.
@@ -37,16 +66,74 @@ const (
 type NotifyServiceClient interface {
 	// 非流式 RPC：單筆或少量簡訊發送
 	SendSms(ctx context.Context, in *SendSmsRequest, opts ...grpc.CallOption) (*SendSmsResponse, error)
-	// 流式 RPC：批量簡訊發送
+	// 流式 RPC：批量簡訊發送，每則請求回傳一則結果，單筆失敗不中斷串流
 	SendBatchSms(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SendSmsRequest, SendSmsResponse], error)
+	// 估算簡訊編碼、分段數與費用，不實際發送
+	EstimateSms(ctx context.Context, in *EstimateSmsRequest, opts ...grpc.CallOption) (*EstimateSmsResponse, error)
 	// 非流式 RPC：單筆或少量郵件發送
 	SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error)
-	// 流式 RPC：批量郵件發送
+	// 流式 RPC：批量郵件發送，每則請求回傳一則結果，單筆失敗不中斷串流
 	SendBatchMail(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SendMailRequest, SendMailResponse], error)
+	// 非流式 RPC：單筆或少量推播通知發送
+	SendPush(ctx context.Context, in *SendPushRequest, opts ...grpc.CallOption) (*SendPushResponse, error)
+	// 流式 RPC：批量推播通知發送，每則請求回傳一則結果，單筆失敗不中斷串流
+	SendBatchPush(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SendPushRequest, SendPushResponse], error)
+	// 發送 LINE 訊息
+	SendLine(ctx context.Context, in *SendLineRequest, opts ...grpc.CallOption) (*SendLineResponse, error)
+	// 發送外部 HTTP 推送，請求以 HMAC-SHA256 簽章
+	SendWebhook(ctx context.Context, in *SendWebhookRequest, opts ...grpc.CallOption) (*SendWebhookResponse, error)
 	// 取消預約訊息
 	CancelScheduledByMessageId(ctx context.Context, in *CancelScheduledByMessageIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 查詢發送狀態
 	ListStatusWithPaging(ctx context.Context, in *ListStatusWithPagingRequest, opts ...grpc.CallOption) (*ListStatusWithPagingResponse, error)
+	// 查詢訊息，包含各狀態收件者數量與備援鏈
+	GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*Message, error)
+	// 發送多通道通知，各通道分別建立訊息並以同一個通知 ID 查詢與取消
+	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	// 取消預約通知，取消所有尚未發送的通道訊息
+	CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查詢通知，包含各通道訊息與彙整狀態
+	GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...grpc.CallOption) (*Notification, error)
+	// 申請一次性密碼
+	RequestOtp(ctx context.Context, in *RequestOtpRequest, opts ...grpc.CallOption) (*RequestOtpResponse, error)
+	// 驗證一次性密碼
+	VerifyOtp(ctx context.Context, in *VerifyOtpRequest, opts ...grpc.CallOption) (*VerifyOtpResponse, error)
+	// 查詢抑制名單
+	ListSuppressions(ctx context.Context, in *ListSuppressionsRequest, opts ...grpc.CallOption) (*ListSuppressionsResponse, error)
+	// 新增抑制名單
+	AddSuppression(ctx context.Context, in *AddSuppressionRequest, opts ...grpc.CallOption) (*Suppression, error)
+	// 移除抑制名單
+	RemoveSuppression(ctx context.Context, in *RemoveSuppressionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查詢上行簡訊
+	ListInboundMessagesWithPaging(ctx context.Context, in *ListInboundMessagesWithPagingRequest, opts ...grpc.CallOption) (*ListInboundMessagesWithPagingResponse, error)
+	// 建立聯絡人
+	CreateContact(ctx context.Context, in *ContactInput, opts ...grpc.CallOption) (*Contact, error)
+	// 更新聯絡人
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	// 刪除聯絡人
+	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查詢聯絡人
+	ListContactsWithPaging(ctx context.Context, in *ListContactsWithPagingRequest, opts ...grpc.CallOption) (*ListContactsWithPagingResponse, error)
+	// 批量匯入聯絡人
+	ImportContacts(ctx context.Context, in *ImportContactsRequest, opts ...grpc.CallOption) (*ImportContactsResponse, error)
+	// 建立群組
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	// 更新群組
+	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	// 刪除群組
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查詢群組
+	ListGroupsWithPaging(ctx context.Context, in *ListGroupsWithPagingRequest, opts ...grpc.CallOption) (*ListGroupsWithPagingResponse, error)
+	// 新增群組成員
+	AddGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 移除群組成員
+	RemoveGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 客戶端流式 RPC：上傳 CSV 依範本批量發送
+	UploadBulkSend(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadBulkSendRequest, UploadBulkSendResponse], error)
+	// 查詢批量發送工作
+	GetBulkSendJob(ctx context.Context, in *GetBulkSendJobRequest, opts ...grpc.CallOption) (*BulkSendJob, error)
+	// 服務端流式 RPC：下載批量發送錯誤報告
+	DownloadBulkSendReport(ctx context.Context, in *DownloadBulkSendReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BulkSendReportChunk], error)
 }
 
 type notifyServiceClient struct {
@@ -80,6 +167,16 @@ func (c *notifyServiceClient) SendBatchSms(ctx context.Context, opts ...grpc.Cal
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type NotifyService_SendBatchSmsClient = grpc.BidiStreamingClient[SendSmsRequest, SendSmsResponse]
 
+func (c *notifyServiceClient) EstimateSms(ctx context.Context, in *EstimateSmsRequest, opts ...grpc.CallOption) (*EstimateSmsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstimateSmsResponse)
+	err := c.cc.Invoke(ctx, NotifyService_EstimateSms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notifyServiceClient) SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendMailResponse)
@@ -103,6 +200,49 @@ func (c *notifyServiceClient) SendBatchMail(ctx context.Context, opts ...grpc.Ca
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type NotifyService_SendBatchMailClient = grpc.BidiStreamingClient[SendMailRequest, SendMailResponse]
 
+func (c *notifyServiceClient) SendPush(ctx context.Context, in *SendPushRequest, opts ...grpc.CallOption) (*SendPushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendPushResponse)
+	err := c.cc.Invoke(ctx, NotifyService_SendPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) SendBatchPush(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SendPushRequest, SendPushResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotifyService_ServiceDesc.Streams[2], NotifyService_SendBatchPush_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SendPushRequest, SendPushResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifyService_SendBatchPushClient = grpc.BidiStreamingClient[SendPushRequest, SendPushResponse]
+
+func (c *notifyServiceClient) SendLine(ctx context.Context, in *SendLineRequest, opts ...grpc.CallOption) (*SendLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendLineResponse)
+	err := c.cc.Invoke(ctx, NotifyService_SendLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) SendWebhook(ctx context.Context, in *SendWebhookRequest, opts ...grpc.CallOption) (*SendWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendWebhookResponse)
+	err := c.cc.Invoke(ctx, NotifyService_SendWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notifyServiceClient) CancelScheduledByMessageId(ctx context.Context, in *CancelScheduledByMessageIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -123,6 +263,258 @@ func (c *notifyServiceClient) ListStatusWithPaging(ctx context.Context, in *List
 	return out, nil
 }
 
+func (c *notifyServiceClient) GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*Message, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Message)
+	err := c.cc.Invoke(ctx, NotifyService_GetMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, NotifyService_SendNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotifyService_CancelNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...grpc.CallOption) (*Notification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, NotifyService_GetNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) RequestOtp(ctx context.Context, in *RequestOtpRequest, opts ...grpc.CallOption) (*RequestOtpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestOtpResponse)
+	err := c.cc.Invoke(ctx, NotifyService_RequestOtp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) VerifyOtp(ctx context.Context, in *VerifyOtpRequest, opts ...grpc.CallOption) (*VerifyOtpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyOtpResponse)
+	err := c.cc.Invoke(ctx, NotifyService_VerifyOtp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) ListSuppressions(ctx context.Context, in *ListSuppressionsRequest, opts ...grpc.CallOption) (*ListSuppressionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppressionsResponse)
+	err := c.cc.Invoke(ctx, NotifyService_ListSuppressions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) AddSuppression(ctx context.Context, in *AddSuppressionRequest, opts ...grpc.CallOption) (*Suppression, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Suppression)
+	err := c.cc.Invoke(ctx, NotifyService_AddSuppression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) RemoveSuppression(ctx context.Context, in *RemoveSuppressionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotifyService_RemoveSuppression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) ListInboundMessagesWithPaging(ctx context.Context, in *ListInboundMessagesWithPagingRequest, opts ...grpc.CallOption) (*ListInboundMessagesWithPagingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboundMessagesWithPagingResponse)
+	err := c.cc.Invoke(ctx, NotifyService_ListInboundMessagesWithPaging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) CreateContact(ctx context.Context, in *ContactInput, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, NotifyService_CreateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, NotifyService_UpdateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotifyService_DeleteContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) ListContactsWithPaging(ctx context.Context, in *ListContactsWithPagingRequest, opts ...grpc.CallOption) (*ListContactsWithPagingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactsWithPagingResponse)
+	err := c.cc.Invoke(ctx, NotifyService_ListContactsWithPaging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) ImportContacts(ctx context.Context, in *ImportContactsRequest, opts ...grpc.CallOption) (*ImportContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportContactsResponse)
+	err := c.cc.Invoke(ctx, NotifyService_ImportContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, NotifyService_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, NotifyService_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotifyService_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) ListGroupsWithPaging(ctx context.Context, in *ListGroupsWithPagingRequest, opts ...grpc.CallOption) (*ListGroupsWithPagingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupsWithPagingResponse)
+	err := c.cc.Invoke(ctx, NotifyService_ListGroupsWithPaging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) AddGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotifyService_AddGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) RemoveGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotifyService_RemoveGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) UploadBulkSend(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadBulkSendRequest, UploadBulkSendResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotifyService_ServiceDesc.Streams[3], NotifyService_UploadBulkSend_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadBulkSendRequest, UploadBulkSendResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifyService_UploadBulkSendClient = grpc.ClientStreamingClient[UploadBulkSendRequest, UploadBulkSendResponse]
+
+func (c *notifyServiceClient) GetBulkSendJob(ctx context.Context, in *GetBulkSendJobRequest, opts ...grpc.CallOption) (*BulkSendJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkSendJob)
+	err := c.cc.Invoke(ctx, NotifyService_GetBulkSendJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifyServiceClient) DownloadBulkSendReport(ctx context.Context, in *DownloadBulkSendReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BulkSendReportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotifyService_ServiceDesc.Streams[4], NotifyService_DownloadBulkSendReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadBulkSendReportRequest, BulkSendReportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifyService_DownloadBulkSendReportClient = grpc.ServerStreamingClient[BulkSendReportChunk]
+
 // NotifyServiceServer is the server API for NotifyService service.
 // All implementations must embed UnimplementedNotifyServiceServer
 // for forward compatibility.
@@ -132,16 +524,74 @@ func (c *notifyServiceClient) ListStatusWithPaging(ctx context.Context, in *List
 type NotifyServiceServer interface {
 	// 非流式 RPC：單筆或少量簡訊發送
 	SendSms(context.Context, *SendSmsRequest) (*SendSmsResponse, error)
-	// 流式 RPC：批量簡訊發送
+	// 流式 RPC：批量簡訊發送，每則請求回傳一則結果，單筆失敗不中斷串流
 	SendBatchSms(grpc.BidiStreamingServer[SendSmsRequest, SendSmsResponse]) error
+	// 估算簡訊編碼、分段數與費用，不實際發送
+	EstimateSms(context.Context, *EstimateSmsRequest) (*EstimateSmsResponse, error)
 	// 非流式 RPC：單筆或少量郵件發送
 	SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error)
-	// 流式 RPC：批量郵件發送
+	// 流式 RPC：批量郵件發送，每則請求回傳一則結果，單筆失敗不中斷串流
 	SendBatchMail(grpc.BidiStreamingServer[SendMailRequest, SendMailResponse]) error
+	// 非流式 RPC：單筆或少量推播通知發送
+	SendPush(context.Context, *SendPushRequest) (*SendPushResponse, error)
+	// 流式 RPC：批量推播通知發送，每則請求回傳一則結果，單筆失敗不中斷串流
+	SendBatchPush(grpc.BidiStreamingServer[SendPushRequest, SendPushResponse]) error
+	// 發送 LINE 訊息
+	SendLine(context.Context, *SendLineRequest) (*SendLineResponse, error)
+	// 發送外部 HTTP 推送，請求以 HMAC-SHA256 簽章
+	SendWebhook(context.Context, *SendWebhookRequest) (*SendWebhookResponse, error)
 	// 取消預約訊息
 	CancelScheduledByMessageId(context.Context, *CancelScheduledByMessageIdRequest) (*emptypb.Empty, error)
 	// 查詢發送狀態
 	ListStatusWithPaging(context.Context, *ListStatusWithPagingRequest) (*ListStatusWithPagingResponse, error)
+	// 查詢訊息，包含各狀態收件者數量與備援鏈
+	GetMessage(context.Context, *GetMessageRequest) (*Message, error)
+	// 發送多通道通知，各通道分別建立訊息並以同一個通知 ID 查詢與取消
+	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	// 取消預約通知，取消所有尚未發送的通道訊息
+	CancelNotification(context.Context, *CancelNotificationRequest) (*emptypb.Empty, error)
+	// 查詢通知，包含各通道訊息與彙整狀態
+	GetNotification(context.Context, *GetNotificationRequest) (*Notification, error)
+	// 申請一次性密碼
+	RequestOtp(context.Context, *RequestOtpRequest) (*RequestOtpResponse, error)
+	// 驗證一次性密碼
+	VerifyOtp(context.Context, *VerifyOtpRequest) (*VerifyOtpResponse, error)
+	// 查詢抑制名單
+	ListSuppressions(context.Context, *ListSuppressionsRequest) (*ListSuppressionsResponse, error)
+	// 新增抑制名單
+	AddSuppression(context.Context, *AddSuppressionRequest) (*Suppression, error)
+	// 移除抑制名單
+	RemoveSuppression(context.Context, *RemoveSuppressionRequest) (*emptypb.Empty, error)
+	// 查詢上行簡訊
+	ListInboundMessagesWithPaging(context.Context, *ListInboundMessagesWithPagingRequest) (*ListInboundMessagesWithPagingResponse, error)
+	// 建立聯絡人
+	CreateContact(context.Context, *ContactInput) (*Contact, error)
+	// 更新聯絡人
+	UpdateContact(context.Context, *UpdateContactRequest) (*Contact, error)
+	// 刪除聯絡人
+	DeleteContact(context.Context, *DeleteContactRequest) (*emptypb.Empty, error)
+	// 查詢聯絡人
+	ListContactsWithPaging(context.Context, *ListContactsWithPagingRequest) (*ListContactsWithPagingResponse, error)
+	// 批量匯入聯絡人
+	ImportContacts(context.Context, *ImportContactsRequest) (*ImportContactsResponse, error)
+	// 建立群組
+	CreateGroup(context.Context, *CreateGroupRequest) (*Group, error)
+	// 更新群組
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error)
+	// 刪除群組
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*emptypb.Empty, error)
+	// 查詢群組
+	ListGroupsWithPaging(context.Context, *ListGroupsWithPagingRequest) (*ListGroupsWithPagingResponse, error)
+	// 新增群組成員
+	AddGroupMembers(context.Context, *GroupMembersRequest) (*emptypb.Empty, error)
+	// 移除群組成員
+	RemoveGroupMembers(context.Context, *GroupMembersRequest) (*emptypb.Empty, error)
+	// 客戶端流式 RPC：上傳 CSV 依範本批量發送
+	UploadBulkSend(grpc.ClientStreamingServer[UploadBulkSendRequest, UploadBulkSendResponse]) error
+	// 查詢批量發送工作
+	GetBulkSendJob(context.Context, *GetBulkSendJobRequest) (*BulkSendJob, error)
+	// 服務端流式 RPC：下載批量發送錯誤報告
+	DownloadBulkSendReport(*DownloadBulkSendReportRequest, grpc.ServerStreamingServer[BulkSendReportChunk]) error
 	mustEmbedUnimplementedNotifyServiceServer()
 }
 
@@ -158,18 +608,105 @@ func (UnimplementedNotifyServiceServer) SendSms(context.Context, *SendSmsRequest
 func (UnimplementedNotifyServiceServer) SendBatchSms(grpc.BidiStreamingServer[SendSmsRequest, SendSmsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method SendBatchSms not implemented")
 }
+func (UnimplementedNotifyServiceServer) EstimateSms(context.Context, *EstimateSmsRequest) (*EstimateSmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSms not implemented")
+}
 func (UnimplementedNotifyServiceServer) SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMail not implemented")
 }
 func (UnimplementedNotifyServiceServer) SendBatchMail(grpc.BidiStreamingServer[SendMailRequest, SendMailResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method SendBatchMail not implemented")
 }
+func (UnimplementedNotifyServiceServer) SendPush(context.Context, *SendPushRequest) (*SendPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPush not implemented")
+}
+func (UnimplementedNotifyServiceServer) SendBatchPush(grpc.BidiStreamingServer[SendPushRequest, SendPushResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SendBatchPush not implemented")
+}
+func (UnimplementedNotifyServiceServer) SendLine(context.Context, *SendLineRequest) (*SendLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLine not implemented")
+}
+func (UnimplementedNotifyServiceServer) SendWebhook(context.Context, *SendWebhookRequest) (*SendWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWebhook not implemented")
+}
 func (UnimplementedNotifyServiceServer) CancelScheduledByMessageId(context.Context, *CancelScheduledByMessageIdRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledByMessageId not implemented")
 }
 func (UnimplementedNotifyServiceServer) ListStatusWithPaging(context.Context, *ListStatusWithPagingRequest) (*ListStatusWithPagingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStatusWithPaging not implemented")
 }
+func (UnimplementedNotifyServiceServer) GetMessage(context.Context, *GetMessageRequest) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessage not implemented")
+}
+func (UnimplementedNotifyServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (UnimplementedNotifyServiceServer) CancelNotification(context.Context, *CancelNotificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNotification not implemented")
+}
+func (UnimplementedNotifyServiceServer) GetNotification(context.Context, *GetNotificationRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotification not implemented")
+}
+func (UnimplementedNotifyServiceServer) RequestOtp(context.Context, *RequestOtpRequest) (*RequestOtpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestOtp not implemented")
+}
+func (UnimplementedNotifyServiceServer) VerifyOtp(context.Context, *VerifyOtpRequest) (*VerifyOtpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyOtp not implemented")
+}
+func (UnimplementedNotifyServiceServer) ListSuppressions(context.Context, *ListSuppressionsRequest) (*ListSuppressionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppressions not implemented")
+}
+func (UnimplementedNotifyServiceServer) AddSuppression(context.Context, *AddSuppressionRequest) (*Suppression, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSuppression not implemented")
+}
+func (UnimplementedNotifyServiceServer) RemoveSuppression(context.Context, *RemoveSuppressionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSuppression not implemented")
+}
+func (UnimplementedNotifyServiceServer) ListInboundMessagesWithPaging(context.Context, *ListInboundMessagesWithPagingRequest) (*ListInboundMessagesWithPagingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInboundMessagesWithPaging not implemented")
+}
+func (UnimplementedNotifyServiceServer) CreateContact(context.Context, *ContactInput) (*Contact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContact not implemented")
+}
+func (UnimplementedNotifyServiceServer) UpdateContact(context.Context, *UpdateContactRequest) (*Contact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContact not implemented")
+}
+func (UnimplementedNotifyServiceServer) DeleteContact(context.Context, *DeleteContactRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContact not implemented")
+}
+func (UnimplementedNotifyServiceServer) ListContactsWithPaging(context.Context, *ListContactsWithPagingRequest) (*ListContactsWithPagingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContactsWithPaging not implemented")
+}
+func (UnimplementedNotifyServiceServer) ImportContacts(context.Context, *ImportContactsRequest) (*ImportContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportContacts not implemented")
+}
+func (UnimplementedNotifyServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedNotifyServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedNotifyServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedNotifyServiceServer) ListGroupsWithPaging(context.Context, *ListGroupsWithPagingRequest) (*ListGroupsWithPagingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupsWithPaging not implemented")
+}
+func (UnimplementedNotifyServiceServer) AddGroupMembers(context.Context, *GroupMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedNotifyServiceServer) RemoveGroupMembers(context.Context, *GroupMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (UnimplementedNotifyServiceServer) UploadBulkSend(grpc.ClientStreamingServer[UploadBulkSendRequest, UploadBulkSendResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBulkSend not implemented")
+}
+func (UnimplementedNotifyServiceServer) GetBulkSendJob(context.Context, *GetBulkSendJobRequest) (*BulkSendJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkSendJob not implemented")
+}
+func (UnimplementedNotifyServiceServer) DownloadBulkSendReport(*DownloadBulkSendReportRequest, grpc.ServerStreamingServer[BulkSendReportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBulkSendReport not implemented")
+}
 func (UnimplementedNotifyServiceServer) mustEmbedUnimplementedNotifyServiceServer() {}
 func (UnimplementedNotifyServiceServer) testEmbeddedByValue()                       {}
 
@@ -216,31 +753,110 @@ func _NotifyService_SendBatchSms_Handler(srv interface{}, stream grpc.ServerStre
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type NotifyService_SendBatchSmsServer = grpc.BidiStreamingServer[SendSmsRequest, SendSmsResponse]
 
-func _NotifyService_SendMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendMailRequest)
+func _NotifyService_EstimateSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSmsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NotifyServiceServer).SendMail(ctx, in)
+		return srv.(NotifyServiceServer).EstimateSms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NotifyService_SendMail_FullMethodName,
+		FullMethod: NotifyService_EstimateSms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotifyServiceServer).SendMail(ctx, req.(*SendMailRequest))
+		return srv.(NotifyServiceServer).EstimateSms(ctx, req.(*EstimateSmsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NotifyService_SendBatchMail_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NotifyServiceServer).SendBatchMail(&grpc.GenericServerStream[SendMailRequest, SendMailResponse]{ServerStream: stream})
-}
+func _NotifyService_SendMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).SendMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_SendMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).SendMail(ctx, req.(*SendMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_SendBatchMail_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotifyServiceServer).SendBatchMail(&grpc.GenericServerStream[SendMailRequest, SendMailResponse]{ServerStream: stream})
+}
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type NotifyService_SendBatchMailServer = grpc.BidiStreamingServer[SendMailRequest, SendMailResponse]
 
+func _NotifyService_SendPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).SendPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_SendPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).SendPush(ctx, req.(*SendPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_SendBatchPush_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotifyServiceServer).SendBatchPush(&grpc.GenericServerStream[SendPushRequest, SendPushResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifyService_SendBatchPushServer = grpc.BidiStreamingServer[SendPushRequest, SendPushResponse]
+
+func _NotifyService_SendLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).SendLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_SendLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).SendLine(ctx, req.(*SendLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_SendWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).SendWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_SendWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).SendWebhook(ctx, req.(*SendWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotifyService_CancelScheduledByMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelScheduledByMessageIdRequest)
 	if err := dec(in); err != nil {
@@ -277,6 +893,420 @@ func _NotifyService_ListStatusWithPaging_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotifyService_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_GetMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).GetMessage(ctx, req.(*GetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).SendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_SendNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).SendNotification(ctx, req.(*SendNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_CancelNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).CancelNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_CancelNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).CancelNotification(ctx, req.(*CancelNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_GetNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).GetNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_GetNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).GetNotification(ctx, req.(*GetNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_RequestOtp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestOtpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).RequestOtp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_RequestOtp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).RequestOtp(ctx, req.(*RequestOtpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_VerifyOtp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOtpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).VerifyOtp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_VerifyOtp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).VerifyOtp(ctx, req.(*VerifyOtpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_ListSuppressions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppressionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).ListSuppressions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_ListSuppressions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).ListSuppressions(ctx, req.(*ListSuppressionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_AddSuppression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSuppressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).AddSuppression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_AddSuppression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).AddSuppression(ctx, req.(*AddSuppressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_RemoveSuppression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSuppressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).RemoveSuppression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_RemoveSuppression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).RemoveSuppression(ctx, req.(*RemoveSuppressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_ListInboundMessagesWithPaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboundMessagesWithPagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).ListInboundMessagesWithPaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_ListInboundMessagesWithPaging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).ListInboundMessagesWithPaging(ctx, req.(*ListInboundMessagesWithPagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_CreateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).CreateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_CreateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).CreateContact(ctx, req.(*ContactInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_UpdateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_DeleteContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_ListContactsWithPaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsWithPagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).ListContactsWithPaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_ListContactsWithPaging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).ListContactsWithPaging(ctx, req.(*ListContactsWithPagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_ImportContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).ImportContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_ImportContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).ImportContacts(ctx, req.(*ImportContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_ListGroupsWithPaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsWithPagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).ListGroupsWithPaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_ListGroupsWithPaging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).ListGroupsWithPaging(ctx, req.(*ListGroupsWithPagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).AddGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_RemoveGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).RemoveGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_UploadBulkSend_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotifyServiceServer).UploadBulkSend(&grpc.GenericServerStream[UploadBulkSendRequest, UploadBulkSendResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifyService_UploadBulkSendServer = grpc.ClientStreamingServer[UploadBulkSendRequest, UploadBulkSendResponse]
+
+func _NotifyService_GetBulkSendJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkSendJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifyServiceServer).GetBulkSendJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotifyService_GetBulkSendJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifyServiceServer).GetBulkSendJob(ctx, req.(*GetBulkSendJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotifyService_DownloadBulkSendReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadBulkSendReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotifyServiceServer).DownloadBulkSendReport(m, &grpc.GenericServerStream[DownloadBulkSendReportRequest, BulkSendReportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifyService_DownloadBulkSendReportServer = grpc.ServerStreamingServer[BulkSendReportChunk]
+
 // NotifyService_ServiceDesc is the grpc.ServiceDesc for NotifyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,10 +1318,26 @@ var NotifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendSms",
 			Handler:    _NotifyService_SendSms_Handler,
 		},
+		{
+			MethodName: "EstimateSms",
+			Handler:    _NotifyService_EstimateSms_Handler,
+		},
 		{
 			MethodName: "SendMail",
 			Handler:    _NotifyService_SendMail_Handler,
 		},
+		{
+			MethodName: "SendPush",
+			Handler:    _NotifyService_SendPush_Handler,
+		},
+		{
+			MethodName: "SendLine",
+			Handler:    _NotifyService_SendLine_Handler,
+		},
+		{
+			MethodName: "SendWebhook",
+			Handler:    _NotifyService_SendWebhook_Handler,
+		},
 		{
 			MethodName: "CancelScheduledByMessageId",
 			Handler:    _NotifyService_CancelScheduledByMessageId_Handler,
@@ -300,6 +1346,94 @@ var NotifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStatusWithPaging",
 			Handler:    _NotifyService_ListStatusWithPaging_Handler,
 		},
+		{
+			MethodName: "GetMessage",
+			Handler:    _NotifyService_GetMessage_Handler,
+		},
+		{
+			MethodName: "SendNotification",
+			Handler:    _NotifyService_SendNotification_Handler,
+		},
+		{
+			MethodName: "CancelNotification",
+			Handler:    _NotifyService_CancelNotification_Handler,
+		},
+		{
+			MethodName: "GetNotification",
+			Handler:    _NotifyService_GetNotification_Handler,
+		},
+		{
+			MethodName: "RequestOtp",
+			Handler:    _NotifyService_RequestOtp_Handler,
+		},
+		{
+			MethodName: "VerifyOtp",
+			Handler:    _NotifyService_VerifyOtp_Handler,
+		},
+		{
+			MethodName: "ListSuppressions",
+			Handler:    _NotifyService_ListSuppressions_Handler,
+		},
+		{
+			MethodName: "AddSuppression",
+			Handler:    _NotifyService_AddSuppression_Handler,
+		},
+		{
+			MethodName: "RemoveSuppression",
+			Handler:    _NotifyService_RemoveSuppression_Handler,
+		},
+		{
+			MethodName: "ListInboundMessagesWithPaging",
+			Handler:    _NotifyService_ListInboundMessagesWithPaging_Handler,
+		},
+		{
+			MethodName: "CreateContact",
+			Handler:    _NotifyService_CreateContact_Handler,
+		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _NotifyService_UpdateContact_Handler,
+		},
+		{
+			MethodName: "DeleteContact",
+			Handler:    _NotifyService_DeleteContact_Handler,
+		},
+		{
+			MethodName: "ListContactsWithPaging",
+			Handler:    _NotifyService_ListContactsWithPaging_Handler,
+		},
+		{
+			MethodName: "ImportContacts",
+			Handler:    _NotifyService_ImportContacts_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _NotifyService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _NotifyService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _NotifyService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "ListGroupsWithPaging",
+			Handler:    _NotifyService_ListGroupsWithPaging_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _NotifyService_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _NotifyService_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "GetBulkSendJob",
+			Handler:    _NotifyService_GetBulkSendJob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -314,6 +1448,22 @@ var NotifyService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SendBatchPush",
+			Handler:       _NotifyService_SendBatchPush_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadBulkSend",
+			Handler:       _NotifyService_UploadBulkSend_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadBulkSendReport",
+			Handler:       _NotifyService_DownloadBulkSendReport_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "notify/notify.proto",
 }